@@ -0,0 +1,14 @@
+# Migrate the receivers of an existing Alertmanager to Dash0 notification
+# channels. Review the warnings before applying.
+data "dash0_alertmanager_conversion" "legacy" {
+  alertmanager_yaml = file("${path.module}/alertmanager.yml")
+}
+
+resource "dash0_notification_channel" "migrated" {
+  for_each                  = data.dash0_alertmanager_conversion.legacy.notification_channels
+  notification_channel_yaml = each.value
+}
+
+output "alertmanager_conversion_warnings" {
+  value = data.dash0_alertmanager_conversion.legacy.warnings
+}
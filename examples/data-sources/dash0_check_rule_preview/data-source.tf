@@ -0,0 +1,15 @@
+# Evaluate the expression of a check rule against the last three days of data
+# before it is applied, to get a sense of how noisy it would be.
+data "dash0_check_rule_preview" "checkout_errors" {
+  dataset    = "production"
+  expression = "sum by (service_name) (rate(http_requests_errors_total{service_name=\"checkout\"}[5m])) > 0.05"
+  for        = "5m"
+  lookback   = "72h"
+}
+
+check "checkout_errors_quiet" {
+  assert {
+    condition     = !data.dash0_check_rule_preview.checkout_errors.would_have_fired
+    error_message = "The checkout error rule would have fired for ${data.dash0_check_rule_preview.checkout_errors.firing_series_count} series in the last 72 hours, last at ${data.dash0_check_rule_preview.checkout_errors.last_fired_at}."
+  }
+}
@@ -0,0 +1,16 @@
+data "dash0_failed_checks" "checkout" {
+  dataset = "production"
+
+  labels = {
+    service_name = "checkout"
+  }
+}
+
+# Flag the workspace when the checkout service has firing alerts after an
+# apply. `check` blocks report failed assertions as warnings.
+check "checkout_healthy" {
+  assert {
+    condition     = data.dash0_failed_checks.checkout.critical_count == 0
+    error_message = "${data.dash0_failed_checks.checkout.critical_count} critical checks are failing for the checkout service."
+  }
+}
@@ -27,9 +27,11 @@ EOF
 }
 
 # Fanning out check rules with `for_each` and routing each rule's alerts to one
-# or more notification channels through the `dash0.com/notification-channel-ids`
-# annotation. The annotation takes a comma-separated list of channel UUIDs —
-# the `id` attribute on `dash0_notification_channel`, not the `origin`.
+# or more notification channels. `notification_channel_ids` takes the `id`
+# attribute of `dash0_notification_channel` (the server-assigned UUID, not the
+# `origin`); the provider writes the IDs into the rule's
+# `dash0.com/notification-channel-ids` annotation, and Terraform creates the
+# channels before the rules that reference them.
 resource "dash0_notification_channel" "team_oncall" {
   for_each = {
     backend  = "backend-oncall@example.com"
@@ -71,15 +73,14 @@ locals {
 resource "dash0_check_rule" "service_error_rate" {
   for_each = local.service_check_rules
 
-  dataset = "production"
+  dataset                  = "production"
+  notification_channel_ids = [for c in each.value.channels : dash0_notification_channel.team_oncall[c].id]
 
   check_rule_yaml = <<-EOF
 apiVersion: monitoring.coreos.com/v1
 kind: PrometheusRule
 metadata:
   name: ${each.key}-error-rate
-  annotations:
-    dash0.com/notification-channel-ids: ${join(",", [for c in each.value.channels : dash0_notification_channel.team_oncall[c].id])}
 spec:
   groups:
     - name: Alerting
@@ -97,3 +98,40 @@ spec:
           labels: {}
 EOF
 }
+
+# Keeping a check rule on `terraform destroy`: with `on_destroy = "disable"`,
+# destroying the resource disables the rule instead of deleting it, so its
+# history is preserved.
+resource "dash0_check_rule" "preview_environment" {
+  dataset    = "preview"
+  on_destroy = "disable"
+
+  check_rule_yaml = file("${path.module}/check_rule.yaml")
+}
+
+# Heartbeat (dead man's switch) checks alert when an expected signal has NOT
+# arrived. `absent_over_time` fires once the nightly backup job has not
+# reported a single span for 26 hours, a day plus slack for a late run.
+resource "dash0_check_rule" "nightly_backup_heartbeat" {
+  dataset = "production"
+
+  check_rule_yaml = <<-EOF
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: nightly-backup-heartbeat
+spec:
+  groups:
+    - name: Heartbeats
+      interval: 5m0s
+      rules:
+        - alert: nightly-backup-missing
+          expr: absent_over_time({otel_metric_name = "dash0.spans", service_name = "nightly-backup"}[26h])
+          for: 0s
+          keep_firing_for: 0s
+          annotations:
+            summary: 'The nightly backup has not reported in 26 hours'
+            dash0-enabled: true
+          labels: {}
+EOF
+}
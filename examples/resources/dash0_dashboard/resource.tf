@@ -3,3 +3,15 @@ resource "dash0_dashboard" "my_dashboard" {
   dashboard_yaml = file("${path.module}/dashboard.yaml")
 }
 
+# Applying organisation-wide metadata without editing the dashboard YAML.
+# Annotations set here are merged into `metadata.annotations` and take
+# precedence over the same keys declared in the YAML.
+resource "dash0_dashboard" "checkout" {
+  dataset        = "default"
+  dashboard_yaml = file("${path.module}/dashboard.yaml")
+
+  annotations = {
+    "dash0.com/folder-path" = "/checkout"
+    "dash0.com/sharing"     = "team:team_01abc"
+  }
+}
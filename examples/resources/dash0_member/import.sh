@@ -0,0 +1,4 @@
+#!/bin/bash
+# Members are imported by email address. The role cannot be read from the
+# Dash0 API, so configure it after importing.
+terraform import dash0_member.name "{{ email }}"
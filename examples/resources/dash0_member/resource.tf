@@ -0,0 +1,22 @@
+# Invite a person to the Dash0 organization. The member appears in the
+# organization once the invitation is accepted; until then `id` is empty.
+resource "dash0_member" "carol" {
+  email = "carol@example.com"
+  role  = "basic_member"
+}
+
+# Reference the member from a team once they have joined. Teams also accept
+# email addresses, which work while the invitation is still pending.
+resource "dash0_team" "backend" {
+  team_yaml = <<-YAML
+apiVersion: dash0.com/v1alpha1
+kind: Dash0Team
+metadata:
+  name: backend-team
+spec:
+  display:
+    name: Backend Team
+  members:
+    - ${dash0_member.carol.email}
+YAML
+}
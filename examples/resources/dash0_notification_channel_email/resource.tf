@@ -0,0 +1,9 @@
+# Email failed checks to the on-call rotation and the SRE team. Invalid
+# addresses are rejected at plan time.
+resource "dash0_notification_channel_email" "oncall" {
+  name = "Email Alerts"
+  recipients = [
+    "oncall@example.com",
+    "sre-team@example.com",
+  ]
+}
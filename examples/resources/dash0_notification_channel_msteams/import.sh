@@ -0,0 +1,2 @@
+#!/bin/bash
+terraform import dash0_notification_channel_msteams.name "{{ origin }}"
@@ -0,0 +1,11 @@
+# Post notifications to a Microsoft Teams channel through an incoming
+# webhook. The webhook URL is treated as sensitive and redacted in plans.
+variable "teams_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+resource "dash0_notification_channel_msteams" "alerts" {
+  name        = "Microsoft Teams Alerts"
+  webhook_url = var.teams_webhook_url
+}
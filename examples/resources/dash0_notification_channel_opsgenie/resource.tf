@@ -0,0 +1,22 @@
+# Create Opsgenie alerts for failed checks. The API key is treated as
+# sensitive and redacted in plans.
+variable "opsgenie_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "dash0_notification_channel_opsgenie" "alerts" {
+  name    = "Opsgenie Alerts"
+  api_key = var.opsgenie_api_key
+  region  = "eu"
+}
+
+# With Terraform 1.11 or later, pass the key as a write-only attribute so it
+# is never stored in the state, for example from an ephemeral resource.
+# Increment api_key_wo_version after rotating the key.
+#
+# resource "dash0_notification_channel_opsgenie" "alerts" {
+#   name               = "Opsgenie Alerts"
+#   api_key_wo         = ephemeral.vault_kv_secret_v2.opsgenie.data["api_key"]
+#   api_key_wo_version = 1
+# }
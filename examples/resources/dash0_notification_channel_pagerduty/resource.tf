@@ -0,0 +1,29 @@
+# Trigger PagerDuty incidents for failed checks. The integration key is
+# treated as sensitive and redacted in plans.
+variable "pagerduty_integration_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "dash0_notification_channel_pagerduty" "incidents" {
+  name            = "PagerDuty Incidents"
+  integration_key = var.pagerduty_integration_key
+
+  # Page only for critical failed checks.
+  routing_filters = [
+    {
+      conditions = [
+        { key = "service.severity", operator = "is", value = "critical" },
+      ]
+    },
+  ]
+}
+
+# For PagerDuty accounts in the EU service region, point the channel at the
+# EU Events API:
+#
+# resource "dash0_notification_channel_pagerduty" "incidents_eu" {
+#   name            = "PagerDuty Incidents (EU)"
+#   integration_key = var.pagerduty_integration_key
+#   events_url      = "https://events.eu.pagerduty.com/v2/enqueue"
+# }
@@ -0,0 +1,35 @@
+# Post notifications to Slack through an incoming webhook. The configuration
+# is expressed as typed attributes instead of YAML, so the webhook URL is
+# treated as sensitive and redacted in plans.
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+resource "dash0_notification_channel_slack" "alerts" {
+  name        = "Slack Alerts"
+  webhook_url = var.slack_webhook_url
+  channel     = "#alerts"
+  frequency   = "30m"
+
+  # Deliver only the failed checks of the SRE team in production, or any
+  # critical failed check.
+  routing_filters = [
+    {
+      conditions = [
+        { key = "team.name", operator = "is", value = "sre" },
+        { key = "deployment.environment.name", operator = "is", value = "production" },
+      ]
+    },
+    {
+      conditions = [
+        { key = "service.severity", operator = "is", value = "critical" },
+      ]
+    },
+  ]
+}
+
+# Reference the channel from a check rule by its server-assigned id.
+output "slack_channel_id" {
+  value = dash0_notification_channel_slack.alerts.id
+}
@@ -0,0 +1,18 @@
+# Route failed checks to an internal incident bridge. Headers are treated as
+# sensitive, so credentials the endpoint expects are redacted in plans.
+variable "incident_bridge_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "dash0_notification_channel_webhook" "incident_bridge" {
+  name = "Incident Bridge"
+  url  = "https://incidents.internal.example.com/hooks/dash0"
+
+  headers = {
+    Authorization = "Bearer ${var.incident_bridge_token}"
+  }
+
+  # The endpoint serves a certificate signed by an internal CA.
+  allow_insecure = true
+}
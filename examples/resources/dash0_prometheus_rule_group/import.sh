@@ -0,0 +1,4 @@
+#!/bin/bash
+# The check rules and recording rule groups whose origins start with
+# <origin>- are read and joined into one document.
+terraform import dash0_prometheus_rule_group.payments production,tf_existing-rule-group-origin
@@ -0,0 +1,50 @@
+# Syncing an existing PrometheusRule document into one check rule per alerting
+# rule. Each check rule is named `<group> - <alert>` and evaluated at the
+# interval of its group.
+resource "dash0_prometheus_rule_group" "checkout" {
+  dataset = "production"
+
+  prometheus_rule_yaml = file("${path.module}/prometheus_rule.yaml")
+}
+
+# The document can also be written inline. The recording rules of the
+# `aggregations` group are stored as one recording rule group.
+resource "dash0_prometheus_rule_group" "payments" {
+  dataset = "production"
+
+  prometheus_rule_yaml = <<-EOF
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: payments
+spec:
+  groups:
+    - name: availability
+      interval: 1m
+      rules:
+        - alert: PaymentServiceDown
+          expr: sum(up{service_name="paymentservice"}) == 0
+          for: 2m
+          annotations:
+            summary: The payment service is down
+        - alert: PaymentErrorRateHigh
+          expr: sum(rate({otel_metric_name="dash0.spans", service_name="paymentservice", otel_span_status_code="ERROR"}[5m])) / sum(rate({otel_metric_name="dash0.spans", service_name="paymentservice"}[5m])) * 100 > $__threshold
+          for: 5m
+          annotations:
+            summary: 'High error percentage for paymentservice: {{$value|printf "%.2f"}}%'
+            dash0-threshold-critical: "5"
+            dash0-threshold-degraded: "2"
+    - name: latency
+      interval: 5m
+      rules:
+        - alert: PaymentLatencyHigh
+          expr: histogram_quantile(0.99, sum by (le) (rate({otel_metric_name="dash0.spans.duration", service_name="paymentservice"}[5m]))) > $__threshold
+          annotations:
+            dash0-threshold-critical: "2"
+    - name: aggregations
+      interval: 1m
+      rules:
+        - record: service_name:dash0_spans_duration:p99_5m
+          expr: histogram_quantile(0.99, sum by (le, service_name) (rate({otel_metric_name="dash0.spans.duration", service_name="paymentservice"}[5m])))
+EOF
+}
@@ -0,0 +1,2 @@
+#!/bin/bash
+terraform import dash0_resource.name "{{ kind }},{{ dataset }},{{ id_or_origin }}"
\ No newline at end of file
@@ -0,0 +1,7 @@
+# Signal-to-metrics rules have no dedicated resource yet, so they are managed
+# through the generic asset endpoint at /api/signal-to-metrics.
+resource "dash0_resource" "checkout_errors" {
+  kind            = "signal-to-metrics"
+  dataset         = "default"
+  definition_yaml = file("${path.module}/signal_to_metrics.yaml")
+}
@@ -0,0 +1,2 @@
+#!/bin/bash
+terraform import dash0_slo.checkout_availability production,tf_existing-slo-origin
@@ -0,0 +1,34 @@
+resource "dash0_slo" "checkout_availability" {
+  dataset = "production"
+
+  slo_yaml = <<-EOF
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: checkout-availability
+  annotations:
+    dash0.com/display-name: Checkout availability
+spec:
+  description: 99.5% of checkout requests succeed over 28 days.
+  service: checkout
+  budgetingMethod: Occurrences
+  indicator:
+    spec:
+      ratioMetric:
+        counter: true
+        good:
+          metricSource:
+            spec:
+              query: http_requests_total{service="checkout",status!~"5.."}
+        total:
+          metricSource:
+            spec:
+              query: http_requests_total{service="checkout"}
+  objectives:
+    - displayName: Availability
+      target: 0.995
+  timeWindow:
+    - duration: 28d
+      isRolling: true
+EOF
+}
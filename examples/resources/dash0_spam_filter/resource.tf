@@ -24,12 +24,34 @@ resource "dash0_spam_filter" "drop_debug_logs" {
 apiVersion: v1alpha2
 kind: Dash0SpamFilter
 metadata:
-  name: Drop debug logs
+  name: Drop debug logs of the batch namespace
 spec:
   context: log
   filter:
+    - key: "k8s.namespace.name"
+      operator: "is"
+      value: "batch"
     - key: "severity_text"
       operator: "is"
       value: "DEBUG"
 EOF
 }
+
+resource "dash0_spam_filter" "drop_health_check_spans" {
+  dataset = "default"
+
+  spam_filter_yaml = <<-EOF
+apiVersion: v1alpha2
+kind: Dash0SpamFilter
+metadata:
+  name: Drop health check spans
+spec:
+  context: span
+  filter:
+    - key: "http.route"
+      operator: "is_one_of"
+      values:
+        - "/healthz"
+        - "/readyz"
+EOF
+}
@@ -8,8 +8,9 @@ resource "dash0_synthetic_check" "my_check" {
 #
 # `dash0_notification_channel` exposes a computed `id` attribute (the
 # server-assigned UUID, resolved by the provider after creation). The
-# synthetic check references that id in `spec.notifications.channels`,
-# which requires raw UUIDs rather than the `tf_`-prefixed origin.
+# synthetic check references that id in `notification_channel_ids`, which the
+# provider writes into `spec.notifications.channels`; the channels require raw
+# UUIDs rather than the `tf_`-prefixed origin.
 resource "dash0_notification_channel" "team_oncall" {
   for_each = {
     backend  = "backend-oncall@example.com"
@@ -32,7 +33,8 @@ YAML
 }
 
 resource "dash0_synthetic_check" "checkout_api" {
-  dataset = "default"
+  dataset                  = "default"
+  notification_channel_ids = [dash0_notification_channel.team_oncall["sre"].id]
 
   synthetic_check_yaml = <<-YAML
 kind: Dash0SyntheticCheck
@@ -40,9 +42,6 @@ metadata:
   name: checkout-api
 spec:
   enabled: true
-  notifications:
-    channels:
-      - ${dash0_notification_channel.team_oncall["sre"].id}
   plugin:
     display:
       name: checkout-api
@@ -77,3 +76,56 @@ spec:
     strategy: all_locations
 YAML
 }
+
+# Stamping out per-environment copies of the same check from a single module.
+# The prefix is prepended to `metadata.name` in the YAML, so every preview
+# environment gets a uniquely named check.
+variable "environment" {
+  type    = string
+  default = "pr-42"
+}
+
+resource "dash0_synthetic_check" "preview" {
+  dataset              = "default"
+  synthetic_check_yaml = file("${path.module}/synthetic_check.yaml")
+  name_prefix          = "${var.environment}-"
+}
+
+# Managing who can change a check. When `permissions` is set, the listed
+# permissions replace the defaults the API assigns and drift in them is
+# detected; without it, the API's default permissions are left alone.
+resource "dash0_synthetic_check" "restricted" {
+  dataset              = "default"
+  synthetic_check_yaml = file("${path.module}/synthetic_check.yaml")
+  name_suffix          = "-restricted"
+
+  permissions = [
+    {
+      role    = "admin"
+      actions = ["synthetic_check:read", "synthetic_check:write", "synthetic_check:delete"]
+    },
+    {
+      role    = "basic_member"
+      actions = ["synthetic_check:read"]
+    },
+  ]
+}
+
+# Sending a probe credential without storing it in state. `secret_headers` is
+# write-only, so its value can come from an ephemeral resource; bump
+# `secret_headers_version` whenever the credential is rotated.
+ephemeral "vault_kv_secret_v2" "probe" {
+  mount = "secret"
+  name  = "synthetics/checkout"
+}
+
+resource "dash0_synthetic_check" "authenticated" {
+  dataset              = "default"
+  synthetic_check_yaml = file("${path.module}/synthetic_check.yaml")
+  name_suffix          = "-authenticated"
+
+  secret_headers = {
+    Authorization = "Bearer ${ephemeral.vault_kv_secret_v2.probe.data.token}"
+  }
+  secret_headers_version = 1
+}
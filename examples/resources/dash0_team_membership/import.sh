@@ -0,0 +1,4 @@
+#!/bin/bash
+# The import ID is the team origin or id and the member's email address or
+# id, separated by a comma.
+terraform import dash0_team_membership.name "{{ team }},{{ member }}"
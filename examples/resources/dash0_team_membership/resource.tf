@@ -0,0 +1,25 @@
+# Add members to a team one at a time, for example from the Terraform
+# configuration of the service a person works on. The team itself can be
+# managed elsewhere; leave spec.members empty there so dash0_team does not
+# remove these memberships on its next update.
+resource "dash0_team" "backend" {
+  team_yaml = <<-YAML
+apiVersion: dash0.com/v1alpha1
+kind: Dash0Team
+metadata:
+  name: backend-team
+spec:
+  display:
+    name: Backend Team
+  members: []
+YAML
+
+  lifecycle {
+    ignore_changes = [team_yaml]
+  }
+}
+
+resource "dash0_team_membership" "alice" {
+  team   = dash0_team.backend.origin
+  member = "alice@example.com"
+}
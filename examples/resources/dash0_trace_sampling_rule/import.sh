@@ -0,0 +1,2 @@
+#!/bin/bash
+terraform import dash0_trace_sampling_rule.checkout_errors production,tf_existing-sampling-rule-origin
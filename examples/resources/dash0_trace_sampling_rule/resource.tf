@@ -0,0 +1,42 @@
+# Keep every erroneous checkout trace, up to 120 traces per minute.
+resource "dash0_trace_sampling_rule" "checkout_errors" {
+  dataset = "production"
+
+  sampling_rule_yaml = <<-EOF
+kind: Dash0Sampling
+metadata:
+  name: checkout-errors
+spec:
+  enabled: true
+  conditions:
+    kind: and
+    spec:
+      conditions:
+        - kind: error
+          spec: {}
+        - kind: ottl
+          spec:
+            ottl: resource.attributes["service.name"] == "checkout"
+  rateLimit:
+    rate: 120
+EOF
+}
+
+# Roll out the same baseline rate to several datasets.
+resource "dash0_trace_sampling_rule" "baseline" {
+  for_each = toset(["staging", "production"])
+
+  dataset = each.key
+
+  sampling_rule_yaml = <<-EOF
+kind: Dash0Sampling
+metadata:
+  name: baseline-10-percent
+spec:
+  enabled: true
+  conditions:
+    kind: probabilistic
+    spec:
+      rate: 0.1
+EOF
+}
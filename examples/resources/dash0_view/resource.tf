@@ -1,4 +1,13 @@
 resource "dash0_view" "my_check" {
   dataset   = "default"
   view_yaml = file("${path.module}/view.yaml")
-}
\ No newline at end of file
+}
+
+# Migrating a view that was created in the Dash0 UI: with `adopt`, the
+# provider takes over the existing view with the same name instead of creating
+# a duplicate.
+resource "dash0_view" "adopted" {
+  dataset           = "default"
+  view_yaml         = file("${path.module}/view.yaml")
+  conflict_strategy = "adopt"
+}
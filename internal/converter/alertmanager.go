@@ -0,0 +1,351 @@
+package converter
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AlertmanagerConversion is the result of converting an Alertmanager
+// configuration into Dash0 notification channels.
+type AlertmanagerConversion struct {
+	// NotificationChannels maps a key of the form receiver/integration/index,
+	// for example "oncall/pagerduty/0", to the YAML definition of a
+	// Dash0NotificationChannel.
+	NotificationChannels map[string]string
+	// Routes is the flattened route tree, in depth-first order with every
+	// route listed before its child routes.
+	Routes []AlertmanagerRoute
+	// Warnings describe the parts of the configuration that could not be
+	// converted faithfully.
+	Warnings []string
+}
+
+// AlertmanagerRoute is a route of the Alertmanager route tree with the
+// matchers it inherits from its parents.
+type AlertmanagerRoute struct {
+	Receiver string
+	// Matchers are the matchers of the route and its parents, in the
+	// Alertmanager syntax, for example `severity="critical"`.
+	Matchers []string
+	// NotificationChannels are the keys of the channels converted from the
+	// route's receiver.
+	NotificationChannels []string
+	Continue             bool
+}
+
+type alertmanagerConfig struct {
+	Global struct {
+		SlackAPIURL    string `yaml:"slack_api_url"`
+		PagerdutyURL   string `yaml:"pagerduty_url"`
+		OpsgenieAPIURL string `yaml:"opsgenie_api_url"`
+		OpsgenieAPIKey string `yaml:"opsgenie_api_key"`
+	} `yaml:"global"`
+	Route     *alertmanagerRouteConfig `yaml:"route"`
+	Receivers []map[string]interface{} `yaml:"receivers"`
+}
+
+type alertmanagerRouteConfig struct {
+	Receiver string                     `yaml:"receiver"`
+	Matchers []string                   `yaml:"matchers"`
+	Match    map[string]string          `yaml:"match"`
+	MatchRE  map[string]string          `yaml:"match_re"`
+	Continue bool                       `yaml:"continue"`
+	Routes   []*alertmanagerRouteConfig `yaml:"routes"`
+}
+
+// alertmanagerMatcher is a parsed Alertmanager label matcher.
+type alertmanagerMatcher struct {
+	Name     string
+	Operator string
+	Value    string
+}
+
+func (m alertmanagerMatcher) String() string {
+	return m.Name + m.Operator + strconv.Quote(m.Value)
+}
+
+// alertmanagerMatcherPattern matches `name op value`, where the name and the
+// value may be double-quoted.
+var alertmanagerMatcherPattern = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*|"(?:[^"\\]|\\.)*")\s*(=~|!~|!=|=)\s*(.*?)\s*$`)
+
+// dash0FilterOperators maps Alertmanager matcher operators to the operators
+// of Dash0 attribute filters.
+var dash0FilterOperators = map[string]string{
+	"=":  "is",
+	"!=": "is_not",
+	"=~": "matches",
+	"!~": "does_not_match",
+}
+
+// ConvertAlertmanagerConfig converts the receivers of an Alertmanager
+// configuration into Dash0 notification channels, and the matchers of the
+// routes leading to a receiver into the routing filters of its channels.
+//
+// Dash0 routes an alert to every channel whose filters match, while
+// Alertmanager routes it to the first matching route only, unless `continue`
+// is set. The conversion therefore approximates the route tree: the channels
+// of a receiver are filtered by the matchers of the routes leading to it,
+// regardless of the routes matched before them.
+func ConvertAlertmanagerConfig(yamlStr string) (AlertmanagerConversion, error) {
+	var cfg alertmanagerConfig
+	if err := yaml.Unmarshal([]byte(yamlStr), &cfg); err != nil {
+		return AlertmanagerConversion{}, fmt.Errorf("error parsing Alertmanager configuration: %w", err)
+	}
+
+	result := AlertmanagerConversion{NotificationChannels: map[string]string{}}
+	warn := func(format string, args ...interface{}) {
+		result.Warnings = append(result.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	// Flatten the route tree, collecting the matcher sets per receiver.
+	matchersByReceiver := map[string][][]alertmanagerMatcher{}
+	var walk func(route *alertmanagerRouteConfig, receiver string, inherited []alertmanagerMatcher) error
+	walk = func(route *alertmanagerRouteConfig, receiver string, inherited []alertmanagerMatcher) error {
+		if route.Receiver != "" {
+			receiver = route.Receiver
+		}
+		own, err := routeMatchers(route)
+		if err != nil {
+			return err
+		}
+		matchers := append(slices.Clone(inherited), own...)
+
+		r := AlertmanagerRoute{Receiver: receiver, Matchers: []string{}, NotificationChannels: []string{}, Continue: route.Continue}
+		for _, m := range matchers {
+			r.Matchers = append(r.Matchers, m.String())
+		}
+		result.Routes = append(result.Routes, r)
+		matchersByReceiver[receiver] = append(matchersByReceiver[receiver], matchers)
+
+		for _, child := range route.Routes {
+			if child == nil {
+				continue
+			}
+			if err := walk(child, receiver, matchers); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if cfg.Route != nil {
+		if err := walk(cfg.Route, "", nil); err != nil {
+			return AlertmanagerConversion{}, err
+		}
+	}
+
+	channelsByReceiver := map[string][]string{}
+	for _, receiver := range cfg.Receivers {
+		name, _ := receiver["name"].(string)
+		if name == "" {
+			warn("A receiver without a name was skipped.")
+			continue
+		}
+
+		filters, filtered := receiverFilters(name, matchersByReceiver[name], warn)
+		integrations := receiverIntegrations(cfg, name, receiver, warn)
+		for _, integration := range integrations {
+			key := fmt.Sprintf("%s/%s/%d", name, integration.integration, integration.index)
+			channelName := name
+			if len(integrations) > 1 {
+				channelName = fmt.Sprintf("%s-%s-%d", name, integration.integration, integration.index)
+			}
+			spec := map[string]interface{}{
+				"type":   integration.channelType,
+				"config": integration.config,
+			}
+			if filtered {
+				spec["routing"] = map[string]interface{}{"filters": filters}
+			}
+			channelYAML, err := encodeYAML(map[string]interface{}{
+				"kind":     "Dash0NotificationChannel",
+				"metadata": map[string]interface{}{"name": channelName},
+				"spec":     spec,
+			})
+			if err != nil {
+				return AlertmanagerConversion{}, err
+			}
+			result.NotificationChannels[key] = channelYAML
+			channelsByReceiver[name] = append(channelsByReceiver[name], key)
+		}
+	}
+
+	for i, route := range result.Routes {
+		if keys, ok := channelsByReceiver[route.Receiver]; ok {
+			result.Routes[i].NotificationChannels = keys
+		}
+	}
+	return result, nil
+}
+
+// routeMatchers returns the matchers of a route, from the `matchers` list and
+// the deprecated `match` and `match_re` maps.
+func routeMatchers(route *alertmanagerRouteConfig) ([]alertmanagerMatcher, error) {
+	var matchers []alertmanagerMatcher
+	for _, s := range route.Matchers {
+		m, err := parseAlertmanagerMatcher(s)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	for _, name := range slices.Sorted(maps.Keys(route.Match)) {
+		matchers = append(matchers, alertmanagerMatcher{Name: name, Operator: "=", Value: route.Match[name]})
+	}
+	for _, name := range slices.Sorted(maps.Keys(route.MatchRE)) {
+		matchers = append(matchers, alertmanagerMatcher{Name: name, Operator: "=~", Value: route.MatchRE[name]})
+	}
+	return matchers, nil
+}
+
+func parseAlertmanagerMatcher(s string) (alertmanagerMatcher, error) {
+	groups := alertmanagerMatcherPattern.FindStringSubmatch(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "{"), "}"))
+	if groups == nil {
+		return alertmanagerMatcher{}, fmt.Errorf("invalid route matcher %q", s)
+	}
+	m := alertmanagerMatcher{Name: groups[1], Operator: groups[2], Value: groups[3]}
+	for _, field := range []*string{&m.Name, &m.Value} {
+		if strings.HasPrefix(*field, `"`) {
+			unquoted, err := strconv.Unquote(*field)
+			if err != nil {
+				return alertmanagerMatcher{}, fmt.Errorf("invalid route matcher %q: %w", s, err)
+			}
+			*field = unquoted
+		}
+	}
+	return m, nil
+}
+
+// receiverFilters returns the Dash0 routing filters of the channels of a
+// receiver, and whether the channels are filtered at all. Dash0 filters are a
+// list of groups that match when all of their conditions match; each route
+// leading to the receiver becomes one group. A route without matchers matches
+// every alert, so the channels are then left unfiltered.
+func receiverFilters(receiver string, matcherSets [][]alertmanagerMatcher, warn func(string, ...interface{})) ([]interface{}, bool) {
+	if len(matcherSets) == 0 {
+		warn("Receiver %q is not referenced by any route; its channels are converted without routing filters.", receiver)
+		return nil, false
+	}
+
+	var groups [][]alertmanagerMatcher
+	for _, set := range matcherSets {
+		if len(set) == 0 {
+			return nil, false
+		}
+		if !slices.ContainsFunc(groups, func(g []alertmanagerMatcher) bool { return slices.Equal(g, set) }) {
+			groups = append(groups, set)
+		}
+	}
+
+	filters := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		conditions := make([]interface{}, 0, len(group))
+		for _, m := range group {
+			value := m.Value
+			if m.Operator == "=~" || m.Operator == "!~" {
+				// Alertmanager anchors regular expressions at both ends.
+				value = "^(?:" + value + ")$"
+			}
+			conditions = append(conditions, map[string]interface{}{
+				"key":      m.Name,
+				"operator": dash0FilterOperators[m.Operator],
+				"value":    value,
+			})
+		}
+		filters = append(filters, conditions)
+	}
+	return filters, true
+}
+
+// convertedIntegration is a receiver integration converted into the type and
+// config of a Dash0 notification channel.
+type convertedIntegration struct {
+	integration string
+	index       int
+	channelType string
+	config      map[string]interface{}
+}
+
+// alertmanagerIntegrations lists the supported Alertmanager integrations in
+// the order their channels are generated.
+var alertmanagerIntegrations = []string{"email", "pagerduty", "slack", "opsgenie", "webhook", "msteams", "discord"}
+
+// receiverIntegrations converts the integrations of a receiver. Unsupported
+// integrations and integrations that cannot be converted are reported through
+// warn.
+func receiverIntegrations(cfg alertmanagerConfig, name string, receiver map[string]interface{}, warn func(string, ...interface{})) []convertedIntegration {
+	for _, key := range slices.Sorted(maps.Keys(receiver)) {
+		integration, ok := strings.CutSuffix(key, "_configs")
+		if ok && !slices.Contains(alertmanagerIntegrations, integration) {
+			warn("Receiver %q: %s has no Dash0 equivalent and was skipped.", name, key)
+		}
+	}
+
+	var converted []convertedIntegration
+	for _, integration := range alertmanagerIntegrations {
+		configs, _ := receiver[integration+"_configs"].([]interface{})
+		for i, c := range configs {
+			fields, _ := c.(map[string]interface{})
+			str := func(field string) string {
+				s, _ := fields[field].(string)
+				return s
+			}
+			where := fmt.Sprintf("Receiver %q: %s_configs[%d]", name, integration, i)
+			fieldNames := slices.Sorted(maps.Keys(fields))
+			if i := slices.IndexFunc(fieldNames, func(field string) bool { return strings.HasSuffix(field, "_file") }); i >= 0 {
+				warn("%s reads %s from a file, which cannot be converted; the channel was skipped.", where, fieldNames[i])
+				continue
+			}
+
+			var channelType string
+			var config map[string]interface{}
+			switch integration {
+			case "email":
+				var recipients []interface{}
+				for _, to := range strings.Split(str("to"), ",") {
+					if to = strings.TrimSpace(to); to != "" {
+						recipients = append(recipients, to)
+					}
+				}
+				channelType, config = "email_v2", map[string]interface{}{"recipients": recipients}
+			case "pagerduty":
+				key := cmp.Or(str("routing_key"), str("service_key"))
+				url := cmp.Or(str("url"), cfg.Global.PagerdutyURL, "https://events.pagerduty.com/v2/enqueue")
+				channelType, config = "pagerduty", map[string]interface{}{"key": key, "url": url}
+			case "slack":
+				url := cmp.Or(str("api_url"), cfg.Global.SlackAPIURL)
+				channelType, config = "slack", map[string]interface{}{"webhookURL": url, "channel": str("channel")}
+			case "opsgenie":
+				instance := "us"
+				if strings.Contains(cmp.Or(str("api_url"), cfg.Global.OpsgenieAPIURL), ".eu.") {
+					instance = "eu"
+				}
+				channelType, config = "opsgenie", map[string]interface{}{"apiKey": cmp.Or(str("api_key"), cfg.Global.OpsgenieAPIKey), "instance": instance}
+			case "webhook":
+				channelType, config = "webhook", map[string]interface{}{"url": str("url")}
+			case "msteams":
+				channelType, config = "teams_webhook", map[string]interface{}{"url": str("webhook_url")}
+			case "discord":
+				channelType, config = "discord_webhook", map[string]interface{}{"url": str("webhook_url")}
+			}
+
+			for _, value := range config {
+				if s, ok := value.(string); ok && strings.Contains(s, "{{") {
+					warn("%s uses a template, which Dash0 does not evaluate; the value was copied unchanged.", where)
+					break
+				}
+			}
+			converted = append(converted, convertedIntegration{integration: integration, index: i, channelType: channelType, config: config})
+		}
+	}
+	if len(converted) == 0 {
+		warn("Receiver %q has no integration that could be converted.", name)
+	}
+	return converted
+}
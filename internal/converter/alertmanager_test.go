@@ -0,0 +1,139 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testAlertmanagerConfig = `global:
+  slack_api_url: https://hooks.slack.com/services/T000/B000/XXX
+route:
+  receiver: default
+  routes:
+    - receiver: oncall
+      matchers:
+        - severity="critical"
+        - team=~"payments|checkout"
+    - receiver: frontend
+      match:
+        team: frontend
+receivers:
+  - name: default
+    email_configs:
+      - to: alerts@example.com, sre@example.com
+  - name: oncall
+    pagerduty_configs:
+      - routing_key: abc123
+    slack_configs:
+      - channel: "#oncall"
+  - name: frontend
+    webhook_configs:
+      - url: https://hooks.example.com/frontend
+    sns_configs:
+      - topic_arn: arn:aws:sns:eu-west-1:123456789012:alerts
+`
+
+func parseChannel(t *testing.T, channelYAML string) map[string]interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(channelYAML), &doc))
+	return doc
+}
+
+func TestConvertAlertmanagerConfig(t *testing.T) {
+	result, err := ConvertAlertmanagerConfig(testAlertmanagerConfig)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"default/email/0", "oncall/pagerduty/0", "oncall/slack/0", "frontend/webhook/0"}, keysOf(result.NotificationChannels))
+
+	assert.Equal(t, map[string]interface{}{
+		"kind":     "Dash0NotificationChannel",
+		"metadata": map[string]interface{}{"name": "default"},
+		"spec": map[string]interface{}{
+			"type":   "email_v2",
+			"config": map[string]interface{}{"recipients": []interface{}{"alerts@example.com", "sre@example.com"}},
+		},
+	}, parseChannel(t, result.NotificationChannels["default/email/0"]))
+
+	assert.Equal(t, map[string]interface{}{
+		"kind":     "Dash0NotificationChannel",
+		"metadata": map[string]interface{}{"name": "oncall-slack-0"},
+		"spec": map[string]interface{}{
+			"type":   "slack",
+			"config": map[string]interface{}{"webhookURL": "https://hooks.slack.com/services/T000/B000/XXX", "channel": "#oncall"},
+			"routing": map[string]interface{}{"filters": []interface{}{
+				[]interface{}{
+					map[string]interface{}{"key": "severity", "operator": "is", "value": "critical"},
+					map[string]interface{}{"key": "team", "operator": "matches", "value": "^(?:payments|checkout)$"},
+				},
+			}},
+		},
+	}, parseChannel(t, result.NotificationChannels["oncall/slack/0"]))
+
+	assert.Equal(t, []AlertmanagerRoute{
+		{Receiver: "default", Matchers: []string{}, NotificationChannels: []string{"default/email/0"}},
+		{Receiver: "oncall", Matchers: []string{`severity="critical"`, `team=~"payments|checkout"`}, NotificationChannels: []string{"oncall/pagerduty/0", "oncall/slack/0"}},
+		{Receiver: "frontend", Matchers: []string{`team="frontend"`}, NotificationChannels: []string{"frontend/webhook/0"}},
+	}, result.Routes)
+
+	assert.Equal(t, []string{`Receiver "frontend": sns_configs has no Dash0 equivalent and was skipped.`}, result.Warnings)
+}
+
+func TestConvertAlertmanagerConfig_ReceiverOnSeveralRoutes(t *testing.T) {
+	result, err := ConvertAlertmanagerConfig(`route:
+  receiver: default
+  routes:
+    - receiver: team
+      matchers: [team="a"]
+    - receiver: team
+      matchers: [team="b"]
+receivers:
+  - name: default
+  - name: team
+    msteams_configs:
+      - webhook_url: https://example.webhook.office.com/a
+`)
+	require.NoError(t, err)
+
+	spec := parseChannel(t, result.NotificationChannels["team/msteams/0"])["spec"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"filters": []interface{}{
+		[]interface{}{map[string]interface{}{"key": "team", "operator": "is", "value": "a"}},
+		[]interface{}{map[string]interface{}{"key": "team", "operator": "is", "value": "b"}},
+	}}, spec["routing"])
+	assert.Equal(t, []string{`Receiver "default" has no integration that could be converted.`}, result.Warnings)
+}
+
+func TestConvertAlertmanagerConfig_Invalid(t *testing.T) {
+	_, err := ConvertAlertmanagerConfig("route: [")
+	assert.Error(t, err)
+
+	_, err = ConvertAlertmanagerConfig("route:\n  receiver: default\n  matchers: ['severity']\n")
+	assert.ErrorContains(t, err, `invalid route matcher "severity"`)
+}
+
+func TestParseAlertmanagerMatcher(t *testing.T) {
+	tests := map[string]alertmanagerMatcher{
+		`severity="critical"`:      {Name: "severity", Operator: "=", Value: "critical"},
+		`severity = critical`:      {Name: "severity", Operator: "=", Value: "critical"},
+		`{team!~"a|b"}`:            {Name: "team", Operator: "!~", Value: "a|b"},
+		`"service name"!="web \""`: {Name: "service name", Operator: "!=", Value: `web "`},
+	}
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			m, err := parseAlertmanagerMatcher(input)
+			require.NoError(t, err)
+			assert.Equal(t, expected, m)
+		})
+	}
+}
+
+func keysOf(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
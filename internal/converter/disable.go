@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CheckRuleEnabledAnnotation is the Prometheus rule annotation that Dash0 uses
+// to enable or disable an individual check rule.
+const CheckRuleEnabledAnnotation = "dash0-enabled"
+
+// DisableSyntheticCheck sets spec.enabled to false in a synthetic check YAML
+// document and returns the resulting YAML.
+func DisableSyntheticCheck(yamlStr string) (string, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing synthetic check YAML: %w", err)
+	}
+
+	spec, ok := doc["spec"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("synthetic check definition has no spec")
+	}
+	spec["enabled"] = false
+
+	return encodeYAML(doc)
+}
+
+// DisableCheckRule sets the dash0-enabled annotation to "false" on every rule
+// of a Prometheus rule YAML document and returns the resulting YAML.
+func DisableCheckRule(yamlStr string) (string, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing check rule YAML: %w", err)
+	}
+
+	spec, _ := doc["spec"].(map[string]interface{})
+	groups, _ := spec["groups"].([]interface{})
+	disabled := 0
+	for _, g := range groups {
+		group, _ := g.(map[string]interface{})
+		rules, _ := group["rules"].([]interface{})
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			annotations, ok := rule["annotations"].(map[string]interface{})
+			if !ok {
+				annotations = map[string]interface{}{}
+				rule["annotations"] = annotations
+			}
+			annotations[CheckRuleEnabledAnnotation] = "false"
+			disabled++
+		}
+	}
+	if disabled == 0 {
+		return "", fmt.Errorf("check rule definition has no rules under spec.groups")
+	}
+
+	return encodeYAML(doc)
+}
@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDisableSyntheticCheck(t *testing.T) {
+	disabled, err := DisableSyntheticCheck("kind: Dash0SyntheticCheck\nmetadata:\n  name: checkout\nspec:\n  enabled: true\n")
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(disabled), &doc))
+	assert.Equal(t, false, doc["spec"].(map[string]interface{})["enabled"])
+
+	_, err = DisableSyntheticCheck("kind: Dash0SyntheticCheck\n")
+	assert.Error(t, err)
+}
+
+func TestDisableCheckRule(t *testing.T) {
+	disabled, err := DisableCheckRule(`
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: adservice
+spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: adservice
+          expr: up == 0
+          annotations:
+            dash0-enabled: true
+            summary: down
+        - alert: cartservice
+          expr: up == 0
+`)
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(disabled), &doc))
+	rules := doc["spec"].(map[string]interface{})["groups"].([]interface{})[0].(map[string]interface{})["rules"].([]interface{})
+	require.Len(t, rules, 2)
+	for _, r := range rules {
+		annotations := r.(map[string]interface{})["annotations"].(map[string]interface{})
+		assert.Equal(t, "false", annotations[CheckRuleEnabledAnnotation])
+	}
+	assert.Equal(t, "down", rules[0].(map[string]interface{})["annotations"].(map[string]interface{})["summary"])
+
+	_, err = DisableCheckRule("kind: PrometheusRule\nspec:\n  groups: []\n")
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package converter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SetSyntheticCheckHeaders adds the given headers to
+// spec.plugin.spec.request.headers of an HTTP synthetic check YAML document
+// and returns the resulting YAML. A header the document already declares is
+// replaced; header names are compared case-insensitively. An empty or nil map
+// returns the document unchanged.
+func SetSyntheticCheckHeaders(yamlStr string, headers map[string]string) (string, error) {
+	if len(headers) == 0 {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing synthetic check YAML: %w", err)
+	}
+	request, err := syntheticCheckRequest(doc)
+	if err != nil {
+		return "", err
+	}
+	if request == nil {
+		return "", fmt.Errorf("synthetic check definition has no spec.plugin.spec.request; headers can only be set on HTTP checks")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	existing, _ := request["headers"].([]interface{})
+	merged := withoutHeaders(existing, names)
+	for _, name := range names {
+		merged = append(merged, map[string]interface{}{"name": name, "value": headers[name]})
+	}
+	request["headers"] = merged
+
+	return encodeYAML(doc)
+}
+
+// RemoveSyntheticCheckHeaders removes the headers with the given names from
+// spec.plugin.spec.request.headers of a synthetic check YAML or JSON document
+// and returns the resulting YAML. Header names are compared
+// case-insensitively. The document is returned unchanged when it declares none
+// of the headers.
+func RemoveSyntheticCheckHeaders(yamlStr string, names []string) (string, error) {
+	if len(names) == 0 {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing synthetic check YAML: %w", err)
+	}
+	request, err := syntheticCheckRequest(doc)
+	if err != nil || request == nil {
+		return yamlStr, err
+	}
+
+	existing, _ := request["headers"].([]interface{})
+	remaining := withoutHeaders(existing, names)
+	if len(remaining) == len(existing) {
+		return yamlStr, nil
+	}
+	request["headers"] = remaining
+
+	return encodeYAML(doc)
+}
+
+// syntheticCheckRequest returns the spec.plugin.spec.request mapping of a
+// synthetic check document, or nil when the document does not declare one.
+func syntheticCheckRequest(doc map[string]interface{}) (map[string]interface{}, error) {
+	current := doc
+	for _, key := range []string{"spec", "plugin", "spec", "request"} {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			if current[key] != nil {
+				return nil, fmt.Errorf("%s must be a mapping, got %T", key, current[key])
+			}
+			return nil, nil
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// withoutHeaders returns the header entries whose name is not in names.
+func withoutHeaders(headers []interface{}, names []string) []interface{} {
+	kept := make([]interface{}, 0, len(headers))
+	for _, h := range headers {
+		entry, _ := h.(map[string]interface{})
+		name, _ := entry["name"].(string)
+		if slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, name) }) {
+			continue
+		}
+		kept = append(kept, h)
+	}
+	return kept
+}
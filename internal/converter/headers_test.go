@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const headersCheckYAML = `kind: Dash0SyntheticCheck
+metadata:
+  name: checkout
+spec:
+  plugin:
+    kind: http
+    spec:
+      request:
+        method: get
+        url: https://checkout.example.com
+        headers:
+          - name: Accept
+            value: application/json
+          - name: authorization
+            value: placeholder
+`
+
+func requestHeaders(t *testing.T, yamlStr string) []interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(yamlStr), &doc))
+	request, err := syntheticCheckRequest(doc)
+	require.NoError(t, err)
+	headers, _ := request["headers"].([]interface{})
+	return headers
+}
+
+func TestSetSyntheticCheckHeaders(t *testing.T) {
+	out, err := SetSyntheticCheckHeaders(headersCheckYAML, nil)
+	require.NoError(t, err)
+	assert.Equal(t, headersCheckYAML, out)
+
+	out, err = SetSyntheticCheckHeaders(headersCheckYAML, map[string]string{
+		"X-Api-Key":     "key",
+		"Authorization": "Bearer token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "Accept", "value": "application/json"},
+		map[string]interface{}{"name": "Authorization", "value": "Bearer token"},
+		map[string]interface{}{"name": "X-Api-Key", "value": "key"},
+	}, requestHeaders(t, out))
+}
+
+func TestSetSyntheticCheckHeaders_NoRequest(t *testing.T) {
+	_, err := SetSyntheticCheckHeaders("kind: Dash0SyntheticCheck\nspec:\n  plugin:\n    kind: dns\n", map[string]string{"Authorization": "Bearer token"})
+	assert.Error(t, err)
+}
+
+func TestRemoveSyntheticCheckHeaders(t *testing.T) {
+	out, err := RemoveSyntheticCheckHeaders(headersCheckYAML, []string{"Authorization"})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "Accept", "value": "application/json"},
+	}, requestHeaders(t, out))
+
+	out, err = RemoveSyntheticCheckHeaders(headersCheckYAML, []string{"X-Api-Key"})
+	require.NoError(t, err)
+	assert.Equal(t, headersCheckYAML, out)
+
+	const checkJSON = `{"kind":"Dash0SyntheticCheck","spec":{"plugin":{"kind":"dns"}}}`
+	out, err = RemoveSyntheticCheckHeaders(checkJSON, []string{"Authorization"})
+	require.NoError(t, err)
+	assert.Equal(t, checkJSON, out)
+}
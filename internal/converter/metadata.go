@@ -0,0 +1,228 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MergeMetadata merges the given labels and annotations into the
+// metadata.labels and metadata.annotations maps of a resource YAML document
+// and returns the resulting YAML. Keys supplied here take precedence over keys
+// already present in the document. Empty or nil maps leave the corresponding
+// section untouched, so a document without provider-managed metadata is
+// returned semantically unchanged.
+//
+// Because every write to the Dash0 API replaces the whole document, a key that
+// is removed from the provider-managed maps is dropped from the asset on the
+// next update without any extra bookkeeping.
+func MergeMetadata(yamlStr string, labels, annotations map[string]string) (string, error) {
+	if len(labels) == 0 && len(annotations) == 0 {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+
+	metadata, ok := doc["metadata"].(map[string]interface{})
+	if !ok {
+		if doc["metadata"] != nil {
+			return "", fmt.Errorf("metadata must be a mapping, got %T", doc["metadata"])
+		}
+		metadata = map[string]interface{}{}
+		doc["metadata"] = metadata
+	}
+
+	if err := mergeStringMap(metadata, "labels", labels); err != nil {
+		return "", err
+	}
+	if err := mergeStringMap(metadata, "annotations", annotations); err != nil {
+		return "", err
+	}
+
+	return encodeYAML(doc)
+}
+
+// MergeDefaultLabels adds the given labels to metadata.labels of a resource
+// YAML document and returns the resulting YAML. Unlike MergeMetadata, labels
+// the document already declares are kept, so the document takes precedence
+// over the defaults. An empty or nil map returns the document unchanged.
+func MergeDefaultLabels(yamlStr string, labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+
+	metadata, ok := doc["metadata"].(map[string]interface{})
+	if !ok {
+		if doc["metadata"] != nil {
+			return "", fmt.Errorf("metadata must be a mapping, got %T", doc["metadata"])
+		}
+		metadata = map[string]interface{}{}
+		doc["metadata"] = metadata
+	}
+
+	defaults := map[string]string{}
+	declared, _ := metadata["labels"].(map[string]interface{})
+	for k, v := range labels {
+		if _, ok := declared[k]; !ok {
+			defaults[k] = v
+		}
+	}
+	if len(defaults) == 0 {
+		return yamlStr, nil
+	}
+	if err := mergeStringMap(metadata, "labels", defaults); err != nil {
+		return "", err
+	}
+
+	return encodeYAML(doc)
+}
+
+// ChangedMetadata returns the entries of expected whose key metadata.<section>
+// of a resource document holds with a different value, mapped to the value
+// held by the document. section is "labels" or "annotations". Keys the
+// document does not hold are not reported, as the Dash0 API only retains the
+// label keys it supports for each asset type. The document may be YAML or
+// JSON.
+func ChangedMetadata(yamlStr, section string, expected map[string]string) (map[string]string, error) {
+	if len(expected) == 0 {
+		return nil, nil
+	}
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	actual, _ := metadata[section].(map[string]interface{})
+
+	changed := map[string]string{}
+	for k, v := range expected {
+		value, ok := actual[k]
+		if !ok {
+			continue
+		}
+		if s := fmt.Sprint(value); s != v {
+			changed[k] = s
+		}
+	}
+	return changed, nil
+}
+
+// MetadataKeys returns the keys of metadata.<section> of a resource YAML
+// document, where section is "labels" or "annotations". It returns nil when
+// the document cannot be parsed or does not declare the section.
+func MetadataKeys(yamlStr, section string) map[string]bool {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil
+	}
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	values, _ := metadata[section].(map[string]interface{})
+	if len(values) == 0 {
+		return nil
+	}
+	keys := make(map[string]bool, len(values))
+	for k := range values {
+		keys[k] = true
+	}
+	return keys
+}
+
+// mergeStringMap merges values into metadata[key], creating the nested map
+// when the document does not declare one yet.
+func mergeStringMap(metadata map[string]interface{}, key string, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	target, ok := metadata[key].(map[string]interface{})
+	if !ok {
+		if metadata[key] != nil {
+			return fmt.Errorf("metadata.%s must be a mapping, got %T", key, metadata[key])
+		}
+		target = map[string]interface{}{}
+		metadata[key] = target
+	}
+	for k, v := range values {
+		target[k] = v
+	}
+	return nil
+}
+
+// AffixMetadataName prepends prefix and appends suffix to metadata.name of a
+// resource YAML document and returns the resulting YAML. The document must
+// declare a non-empty metadata.name when either affix is set. Empty affixes
+// return the document unchanged.
+func AffixMetadataName(yamlStr string, prefix, suffix string) (string, error) {
+	if prefix == "" && suffix == "" {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		return "", fmt.Errorf("metadata.name must be set when a name prefix or suffix is configured")
+	}
+	metadata["name"] = prefix + name + suffix
+
+	return encodeYAML(doc)
+}
+
+// encodeYAML encodes a parsed YAML document with the 2-space indentation used
+// throughout the converter package.
+func encodeYAML(doc map[string]interface{}) (string, error) {
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return "", fmt.Errorf("error encoding YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("error closing YAML encoder: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// DefinitionName returns the first non-empty string found at the given
+// dot-separated field paths of a resource YAML document (for example
+// "spec.display.name", then "metadata.name"), or an empty string when none of
+// the paths holds a string.
+func DefinitionName(yamlStr string, fieldPaths ...string) string {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return ""
+	}
+	for _, fieldPath := range fieldPaths {
+		var current interface{} = doc
+		for _, part := range strings.Split(fieldPath, ".") {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				current = nil
+				break
+			}
+			current = m[part]
+		}
+		if name, ok := current.(string); ok && name != "" {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMergeMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		yamlStr     string
+		labels      map[string]string
+		annotations map[string]string
+		expected    map[string]interface{}
+	}{
+		{
+			name: "adds metadata maps when absent",
+			yamlStr: `
+kind: Dash0SyntheticCheck
+spec:
+  enabled: true
+`,
+			labels:      map[string]string{"team": "checkout"},
+			annotations: map[string]string{"dash0.com/folder-path": "/checkout"},
+			expected: map[string]interface{}{
+				"kind": "Dash0SyntheticCheck",
+				"metadata": map[string]interface{}{
+					"labels":      map[string]interface{}{"team": "checkout"},
+					"annotations": map[string]interface{}{"dash0.com/folder-path": "/checkout"},
+				},
+				"spec": map[string]interface{}{"enabled": true},
+			},
+		},
+		{
+			name: "provider values override document values",
+			yamlStr: `
+metadata:
+  name: checkout
+  labels:
+    team: payments
+    tier: gold
+  annotations:
+    dash0.com/sharing: user:alice@example.com
+`,
+			labels:      map[string]string{"team": "checkout"},
+			annotations: map[string]string{"dash0.com/sharing": "team:team_01abc"},
+			expected: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": "checkout",
+					"labels": map[string]interface{}{
+						"team": "checkout",
+						"tier": "gold",
+					},
+					"annotations": map[string]interface{}{"dash0.com/sharing": "team:team_01abc"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, err := MergeMetadata(tc.yamlStr, tc.labels, tc.annotations)
+			require.NoError(t, err)
+
+			var actual map[string]interface{}
+			require.NoError(t, yaml.Unmarshal([]byte(merged), &actual))
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMergeMetadata_NoManagedMetadataReturnsInput(t *testing.T) {
+	input := "metadata:\n  name: checkout\n"
+	merged, err := MergeMetadata(input, nil, map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, input, merged)
+}
+
+func TestMergeMetadata_InvalidMetadata(t *testing.T) {
+	_, err := MergeMetadata("metadata: checkout\n", map[string]string{"team": "checkout"}, nil)
+	assert.Error(t, err)
+
+	_, err = MergeMetadata("metadata:\n  labels: [a]\n", map[string]string{"team": "checkout"}, nil)
+	assert.Error(t, err)
+}
+
+func TestMergeDefaultLabels(t *testing.T) {
+	merged, err := MergeDefaultLabels("metadata:\n  name: checkout\n  labels:\n    team: checkout\n", map[string]string{
+		"team":            "platform",
+		"dash0.com/owner": "platform",
+	})
+	require.NoError(t, err)
+
+	var actual map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(merged), &actual))
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "checkout",
+			"labels": map[string]interface{}{
+				"team":            "checkout",
+				"dash0.com/owner": "platform",
+			},
+		},
+	}, actual)
+}
+
+func TestMergeDefaultLabels_AllDeclaredReturnsInput(t *testing.T) {
+	input := "metadata:\n  labels:\n    team: checkout\n"
+	merged, err := MergeDefaultLabels(input, map[string]string{"team": "platform"})
+	require.NoError(t, err)
+	assert.Equal(t, input, merged)
+
+	merged, err = MergeDefaultLabels(input, nil)
+	require.NoError(t, err)
+	assert.Equal(t, input, merged)
+}
+
+func TestMetadataKeys(t *testing.T) {
+	assert.Equal(t, map[string]bool{"team": true, "tier": true}, MetadataKeys("metadata:\n  labels:\n    team: checkout\n    tier: gold\n", "labels"))
+	assert.Nil(t, MetadataKeys("metadata:\n  name: checkout\n", "labels"))
+	assert.Nil(t, MetadataKeys("metadata: [", "labels"))
+}
+
+func TestChangedMetadata(t *testing.T) {
+	response := `{"metadata":{"name":"checkout","labels":{"team":"payments","tier":"gold"}}}`
+
+	changed, err := ChangedMetadata(response, "labels", map[string]string{
+		"team":            "checkout",
+		"tier":            "gold",
+		"dash0.com/owner": "platform",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments"}, changed, "unchanged and missing keys are not reported")
+
+	changed, err = ChangedMetadata(response, "annotations", map[string]string{"dash0.com/sharing": "team:backend"})
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+
+	_, err = ChangedMetadata("metadata: [", "labels", map[string]string{"team": "checkout"})
+	assert.Error(t, err)
+}
+
+func TestAffixMetadataName(t *testing.T) {
+	merged, err := AffixMetadataName("metadata:\n  name: checkout\nspec:\n  enabled: true\n", "pr-42-", "-preview")
+	require.NoError(t, err)
+
+	var actual map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(merged), &actual))
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "pr-42-checkout-preview"},
+		"spec":     map[string]interface{}{"enabled": true},
+	}, actual)
+}
+
+func TestAffixMetadataName_NoAffixesReturnsInput(t *testing.T) {
+	input := "spec:\n  enabled: true\n"
+	merged, err := AffixMetadataName(input, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, input, merged)
+}
+
+func TestAffixMetadataName_MissingName(t *testing.T) {
+	_, err := AffixMetadataName("spec:\n  enabled: true\n", "pr-42-", "")
+	assert.Error(t, err)
+}
+
+func TestDefinitionName(t *testing.T) {
+	yamlStr := "metadata:\n  name: checkout\nspec:\n  display:\n    name: Checkout\n"
+
+	assert.Equal(t, "Checkout", DefinitionName(yamlStr, "spec.display.name", "metadata.name"))
+	assert.Equal(t, "checkout", DefinitionName(yamlStr, "metadata.name"))
+	assert.Equal(t, "checkout", DefinitionName(yamlStr, "spec.title", "metadata.name"))
+	assert.Equal(t, "", DefinitionName(yamlStr, "spec.title"))
+	assert.Equal(t, "", DefinitionName("not: [valid", "metadata.name"))
+}
@@ -414,6 +414,57 @@ func stripAbsentZeroValues(reference, target map[string]interface{}) {
 	}
 }
 
+// StripFieldsAbsentFrom removes every field from targetYAML that is absent
+// from referenceYAML and returns the resulting YAML. Nested maps are stripped
+// recursively, and so are lists of maps whose length is the same in both
+// documents, element by element. Comparing the result with the reference
+// treats fields that only the target declares, such as fields set by the
+// server or in the web app, as non-drift.
+func StripFieldsAbsentFrom(referenceYAML, targetYAML string) (string, error) {
+	var reference, target map[string]interface{}
+	if err := yaml.Unmarshal([]byte(referenceYAML), &reference); err != nil {
+		return "", fmt.Errorf("error parsing reference YAML: %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(targetYAML), &target); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+	if target == nil {
+		return targetYAML, nil
+	}
+	stripAbsentFields(reference, target)
+	return encodeYAML(target)
+}
+
+// stripAbsentFields removes keys from target that don't exist in reference,
+// recursing into nested maps and into lists of maps of equal length.
+func stripAbsentFields(reference, target map[string]interface{}) {
+	for key, targetVal := range target {
+		refVal, existsInRef := reference[key]
+		if !existsInRef {
+			delete(target, key)
+			continue
+		}
+		switch t := targetVal.(type) {
+		case map[string]interface{}:
+			if refMap, ok := refVal.(map[string]interface{}); ok {
+				stripAbsentFields(refMap, t)
+			}
+		case []interface{}:
+			refList, ok := refVal.([]interface{})
+			if !ok || len(refList) != len(t) {
+				continue
+			}
+			for i := range t {
+				refItem, refOK := refList[i].(map[string]interface{})
+				targetItem, targetOK := t[i].(map[string]interface{})
+				if refOK && targetOK {
+					stripAbsentFields(refItem, targetItem)
+				}
+			}
+		}
+	}
+}
+
 // isZeroValue returns true if v is a JSON zero value (false, 0, "", nil,
 // empty map, or empty slice).
 func isZeroValue(v interface{}) bool {
@@ -1553,3 +1553,57 @@ spec:
 		})
 	}
 }
+
+func TestStripFieldsAbsentFrom(t *testing.T) {
+	reference := `
+kind: Dashboard
+metadata:
+  name: checkout
+spec:
+  display:
+    name: Checkout
+  panels:
+    - title: Latency
+    - title: Errors
+`
+	target := `
+kind: Dashboard
+metadata:
+  name: checkout
+  annotations:
+    dash0.com/sharing: team
+spec:
+  display:
+    name: Checkout renamed
+    description: Edited in the web app
+  panels:
+    - title: Latency
+      color: red
+    - title: Errors
+  theme: dark
+`
+	stripped, err := StripFieldsAbsentFrom(reference, target)
+	require.NoError(t, err)
+
+	equivalent, err := ResourceYAMLEquivalent(`
+kind: Dashboard
+metadata:
+  name: checkout
+spec:
+  display:
+    name: Checkout renamed
+  panels:
+    - title: Latency
+    - title: Errors
+`, stripped, nil, []string{AnnotationSharing})
+	require.NoError(t, err)
+	assert.True(t, equivalent, stripped)
+
+	// Fields in the reference keep taking part in the comparison.
+	equivalent, err = ResourceYAMLEquivalent(reference, stripped, nil, nil)
+	require.NoError(t, err)
+	assert.False(t, equivalent)
+
+	_, err = StripFieldsAbsentFrom("invalid: : : yaml", target)
+	assert.Error(t, err)
+}
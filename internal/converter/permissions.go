@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Permission grants a set of actions on an asset to a role, a team or a user.
+// Exactly one of Role, TeamID and UserID is expected to be set.
+type Permission struct {
+	Actions []string
+	Role    string
+	TeamID  string
+	UserID  string
+}
+
+// SetPermissions replaces spec.permissions of a resource YAML document with
+// the given permissions and returns the resulting YAML. A nil slice returns the
+// document unchanged, so that the permissions the API assigns by default are
+// left alone; an empty slice sets an empty list.
+func SetPermissions(yamlStr string, permissions []Permission) (string, error) {
+	if permissions == nil {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+
+	spec, ok := doc["spec"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("resource definition has no spec")
+	}
+
+	entries := make([]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		actions := make([]interface{}, 0, len(p.Actions))
+		for _, action := range p.Actions {
+			actions = append(actions, action)
+		}
+		entry := map[string]interface{}{"actions": actions}
+		for key, value := range map[string]string{"role": p.Role, "teamId": p.TeamID, "userId": p.UserID} {
+			if value != "" {
+				entry[key] = value
+			}
+		}
+		entries = append(entries, entry)
+	}
+	spec["permissions"] = entries
+
+	return encodeYAML(doc)
+}
@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSetPermissions(t *testing.T) {
+	const checkYAML = `kind: Dash0SyntheticCheck
+metadata:
+  name: checkout
+spec:
+  enabled: true
+  permissions:
+    - actions: [synthetic_check:read]
+      role: basic_member
+`
+
+	out, err := SetPermissions(checkYAML, nil)
+	require.NoError(t, err)
+	assert.Equal(t, checkYAML, out)
+
+	out, err = SetPermissions(checkYAML, []Permission{
+		{Actions: []string{"synthetic_check:read", "synthetic_check:write"}, TeamID: "team-1"},
+		{Actions: []string{"synthetic_check:delete"}, Role: "admin"},
+	})
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(out), &doc))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"actions": []interface{}{"synthetic_check:read", "synthetic_check:write"}, "teamId": "team-1"},
+		map[string]interface{}{"actions": []interface{}{"synthetic_check:delete"}, "role": "admin"},
+	}, doc["spec"].(map[string]interface{})["permissions"])
+
+	out, err = SetPermissions(checkYAML, []Permission{})
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal([]byte(out), &doc))
+	assert.Equal(t, []interface{}{}, doc["spec"].(map[string]interface{})["permissions"])
+
+	_, err = SetPermissions("kind: Dash0SyntheticCheck\n", []Permission{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,434 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PrometheusRulePart is a part of a PrometheusRule document that is stored as
+// an asset of its own: an alerting rule as a check rule, or the recording
+// rules of a group as a recording rule group.
+type PrometheusRulePart struct {
+	// Key identifies the part within its document. It is derived from the
+	// name of the group and, for an alerting rule, the alert name, so it does
+	// not change when other rules are added, removed or reordered.
+	Key string
+	// Recording is set for the part that holds the recording rules of a
+	// group.
+	Recording bool
+	// Document is a PrometheusRule document with the apiVersion, kind and
+	// metadata of the source and a single group that holds the rules of the
+	// part, with the name and interval of the source group.
+	Document string
+}
+
+// PrometheusRuleKey returns the key of the part that holds the alerting rule
+// alert of group, or the recording rules of group when alert is empty. Names
+// are lowercased and every run of other characters than ASCII letters and digits
+// becomes a hyphen, and the group and alert are joined with two hyphens, so
+// keys can be used in origins.
+func PrometheusRuleKey(group, alert string) string {
+	if alert == "" {
+		return keySegment(group)
+	}
+	return keySegment(group) + "--" + keySegment(alert)
+}
+
+// keySegment lowercases name and replaces every run of other characters than
+// ASCII letters and digits with a single hyphen, trimming hyphens at either
+// end.
+func keySegment(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, c := range strings.ToLower(name) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(c)
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
+
+// SplitPrometheusRule splits a PrometheusRule document into one part per
+// alerting rule and one part per group with recording rules, in document
+// order; the part of a group's recording rules takes the place of its first
+// recording rule. Each part holds a document in the shape the check rules or
+// recording rules API accepts.
+//
+// Two alerting rules, or two groups with recording rules, whose names map to
+// the same key are rejected, as they would be stored as the same asset.
+func SplitPrometheusRule(yamlStr string) ([]PrometheusRulePart, error) {
+	doc, groups, err := prometheusRuleGroups(yamlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []PrometheusRulePart
+	owners := map[string]string{}
+	claim := func(key, owner string) error {
+		if previous, ok := owners[key]; ok {
+			return fmt.Errorf("%s and %s both map to the key %q; rename one of them", previous, owner, key)
+		}
+		owners[key] = owner
+		return nil
+	}
+	for i, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("group %d is not a mapping", i)
+		}
+		name, _ := group["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("group %d has no name", i)
+		}
+		if keySegment(name) == "" {
+			return nil, fmt.Errorf("group %q has no letters or digits in its name to derive a key from", name)
+		}
+		rules, _ := group["rules"].([]interface{})
+		recording := -1
+		var recordingRules []interface{}
+		for j, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("rule %d of group %q is not a mapping", j, name)
+			}
+			if record, _ := rule["record"].(string); record != "" {
+				if recording < 0 {
+					owner := fmt.Sprintf("the recording rules of group %q", name)
+					if err := claim("recording:"+PrometheusRuleKey(name, ""), owner); err != nil {
+						return nil, err
+					}
+					recording = len(parts)
+					parts = append(parts, PrometheusRulePart{Key: PrometheusRuleKey(name, ""), Recording: true})
+				}
+				recordingRules = append(recordingRules, rule)
+				continue
+			}
+			alert, _ := rule["alert"].(string)
+			if alert == "" {
+				return nil, fmt.Errorf("rule %d of group %q has neither an alert nor a record name", j, name)
+			}
+			if keySegment(alert) == "" {
+				return nil, fmt.Errorf("alerting rule %q of group %q has no letters or digits in its name to derive a key from", alert, name)
+			}
+			key := PrometheusRuleKey(name, alert)
+			if err := claim("alert:"+key, fmt.Sprintf("alerting rule %q of group %q", alert, name)); err != nil {
+				return nil, err
+			}
+			document, err := encodeWithGroups(doc, []interface{}{singleGroup(group, []interface{}{rule})})
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, PrometheusRulePart{Key: key, Document: document})
+		}
+		if recording >= 0 {
+			document, err := encodeWithGroups(doc, []interface{}{singleGroup(group, recordingRules)})
+			if err != nil {
+				return nil, err
+			}
+			parts[recording].Document = document
+		}
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("PrometheusRule has no rules under spec.groups")
+	}
+	return parts, nil
+}
+
+// singleGroup returns a group with the name and interval of group and the
+// given rules.
+func singleGroup(group map[string]interface{}, rules []interface{}) map[string]interface{} {
+	single := map[string]interface{}{"name": group["name"], "rules": rules}
+	if interval, ok := group["interval"]; ok {
+		single["interval"] = interval
+	}
+	return single
+}
+
+// MergePrometheusRule writes parts, as returned by SplitPrometheusRule or with
+// documents read back from the check rules and recording rules APIs, into the
+// PrometheusRule document they were split from. A part replaces the rules of
+// the part with the same key, and their group's interval when it differs; a
+// part with an empty document removes them. Rules without a matching part are
+// kept, and groups left without rules are dropped.
+func MergePrometheusRule(yamlStr string, parts []PrometheusRulePart) (string, error) {
+	doc, groups, err := prometheusRuleGroups(yamlStr)
+	if err != nil {
+		return "", err
+	}
+	replacements := map[string]PrometheusRulePart{}
+	for _, part := range parts {
+		replacements[fmt.Sprintf("%t:%s", part.Recording, part.Key)] = part
+	}
+
+	merged := make([]interface{}, 0, len(groups))
+	for _, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := group["name"].(string)
+		groupRules, _ := group["rules"].([]interface{})
+		kept := make([]interface{}, 0, len(groupRules))
+		recordingDone := false
+		for _, r := range groupRules {
+			rule, _ := r.(map[string]interface{})
+			recording := false
+			key := ""
+			if record, _ := rule["record"].(string); record != "" {
+				recording, key = true, PrometheusRuleKey(name, "")
+			} else {
+				alert, _ := rule["alert"].(string)
+				key = PrometheusRuleKey(name, alert)
+			}
+			part, ok := replacements[fmt.Sprintf("%t:%s", recording, key)]
+			if !ok {
+				kept = append(kept, r)
+				continue
+			}
+			// The recording rules of a group are replaced together, at the
+			// position of the first one.
+			if recording && recordingDone {
+				continue
+			}
+			recordingDone = recordingDone || recording
+			if part.Document == "" {
+				continue
+			}
+			single, rules, err := singlePrometheusRuleGroup(part.Document)
+			if err != nil {
+				return "", err
+			}
+			kept = append(kept, rules...)
+			// Rules of a group share its interval, so a part whose interval
+			// changed sets it for the whole group.
+			original, hasOriginal := group["interval"]
+			interval, ok := single["interval"]
+			if ok == hasOriginal && fmt.Sprint(interval) == fmt.Sprint(original) {
+				continue
+			}
+			if ok {
+				group["interval"] = interval
+			} else {
+				delete(group, "interval")
+			}
+		}
+		if len(kept) == 0 {
+			continue
+		}
+		group["rules"] = kept
+		merged = append(merged, group)
+	}
+	return encodeWithGroups(doc, merged)
+}
+
+// prometheusRuleGroups parses a PrometheusRule document and returns it along
+// with its spec.groups.
+func prometheusRuleGroups(yamlStr string) (map[string]interface{}, []interface{}, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil, nil, fmt.Errorf("error parsing PrometheusRule YAML: %w", err)
+	}
+	spec, _ := doc["spec"].(map[string]interface{})
+	groups, _ := spec["groups"].([]interface{})
+	if len(groups) == 0 {
+		return nil, nil, fmt.Errorf("PrometheusRule has no groups under spec.groups")
+	}
+	return doc, groups, nil
+}
+
+// JoinPrometheusRules combines single-group documents into one PrometheusRule
+// document, keeping their order. The rules of groups with the same name and
+// interval are joined into the first of them. The apiVersion, kind and
+// metadata are taken from the first document.
+func JoinPrometheusRules(documents []string) (string, error) {
+	if len(documents) == 0 {
+		return "", fmt.Errorf("no rules to join")
+	}
+	doc, _, err := prometheusRuleGroups(documents[0])
+	if err != nil {
+		return "", err
+	}
+
+	var groups []interface{}
+	for _, d := range documents {
+		single, rules, err := singlePrometheusRuleGroup(d)
+		if err != nil {
+			return "", err
+		}
+		joined := false
+		for _, g := range groups {
+			group := g.(map[string]interface{})
+			if group["name"] == single["name"] && fmt.Sprint(group["interval"]) == fmt.Sprint(single["interval"]) {
+				group["rules"] = append(group["rules"].([]interface{}), rules...)
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			groups = append(groups, singleGroup(single, rules))
+		}
+	}
+	return encodeWithGroups(doc, groups)
+}
+
+// singlePrometheusRuleGroup returns the only group of a single-group document
+// along with its rules.
+func singlePrometheusRuleGroup(yamlStr string) (map[string]interface{}, []interface{}, error) {
+	_, groups, err := prometheusRuleGroups(yamlStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	group, _ := groups[0].(map[string]interface{})
+	rules, _ := group["rules"].([]interface{})
+	if len(groups) != 1 || len(rules) == 0 {
+		return nil, nil, fmt.Errorf("expected a PrometheusRule with exactly one group that has rules")
+	}
+	return group, rules, nil
+}
+
+// encodeWithGroups encodes doc with its spec.groups replaced by groups,
+// leaving doc itself unchanged.
+func encodeWithGroups(doc map[string]interface{}, groups []interface{}) (string, error) {
+	out := make(map[string]interface{}, len(doc))
+	for k, v := range doc {
+		out[k] = v
+	}
+	spec := map[string]interface{}{}
+	if source, ok := doc["spec"].(map[string]interface{}); ok {
+		for k, v := range source {
+			spec[k] = v
+		}
+	}
+	spec["groups"] = groups
+	out["spec"] = spec
+	return encodeYAML(out)
+}
+
+// MergePrometheusRuleMetadata merges the given labels and annotations into the
+// labels and annotations of every rule of a PrometheusRule document and
+// returns the resulting YAML. It is the counterpart of MergeMetadata for check
+// rules, whose PrometheusRule metadata the Dash0 API does not keep. Keys
+// supplied here take precedence over keys declared by the rules, and empty or
+// nil maps return the document unchanged.
+func MergePrometheusRuleMetadata(yamlStr string, labels, annotations map[string]string) (string, error) {
+	if len(labels) == 0 && len(annotations) == 0 {
+		return yamlStr, nil
+	}
+	return updatePrometheusRules(yamlStr, func(_ map[string]interface{}, rule map[string]interface{}) error {
+		if err := mergeRuleStringMap(rule, "labels", labels); err != nil {
+			return err
+		}
+		return mergeRuleStringMap(rule, "annotations", annotations)
+	})
+}
+
+// MergePrometheusRuleDefaultLabels adds the given labels to the labels of
+// every rule of a PrometheusRule document and returns the resulting YAML. It
+// is the counterpart of MergeDefaultLabels for check rules: labels a rule
+// already declares are kept, so the rule takes precedence over the defaults.
+// An empty or nil map returns the document unchanged.
+func MergePrometheusRuleDefaultLabels(yamlStr string, labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return yamlStr, nil
+	}
+	return updatePrometheusRules(yamlStr, func(_ map[string]interface{}, rule map[string]interface{}) error {
+		declared, _ := rule["labels"].(map[string]interface{})
+		defaults := map[string]string{}
+		for k, v := range labels {
+			if _, ok := declared[k]; !ok {
+				defaults[k] = v
+			}
+		}
+		return mergeRuleStringMap(rule, "labels", defaults)
+	})
+}
+
+// updatePrometheusRules calls update for every rule of a PrometheusRule
+// document, along with the group it belongs to, and returns the resulting
+// YAML.
+func updatePrometheusRules(yamlStr string, update func(group, rule map[string]interface{}) error) (string, error) {
+	doc, groups, err := prometheusRuleGroups(yamlStr)
+	if err != nil {
+		return "", err
+	}
+	for i, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("group %d is not a mapping", i)
+		}
+		rules, _ := group["rules"].([]interface{})
+		for j, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("rule %d of group %d is not a mapping", j, i)
+			}
+			if err := update(group, rule); err != nil {
+				return "", err
+			}
+		}
+	}
+	return encodeYAML(doc)
+}
+
+// mergeRuleStringMap merges values into rule[key], creating the nested map
+// when the rule does not declare one yet.
+func mergeRuleStringMap(rule map[string]interface{}, key string, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	target, ok := rule[key].(map[string]interface{})
+	if !ok {
+		if rule[key] != nil {
+			return fmt.Errorf("%s of a rule must be a mapping, got %T", key, rule[key])
+		}
+		target = map[string]interface{}{}
+		rule[key] = target
+	}
+	for k, v := range values {
+		target[k] = v
+	}
+	return nil
+}
+
+// AffixPrometheusRuleNames prepends prefix to the name of every group and
+// appends suffix to the name of every alerting rule of a PrometheusRule
+// document, and returns the resulting YAML. A check rule is named
+// "<group> - <alert>", so this is the counterpart of AffixMetadataName for
+// check rules, whose PrometheusRule metadata the Dash0 API does not keep.
+// Empty affixes return the document unchanged.
+func AffixPrometheusRuleNames(yamlStr string, prefix, suffix string) (string, error) {
+	if prefix == "" && suffix == "" {
+		return yamlStr, nil
+	}
+	doc, groups, err := prometheusRuleGroups(yamlStr)
+	if err != nil {
+		return "", err
+	}
+	for i, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("group %d is not a mapping", i)
+		}
+		name, _ := group["name"].(string)
+		if name == "" {
+			return "", fmt.Errorf("group %d has no name", i)
+		}
+		group["name"] = prefix + name
+		rules, _ := group["rules"].([]interface{})
+		for _, r := range rules {
+			rule, _ := r.(map[string]interface{})
+			if alert, _ := rule["alert"].(string); alert != "" {
+				rule["alert"] = alert + suffix
+			}
+		}
+	}
+	return encodeYAML(doc)
+}
@@ -0,0 +1,285 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testPrometheusRule = `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: availability
+      interval: 1m
+      rules:
+        - alert: CheckoutDown
+          expr: up{job="checkout"} == 0
+        - alert: CartDown
+          expr: up{job="cart"} == 0
+    - name: latency
+      rules:
+        - alert: CheckoutSlow
+          expr: histogram_quantile(0.99, rate(http_duration_seconds_bucket[5m])) > 1
+          for: 5m
+`
+
+const testPrometheusRuleWithRecordingRules = `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: availability
+      interval: 1m
+      rules:
+        - alert: CheckoutDown
+          expr: up{job="checkout"} == 0
+        - record: job:up:sum
+          expr: sum by (job) (up)
+        - record: job:up:avg
+          expr: avg by (job) (up)
+    - name: http requests
+      rules:
+        - alert: ErrorRateHigh
+          expr: sum(rate(http_requests_total{code=~"5.."}[5m])) > 1
+`
+
+func testRuleGroups(t *testing.T, yamlStr string) []interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(yamlStr), &doc))
+	return doc["spec"].(map[string]interface{})["groups"].([]interface{})
+}
+
+func TestSplitPrometheusRule(t *testing.T) {
+	parts, err := SplitPrometheusRule(testPrometheusRule)
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	for i, expected := range []struct{ key, group, interval, alert string }{
+		{"availability--checkoutdown", "availability", "1m", "CheckoutDown"},
+		{"availability--cartdown", "availability", "1m", "CartDown"},
+		{"latency--checkoutslow", "latency", "", "CheckoutSlow"},
+	} {
+		assert.Equal(t, expected.key, parts[i].Key)
+		assert.False(t, parts[i].Recording)
+
+		var doc map[string]interface{}
+		require.NoError(t, yaml.Unmarshal([]byte(parts[i].Document), &doc))
+		assert.Equal(t, "checkout", doc["metadata"].(map[string]interface{})["name"])
+
+		groups := testRuleGroups(t, parts[i].Document)
+		require.Len(t, groups, 1)
+		group := groups[0].(map[string]interface{})
+		assert.Equal(t, expected.group, group["name"])
+		if expected.interval == "" {
+			assert.NotContains(t, group, "interval")
+		} else {
+			assert.Equal(t, expected.interval, group["interval"])
+		}
+		groupRules := group["rules"].([]interface{})
+		require.Len(t, groupRules, 1)
+		assert.Equal(t, expected.alert, groupRules[0].(map[string]interface{})["alert"])
+	}
+}
+
+// TestSplitPrometheusRuleRecordingRules checks that the recording rules of a
+// group are split into one part, at the position of the first of them.
+func TestSplitPrometheusRuleRecordingRules(t *testing.T) {
+	parts, err := SplitPrometheusRule(testPrometheusRuleWithRecordingRules)
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	assert.Equal(t, PrometheusRulePart{Key: "availability--checkoutdown", Document: parts[0].Document}, parts[0])
+	assert.Equal(t, "availability", parts[1].Key)
+	assert.True(t, parts[1].Recording)
+	assert.Equal(t, "http-requests--errorratehigh", parts[2].Key)
+
+	group := testRuleGroups(t, parts[1].Document)[0].(map[string]interface{})
+	assert.Equal(t, "1m", group["interval"])
+	rules := group["rules"].([]interface{})
+	require.Len(t, rules, 2)
+	assert.Equal(t, "job:up:sum", rules[0].(map[string]interface{})["record"])
+	assert.Equal(t, "job:up:avg", rules[1].(map[string]interface{})["record"])
+}
+
+func TestPrometheusRuleKey(t *testing.T) {
+	assert.Equal(t, "http-requests--errorratehigh", PrometheusRuleKey("HTTP requests", "ErrorRateHigh"))
+	assert.Equal(t, "slo-checkout--burn-rate-1h", PrometheusRuleKey("slo/checkout", " burn rate (1h) "))
+	assert.Equal(t, "http-requests", PrometheusRuleKey("HTTP requests", ""))
+}
+
+func TestSplitPrometheusRuleRejectsInvalidDocuments(t *testing.T) {
+	for name, definition := range map[string]string{
+		"no groups":               "kind: PrometheusRule\nspec:\n  groups: []\n",
+		"unnamed group":           "kind: PrometheusRule\nspec:\n  groups:\n    - rules:\n        - alert: Down\n          expr: up == 0\n",
+		"unnamed rule":            "kind: PrometheusRule\nspec:\n  groups:\n    - name: g\n      rules:\n        - expr: up == 0\n",
+		"no rules":                "kind: PrometheusRule\nspec:\n  groups:\n    - name: g\n      rules: []\n",
+		"no key":                  "kind: PrometheusRule\nspec:\n  groups:\n    - name: g\n      rules:\n        - alert: \"!!\"\n          expr: up == 0\n",
+		"duplicate alert":         "kind: PrometheusRule\nspec:\n  groups:\n    - name: g\n      rules:\n        - alert: Down\n          expr: up == 0\n        - alert: Down\n          expr: up < 1\n",
+		"colliding alert":         "kind: PrometheusRule\nspec:\n  groups:\n    - name: g\n      rules:\n        - alert: Service Down\n          expr: up == 0\n        - alert: service-down\n          expr: up < 1\n",
+		"colliding group":         "kind: PrometheusRule\nspec:\n  groups:\n    - name: Checkout\n      rules:\n        - alert: Down\n          expr: up == 0\n    - name: checkout\n      rules:\n        - alert: Down\n          expr: up < 1\n",
+		"colliding record groups": "kind: PrometheusRule\nspec:\n  groups:\n    - name: jobs\n      rules:\n        - record: job:up:sum\n          expr: sum(up)\n    - name: Jobs\n      rules:\n        - record: job:up:avg\n          expr: avg(up)\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := SplitPrometheusRule(definition)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMergePrometheusRule(t *testing.T) {
+	parts, err := SplitPrometheusRule(testPrometheusRule)
+	require.NoError(t, err)
+
+	changed := `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata: {}
+spec:
+  groups:
+    - name: availability
+      interval: 2m
+      rules:
+        - alert: CheckoutDown
+          expr: up{job="checkout"} == 0
+          for: 1m
+`
+	merged, err := MergePrometheusRule(testPrometheusRule, []PrometheusRulePart{
+		{Key: parts[0].Key, Document: changed},
+		{Key: parts[2].Key},
+	})
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(merged), &doc))
+	assert.Equal(t, "checkout", doc["metadata"].(map[string]interface{})["name"])
+
+	groups := testRuleGroups(t, merged)
+	require.Len(t, groups, 1, "the latency group has no rules left")
+	group := groups[0].(map[string]interface{})
+	assert.Equal(t, "2m", group["interval"])
+	groupRules := group["rules"].([]interface{})
+	require.Len(t, groupRules, 2)
+	assert.Equal(t, "1m", groupRules[0].(map[string]interface{})["for"])
+	assert.Equal(t, "CartDown", groupRules[1].(map[string]interface{})["alert"], "rules without a part are kept")
+}
+
+func TestMergePrometheusRuleRecordingRules(t *testing.T) {
+	changed := `{"apiVersion":"monitoring.coreos.com/v1","kind":"PrometheusRule","metadata":{"name":"checkout","labels":{"dash0.com/origin":"tf_abc-availability"}},"spec":{"groups":[{"name":"availability","interval":"1m","rules":[{"record":"job:up:max","expr":"max(up)"}]}]}}`
+	merged, err := MergePrometheusRule(testPrometheusRuleWithRecordingRules, []PrometheusRulePart{
+		{Key: "availability", Recording: true, Document: changed},
+	})
+	require.NoError(t, err)
+
+	groups := testRuleGroups(t, merged)
+	require.Len(t, groups, 2)
+	rules := groups[0].(map[string]interface{})["rules"].([]interface{})
+	require.Len(t, rules, 2, "the recording rules are replaced together")
+	assert.Equal(t, "CheckoutDown", rules[0].(map[string]interface{})["alert"])
+	assert.Equal(t, "job:up:max", rules[1].(map[string]interface{})["record"])
+
+	merged, err = MergePrometheusRule(testPrometheusRuleWithRecordingRules, []PrometheusRulePart{
+		{Key: "availability", Recording: true},
+	})
+	require.NoError(t, err)
+	assert.Len(t, testRuleGroups(t, merged)[0].(map[string]interface{})["rules"], 1)
+}
+
+func TestMergePrometheusRuleMetadata(t *testing.T) {
+	input := `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: availability
+      rules:
+        - alert: CheckoutDown
+          expr: up{job="checkout"} == 0
+          labels:
+            team: checkout
+            severity: critical
+`
+	merged, err := MergePrometheusRuleMetadata(input, map[string]string{"team": "payments"}, map[string]string{"summary": "Checkout is down"})
+	require.NoError(t, err)
+
+	groups := testRuleGroups(t, merged)
+	rule := groups[0].(map[string]interface{})["rules"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"team": "payments", "severity": "critical"}, rule["labels"])
+	assert.Equal(t, map[string]interface{}{"summary": "Checkout is down"}, rule["annotations"])
+
+	unchanged, err := MergePrometheusRuleMetadata(input, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, input, unchanged)
+
+	_, err = MergePrometheusRuleMetadata("spec:\n  groups:\n    - name: a\n      rules:\n        - alert: A\n          labels: [a]\n", map[string]string{"team": "payments"}, nil)
+	assert.Error(t, err)
+}
+
+func TestMergePrometheusRuleDefaultLabels(t *testing.T) {
+	input := "spec:\n  groups:\n    - name: availability\n      rules:\n        - alert: CheckoutDown\n          labels:\n            team: checkout\n        - alert: CheckoutSlow\n"
+	merged, err := MergePrometheusRuleDefaultLabels(input, map[string]string{"team": "platform", "tier": "gold"})
+	require.NoError(t, err)
+
+	rules := testRuleGroups(t, merged)[0].(map[string]interface{})["rules"].([]interface{})
+	assert.Equal(t, map[string]interface{}{"team": "checkout", "tier": "gold"}, rules[0].(map[string]interface{})["labels"], "labels declared by the rule are kept")
+	assert.Equal(t, map[string]interface{}{"team": "platform", "tier": "gold"}, rules[1].(map[string]interface{})["labels"])
+
+	unchanged, err := MergePrometheusRuleDefaultLabels(input, nil)
+	require.NoError(t, err)
+	assert.Equal(t, input, unchanged)
+}
+
+func TestAffixPrometheusRuleNames(t *testing.T) {
+	affixed, err := AffixPrometheusRuleNames(testPrometheusRule, "pr-42 ", " (preview)")
+	require.NoError(t, err)
+
+	groups := testRuleGroups(t, affixed)
+	require.Len(t, groups, 2)
+	group := groups[0].(map[string]interface{})
+	assert.Equal(t, "pr-42 availability", group["name"])
+	for _, r := range group["rules"].([]interface{}) {
+		assert.Contains(t, r.(map[string]interface{})["alert"], " (preview)")
+	}
+
+	unchanged, err := AffixPrometheusRuleNames(testPrometheusRule, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, testPrometheusRule, unchanged)
+
+	_, err = AffixPrometheusRuleNames("spec:\n  groups:\n    - rules:\n        - alert: A\n", "pr-42 ", "")
+	assert.Error(t, err)
+}
+
+func TestJoinPrometheusRules(t *testing.T) {
+	parts, err := SplitPrometheusRule(testPrometheusRuleWithRecordingRules)
+	require.NoError(t, err)
+
+	// Imports read the check rules before the recording rules, so the rules
+	// of a group are joined even when other documents come between them.
+	documents := []string{parts[0].Document, parts[2].Document, parts[1].Document}
+	joined, err := JoinPrometheusRules(documents)
+	require.NoError(t, err)
+
+	groups := testRuleGroups(t, joined)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "availability", groups[0].(map[string]interface{})["name"])
+	assert.Len(t, groups[0].(map[string]interface{})["rules"], 3)
+	assert.Equal(t, "http requests", groups[1].(map[string]interface{})["name"])
+
+	roundTrip, err := SplitPrometheusRule(joined)
+	require.NoError(t, err)
+	require.Len(t, roundTrip, 3)
+	assert.Equal(t, parts[1], roundTrip[1])
+
+	_, err = JoinPrometheusRules(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CheckRuleNotificationChannelsAnnotation is the Prometheus rule annotation
+// that routes the alerts of a check rule to a comma-separated list of
+// notification channel IDs.
+const CheckRuleNotificationChannelsAnnotation = "dash0.com/notification-channel-ids"
+
+// SetCheckRuleNotificationChannels sets the notification channel annotation on
+// every rule of a Prometheus rule YAML document to the given channel IDs and
+// returns the resulting YAML. A nil slice returns the document unchanged, so
+// that channels declared in the YAML itself keep working; an empty slice
+// removes the annotation.
+func SetCheckRuleNotificationChannels(yamlStr string, channelIDs []string) (string, error) {
+	if channelIDs == nil {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing check rule YAML: %w", err)
+	}
+
+	spec, _ := doc["spec"].(map[string]interface{})
+	groups, _ := spec["groups"].([]interface{})
+	updated := 0
+	for _, g := range groups {
+		group, _ := g.(map[string]interface{})
+		rules, _ := group["rules"].([]interface{})
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			annotations, ok := rule["annotations"].(map[string]interface{})
+			if !ok {
+				annotations = map[string]interface{}{}
+				rule["annotations"] = annotations
+			}
+			if len(channelIDs) == 0 {
+				delete(annotations, CheckRuleNotificationChannelsAnnotation)
+			} else {
+				annotations[CheckRuleNotificationChannelsAnnotation] = strings.Join(channelIDs, ",")
+			}
+			updated++
+		}
+	}
+	if updated == 0 {
+		return "", fmt.Errorf("check rule definition has no rules under spec.groups")
+	}
+
+	return encodeYAML(doc)
+}
+
+// SetSyntheticCheckNotificationChannels replaces spec.notifications.channels of
+// a synthetic check YAML document with the given channel IDs and returns the
+// resulting YAML. A nil slice returns the document unchanged.
+func SetSyntheticCheckNotificationChannels(yamlStr string, channelIDs []string) (string, error) {
+	if channelIDs == nil {
+		return yamlStr, nil
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return "", fmt.Errorf("error parsing synthetic check YAML: %w", err)
+	}
+
+	spec, ok := doc["spec"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("synthetic check definition has no spec")
+	}
+	notifications, ok := spec["notifications"].(map[string]interface{})
+	if !ok {
+		if spec["notifications"] != nil {
+			return "", fmt.Errorf("spec.notifications must be a mapping, got %T", spec["notifications"])
+		}
+		notifications = map[string]interface{}{}
+		spec["notifications"] = notifications
+	}
+	channels := make([]interface{}, 0, len(channelIDs))
+	for _, id := range channelIDs {
+		channels = append(channels, id)
+	}
+	notifications["channels"] = channels
+
+	return encodeYAML(doc)
+}
@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const referencesCheckRuleYAML = `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: checkout
+          expr: up == 0
+          annotations:
+            summary: down
+            dash0.com/notification-channel-ids: from-yaml
+`
+
+func TestSetCheckRuleNotificationChannels(t *testing.T) {
+	ruleAnnotations := func(t *testing.T, yamlStr string) map[string]interface{} {
+		t.Helper()
+		var doc map[string]interface{}
+		require.NoError(t, yaml.Unmarshal([]byte(yamlStr), &doc))
+		rule := doc["spec"].(map[string]interface{})["groups"].([]interface{})[0].(map[string]interface{})["rules"].([]interface{})[0]
+		return rule.(map[string]interface{})["annotations"].(map[string]interface{})
+	}
+
+	t.Run("nil leaves the document unchanged", func(t *testing.T) {
+		out, err := SetCheckRuleNotificationChannels(referencesCheckRuleYAML, nil)
+		require.NoError(t, err)
+		assert.Equal(t, referencesCheckRuleYAML, out)
+	})
+
+	t.Run("ids replace the annotation", func(t *testing.T) {
+		out, err := SetCheckRuleNotificationChannels(referencesCheckRuleYAML, []string{"a", "b"})
+		require.NoError(t, err)
+		annotations := ruleAnnotations(t, out)
+		assert.Equal(t, "a,b", annotations[CheckRuleNotificationChannelsAnnotation])
+		assert.Equal(t, "down", annotations["summary"])
+	})
+
+	t.Run("empty removes the annotation", func(t *testing.T) {
+		out, err := SetCheckRuleNotificationChannels(referencesCheckRuleYAML, []string{})
+		require.NoError(t, err)
+		assert.NotContains(t, ruleAnnotations(t, out), CheckRuleNotificationChannelsAnnotation)
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		_, err := SetCheckRuleNotificationChannels("kind: PrometheusRule\nspec:\n  groups: []\n", []string{"a"})
+		assert.Error(t, err)
+	})
+}
+
+func TestSetSyntheticCheckNotificationChannels(t *testing.T) {
+	const checkYAML = "kind: Dash0SyntheticCheck\nmetadata:\n  name: checkout\nspec:\n  enabled: true\n"
+
+	out, err := SetSyntheticCheckNotificationChannels(checkYAML, nil)
+	require.NoError(t, err)
+	assert.Equal(t, checkYAML, out)
+
+	out, err = SetSyntheticCheckNotificationChannels(checkYAML, []string{"a", "b"})
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(out), &doc))
+	notifications := doc["spec"].(map[string]interface{})["notifications"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"a", "b"}, notifications["channels"])
+
+	_, err = SetSyntheticCheckNotificationChannels("kind: Dash0SyntheticCheck\n", []string{"a"})
+	assert.Error(t, err)
+
+	_, err = SetSyntheticCheckNotificationChannels("kind: Dash0SyntheticCheck\nspec:\n  notifications: none\n", []string{"a"})
+	assert.Error(t, err)
+}
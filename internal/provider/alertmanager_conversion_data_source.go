@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ datasource.DataSource = &AlertmanagerConversionDataSource{}
+
+// NewAlertmanagerConversionDataSource is a helper function to simplify the provider implementation.
+func NewAlertmanagerConversionDataSource() datasource.DataSource {
+	return &AlertmanagerConversionDataSource{}
+}
+
+// AlertmanagerConversionDataSource is the data source implementation. The
+// conversion happens entirely in the provider, so it needs no client.
+type AlertmanagerConversionDataSource struct{}
+
+// alertmanagerConversionModel is the Terraform state model for the
+// Alertmanager conversion data source.
+type alertmanagerConversionModel struct {
+	AlertmanagerYaml     types.String `tfsdk:"alertmanager_yaml"`
+	NotificationChannels types.Map    `tfsdk:"notification_channels"`
+	Routes               types.List   `tfsdk:"routes"`
+	Warnings             types.List   `tfsdk:"warnings"`
+}
+
+// alertmanagerRouteModel is an element of the routes attribute.
+type alertmanagerRouteModel struct {
+	Receiver             string   `tfsdk:"receiver"`
+	Matchers             []string `tfsdk:"matchers"`
+	NotificationChannels []string `tfsdk:"notification_channels"`
+	Continue             bool     `tfsdk:"continue"`
+}
+
+var alertmanagerRouteType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"receiver":              types.StringType,
+	"matchers":              types.ListType{ElemType: types.StringType},
+	"notification_channels": types.ListType{ElemType: types.StringType},
+	"continue":              types.BoolType,
+}}
+
+func (d *AlertmanagerConversionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alertmanager_conversion"
+}
+
+func (d *AlertmanagerConversionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Converts a Prometheus Alertmanager configuration into Dash0 notification channel definitions, to migrate receivers and routes to Dash0. " +
+			"Every integration of a receiver (`email_configs`, `pagerduty_configs`, `slack_configs`, `opsgenie_configs`, `webhook_configs`, `msteams_configs` and `discord_configs`) becomes one notification channel; " +
+			"the matchers of the routes that lead to the receiver become the channel's routing filters. " +
+			"Feed `notification_channels` into `dash0_notification_channel` with `for_each`. " +
+			"Anything that cannot be converted faithfully, such as unsupported integrations, Go templates or secrets read from files, is listed in `warnings` and reported as a warning.",
+
+		Attributes: map[string]schema.Attribute{
+			"alertmanager_yaml": schema.StringAttribute{
+				Description: "The Alertmanager configuration in YAML format, as in `alertmanager.yml`. Only `global`, `route` and `receivers` are read; inhibition rules, time intervals and templates have no Dash0 equivalent.",
+				Required:    true,
+			},
+			"notification_channels": schema.MapAttribute{
+				Description: "The converted notification channels in YAML format, keyed by `receiver/integration/index`, for example `oncall/pagerduty/0`. Each value can be used as the `notification_channel_yaml` of a `dash0_notification_channel`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"routes": schema.ListNestedAttribute{
+				Description: "The flattened route tree, listed depth-first with every route before its child routes.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"receiver": schema.StringAttribute{
+							Description: "The receiver of the route.",
+							Computed:    true,
+						},
+						"matchers": schema.ListAttribute{
+							Description: "The matchers of the route and its parents, in the Alertmanager syntax, for example `severity=\"critical\"`.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"notification_channels": schema.ListAttribute{
+							Description: "The keys in `notification_channels` of the channels converted from the receiver.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"continue": schema.BoolAttribute{
+							Description: "Whether Alertmanager keeps matching sibling routes after this one.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"warnings": schema.ListAttribute{
+				Description: "The parts of the configuration that were skipped or could not be converted faithfully.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AlertmanagerConversionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model alertmanagerConversionModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := converter.ConvertAlertmanagerConfig(model.AlertmanagerYaml.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alertmanager_yaml"),
+			"Invalid Alertmanager Configuration",
+			fmt.Sprintf("Unable to convert the Alertmanager configuration: %s", err),
+		)
+		return
+	}
+
+	routes := make([]alertmanagerRouteModel, 0, len(result.Routes))
+	for _, r := range result.Routes {
+		routes = append(routes, alertmanagerRouteModel{
+			Receiver:             r.Receiver,
+			Matchers:             r.Matchers,
+			NotificationChannels: r.NotificationChannels,
+			Continue:             r.Continue,
+		})
+	}
+
+	model.NotificationChannels, diags = types.MapValueFrom(ctx, types.StringType, result.NotificationChannels)
+	resp.Diagnostics.Append(diags...)
+	model.Routes, diags = types.ListValueFrom(ctx, alertmanagerRouteType, routes)
+	resp.Diagnostics.Append(diags...)
+	model.Warnings, diags = types.ListValueFrom(ctx, types.StringType, result.Warnings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(result.Warnings) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Incomplete Alertmanager Conversion",
+			"Parts of the Alertmanager configuration could not be converted faithfully:\n\n- "+strings.Join(result.Warnings, "\n- "),
+		)
+	}
+
+	tflog.Trace(ctx, "read the Alertmanager conversion data source")
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// readAlertmanagerConversion runs the data source's Read against a config
+// with the given Alertmanager YAML.
+func readAlertmanagerConversion(t *testing.T, alertmanagerYAML string) (*datasource.ReadResponse, alertmanagerConversionModel) {
+	t.Helper()
+	ctx := context.Background()
+	d := &AlertmanagerConversionDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"alertmanager_yaml":     tftypes.NewValue(tftypes.String, alertmanagerYAML),
+		"notification_channels": tftypes.NewValue(objectType.AttributeTypes["notification_channels"], nil),
+		"routes":                tftypes.NewValue(objectType.AttributeTypes["routes"], nil),
+		"warnings":              tftypes.NewValue(objectType.AttributeTypes["warnings"], nil),
+	})
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}, resp)
+
+	var model alertmanagerConversionModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	}
+	return resp, model
+}
+
+func TestAlertmanagerConversionDataSource_Read(t *testing.T) {
+	resp, model := readAlertmanagerConversion(t, `route:
+  receiver: oncall
+  routes:
+    - receiver: chat
+      matchers: [severity="warning"]
+      continue: true
+receivers:
+  - name: oncall
+    pagerduty_configs:
+      - routing_key: abc123
+  - name: chat
+    slack_configs:
+      - api_url: https://hooks.slack.com/services/T000/B000/XXX
+    pushover_configs:
+      - user_key: abc
+`)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	channels := map[string]string{}
+	resp.Diagnostics.Append(model.NotificationChannels.ElementsAs(context.Background(), &channels, false)...)
+	assert.ElementsMatch(t, []string{"oncall/pagerduty/0", "chat/slack/0"}, slices.Collect(maps.Keys(channels)))
+	assert.Contains(t, channels["chat/slack/0"], "operator: is")
+	for key, channelYAML := range channels {
+		channelJSON, err := converter.ConvertYAMLToJSON(channelYAML)
+		require.NoError(t, err)
+		assert.NoError(t, client.ValidateNotificationChannel(channelJSON), key)
+	}
+
+	var routes []alertmanagerRouteModel
+	resp.Diagnostics.Append(model.Routes.ElementsAs(context.Background(), &routes, false)...)
+	assert.Equal(t, []alertmanagerRouteModel{
+		{Receiver: "oncall", Matchers: []string{}, NotificationChannels: []string{"oncall/pagerduty/0"}},
+		{Receiver: "chat", Matchers: []string{`severity="warning"`}, NotificationChannels: []string{"chat/slack/0"}, Continue: true},
+	}, routes)
+
+	assert.Len(t, model.Warnings.Elements(), 1)
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "pushover_configs")
+}
+
+func TestAlertmanagerConversionDataSource_Read_Invalid(t *testing.T) {
+	resp, _ := readAlertmanagerConversion(t, "receivers: {")
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid Alertmanager Configuration", resp.Diagnostics.Errors()[0].Summary())
+}
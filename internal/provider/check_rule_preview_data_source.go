@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &CheckRulePreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &CheckRulePreviewDataSource{}
+)
+
+// Defaults of the check rule preview window.
+const (
+	defaultPreviewLookback = 24 * time.Hour
+	defaultPreviewStep     = time.Minute
+	// maxPreviewPoints is the number of evaluations per series the Prometheus
+	// API accepts in a single range query.
+	maxPreviewPoints = 11000
+)
+
+// NewCheckRulePreviewDataSource is a helper function to simplify the provider implementation.
+func NewCheckRulePreviewDataSource() datasource.DataSource {
+	return &CheckRulePreviewDataSource{}
+}
+
+// CheckRulePreviewDataSource is the data source implementation.
+type CheckRulePreviewDataSource struct {
+	client client.Client
+}
+
+// checkRulePreviewModel is the Terraform state model for the check rule
+// preview data source.
+type checkRulePreviewModel struct {
+	Dataset           types.String `tfsdk:"dataset"`
+	Expression        types.String `tfsdk:"expression"`
+	For               types.String `tfsdk:"for"`
+	Lookback          types.String `tfsdk:"lookback"`
+	Step              types.String `tfsdk:"step"`
+	WouldHaveFired    types.Bool   `tfsdk:"would_have_fired"`
+	FiringSeriesCount types.Int64  `tfsdk:"firing_series_count"`
+	FirstFiredAt      types.String `tfsdk:"first_fired_at"`
+	LastFiredAt       types.String `tfsdk:"last_fired_at"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CheckRulePreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CheckRulePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_rule_preview"
+}
+
+func (d *CheckRulePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates a check rule expression against the recent data of a Dash0 dataset and reports whether, and when, the rule would have fired. " +
+			"Use it to review a new or changed `dash0_check_rule` in the plan before it starts sending notifications, for example with a Terraform `check` block. " +
+			"The expression is evaluated like the PromQL expression of an alerting rule: every series it returns at an evaluation is pending, and a series fires once it has been pending for the `for` duration.",
+
+		Attributes: map[string]schema.Attribute{
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) to query. Provide the dataset's identifier, which is immutable, not the 'name'.",
+				Required:    true,
+			},
+			"expression": schema.StringAttribute{
+				Description: "The PromQL expression of the check rule, including its threshold, for example `sum by (service_name) (rate(http_requests_errors_total[5m])) > 0.05`.",
+				Required:    true,
+			},
+			"for": schema.StringAttribute{
+				Description: "How long a series must be returned by the expression without interruption before the rule fires, as a duration such as `5m`. Defaults to `0s`, which fires at the first evaluation that returns the series.",
+				Optional:    true,
+			},
+			"lookback": schema.StringAttribute{
+				Description: "How far back from now the expression is evaluated, as a duration such as `72h`. Defaults to `24h`.",
+				Optional:    true,
+			},
+			"step": schema.StringAttribute{
+				Description: "The interval between two evaluations, as a duration such as `30s`. Use the evaluation interval of the rule group for the closest match. Defaults to `1m`.",
+				Optional:    true,
+			},
+			"would_have_fired": schema.BoolAttribute{
+				Description: "Whether the rule would have fired for at least one series within the lookback window.",
+				Computed:    true,
+			},
+			"firing_series_count": schema.Int64Attribute{
+				Description: "The number of series for which the rule would have fired at least once.",
+				Computed:    true,
+			},
+			"first_fired_at": schema.StringAttribute{
+				Description: "The time at which the rule would have fired first, in RFC 3339 format. Null when it would not have fired.",
+				Computed:    true,
+			},
+			"last_fired_at": schema.StringAttribute{
+				Description: "The time at which the rule would have fired last, in RFC 3339 format. A series that keeps firing counts as firing once, when it started. Null when it would not have fired.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// previewDuration parses an optional duration attribute, falling back to
+// fallback when it is null.
+func previewDuration(value types.String, attribute string, fallback time.Duration, resp *datasource.ReadResponse) time.Duration {
+	if value.IsNull() {
+		return fallback
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid duration",
+			fmt.Sprintf("%s must be a non-negative duration such as \"5m\" or \"1h30m\"; got %q.", attribute, value.ValueString()),
+		)
+	}
+	return d
+}
+
+func (d *CheckRulePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model checkRulePreviewModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pendingFor := previewDuration(model.For, "for", 0, resp)
+	lookback := previewDuration(model.Lookback, "lookback", defaultPreviewLookback, resp)
+	step := previewDuration(model.Step, "step", defaultPreviewStep, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if step <= 0 || lookback/step > maxPreviewPoints {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("step"),
+			"Invalid step",
+			fmt.Sprintf("step must be positive, and lookback may span at most %d steps; got a lookback of %s with a step of %s.", maxPreviewPoints, lookback, step),
+		)
+		return
+	}
+
+	end := time.Now().UTC().Truncate(step)
+	series, err := d.client.QueryRange(ctx, model.Dataset.ValueString(), model.Expression.ValueString(), end.Add(-lookback), end, step)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to evaluate the check rule expression, got error: %s", err))
+		return
+	}
+
+	var first, last time.Time
+	var firing int64
+	for _, s := range series {
+		times := firingTimes(s.Timestamps, step, pendingFor)
+		if len(times) == 0 {
+			continue
+		}
+		firing++
+		if first.IsZero() || times[0].Before(first) {
+			first = times[0]
+		}
+		if t := times[len(times)-1]; t.After(last) {
+			last = t
+		}
+	}
+
+	model.WouldHaveFired = types.BoolValue(firing > 0)
+	model.FiringSeriesCount = types.Int64Value(firing)
+	model.FirstFiredAt = types.StringNull()
+	model.LastFiredAt = types.StringNull()
+	if firing > 0 {
+		model.FirstFiredAt = types.StringValue(first.Format(time.RFC3339))
+		model.LastFiredAt = types.StringValue(last.Format(time.RFC3339))
+	}
+
+	tflog.Trace(ctx, "read the check rule preview data source")
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// firingTimes returns the times at which an alerting rule would have fired for
+// a series that the expression returned at the given ascending evaluation
+// timestamps. Consecutive evaluations, at most one step apart, form a pending
+// period; the rule fires once per period that lasts at least pendingFor, at
+// its start plus pendingFor.
+func firingTimes(timestamps []time.Time, step, pendingFor time.Duration) []time.Time {
+	var fired []time.Time
+	for i := 0; i < len(timestamps); {
+		start := timestamps[i]
+		j := i
+		for j+1 < len(timestamps) && timestamps[j+1].Sub(timestamps[j]) <= step {
+			j++
+		}
+		if timestamps[j].Sub(start) >= pendingFor {
+			fired = append(fired, start.Add(pendingFor))
+		}
+		i = j + 1
+	}
+	return fired
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// readCheckRulePreview runs the data source's Read against a config with the
+// given expression and optional durations (empty for null).
+func readCheckRulePreview(t *testing.T, d *CheckRulePreviewDataSource, expression, pendingFor, lookback, step string) (*datasource.ReadResponse, checkRulePreviewModel) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	optional := func(value string) tftypes.Value {
+		if value == "" {
+			return tftypes.NewValue(tftypes.String, nil)
+		}
+		return tftypes.NewValue(tftypes.String, value)
+	}
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"dataset":             tftypes.NewValue(tftypes.String, "default"),
+		"expression":          tftypes.NewValue(tftypes.String, expression),
+		"for":                 optional(pendingFor),
+		"lookback":            optional(lookback),
+		"step":                optional(step),
+		"would_have_fired":    tftypes.NewValue(tftypes.Bool, nil),
+		"firing_series_count": tftypes.NewValue(tftypes.Number, nil),
+		"first_fired_at":      tftypes.NewValue(tftypes.String, nil),
+		"last_fired_at":       tftypes.NewValue(tftypes.String, nil),
+	})
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}, resp)
+
+	var model checkRulePreviewModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	}
+	return resp, model
+}
+
+// minutes returns timestamps at the given minutes after base.
+func minutes(base time.Time, offsets ...int) []time.Time {
+	times := make([]time.Time, 0, len(offsets))
+	for _, o := range offsets {
+		times = append(times, base.Add(time.Duration(o)*time.Minute))
+	}
+	return times
+}
+
+func TestCheckRulePreviewDataSource_Read(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	mockClient := &MockClient{}
+	mockClient.On("QueryRange", mock.Anything, "default", "up == 0", mock.Anything, mock.Anything, time.Minute).Return([]client.RangeSeries{
+		// Pending for 2 minutes only: does not fire with for = 5m.
+		{Labels: map[string]string{"instance": "a"}, Timestamps: minutes(base, 0, 1, 2)},
+		// Pending from minute 10 to 16, and again from 30 to 40.
+		{Labels: map[string]string{"instance": "b"}, Timestamps: minutes(base, 10, 11, 12, 13, 14, 15, 16, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40)},
+	}, nil)
+
+	resp, model := readCheckRulePreview(t, &CheckRulePreviewDataSource{client: mockClient}, "up == 0", "5m", "", "")
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.BoolValue(true), model.WouldHaveFired)
+	assert.Equal(t, types.Int64Value(1), model.FiringSeriesCount)
+	assert.Equal(t, types.StringValue("2026-10-01T12:15:00Z"), model.FirstFiredAt)
+	assert.Equal(t, types.StringValue("2026-10-01T12:35:00Z"), model.LastFiredAt)
+	mockClient.AssertExpectations(t)
+}
+
+func TestCheckRulePreviewDataSource_Read_NotFired(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("QueryRange", mock.Anything, "default", "up == 0", mock.Anything, mock.Anything, 30*time.Second).Return([]client.RangeSeries{}, nil)
+
+	resp, model := readCheckRulePreview(t, &CheckRulePreviewDataSource{client: mockClient}, "up == 0", "", "1h", "30s")
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.BoolValue(false), model.WouldHaveFired)
+	assert.Equal(t, types.Int64Value(0), model.FiringSeriesCount)
+	assert.True(t, model.FirstFiredAt.IsNull())
+	mockClient.AssertExpectations(t)
+}
+
+func TestCheckRulePreviewDataSource_Read_Errors(t *testing.T) {
+	t.Run("invalid duration", func(t *testing.T) {
+		resp, _ := readCheckRulePreview(t, &CheckRulePreviewDataSource{client: &MockClient{}}, "up == 0", "five minutes", "", "")
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Invalid duration", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("too many steps", func(t *testing.T) {
+		resp, _ := readCheckRulePreview(t, &CheckRulePreviewDataSource{client: &MockClient{}}, "up == 0", "", "720h", "1s")
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Invalid step", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("QueryRange", mock.Anything, "default", "up ==", mock.Anything, mock.Anything, time.Minute).Return(nil, errors.New("dash0 api error: bad_data"))
+		resp, _ := readCheckRulePreview(t, &CheckRulePreviewDataSource{client: mockClient}, "up ==", "", "", "")
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "bad_data")
+	})
+}
+
+func TestFiringTimes(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	assert.Nil(t, firingTimes(nil, time.Minute, 0))
+	assert.Equal(t, minutes(base, 0, 5), firingTimes(minutes(base, 0, 1, 5), time.Minute, 0))
+	assert.Equal(t, minutes(base, 2), firingTimes(minutes(base, 0, 1, 2, 3), time.Minute, 2*time.Minute))
+	assert.Nil(t, firingTimes(minutes(base, 0, 2, 4), time.Minute, time.Minute))
+}
@@ -24,9 +24,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &CheckRuleResource{}
-	_ resource.ResourceWithConfigure   = &CheckRuleResource{}
-	_ resource.ResourceWithImportState = &CheckRuleResource{}
+	_ resource.Resource                   = &CheckRuleResource{}
+	_ resource.ResourceWithConfigure      = &CheckRuleResource{}
+	_ resource.ResourceWithImportState    = &CheckRuleResource{}
+	_ resource.ResourceWithValidateConfig = &CheckRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckRuleResource{}
 )
 
 // NewCheckRuleResource is a helper function to simplify the provider implementation.
@@ -36,7 +38,10 @@ func NewCheckRuleResource() resource.Resource {
 
 // CheckRuleResource is the resource implementation.
 type CheckRuleResource struct {
-	client client.Client
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
 }
 
 // checkRuleModel is the Terraform state model for a check rule resource.
@@ -45,7 +50,43 @@ type checkRuleModel struct {
 	ID            types.String `tfsdk:"id"`
 	Dataset       types.String `tfsdk:"dataset"`
 	CheckRuleYaml types.String `tfsdk:"check_rule_yaml"`
-	URL           types.String `tfsdk:"url"`
+	// NotificationChannelIDs is written into the rule annotations on every
+	// write; it is not part of the YAML stored in state.
+	NotificationChannelIDs types.Set    `tfsdk:"notification_channel_ids"`
+	Labels                 types.Map    `tfsdk:"labels"`
+	Annotations            types.Map    `tfsdk:"annotations"`
+	LabelsAll              types.Map    `tfsdk:"labels_all"`
+	NamePrefix             types.String `tfsdk:"name_prefix"`
+	NameSuffix             types.String `tfsdk:"name_suffix"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	IgnoreServerDefaults   types.Bool   `tfsdk:"ignore_server_defaults"`
+	URL                    types.String `tfsdk:"url"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model, which are applied to the rules of the definition.
+func (m checkRuleModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels:  defaultLabels,
+		Labels:         m.Labels,
+		Annotations:    m.Annotations,
+		NamePrefix:     m.NamePrefix,
+		NameSuffix:     m.NameSuffix,
+		PrometheusRule: true,
+	}
+}
+
+// definition returns the check rule YAML as sent to the API: the configured
+// YAML with the notification channel IDs and the provider-managed metadata,
+// on top of the given default labels, applied.
+func (m checkRuleModel) definition(ctx context.Context, defaultLabels map[string]string) (string, diag.Diagnostics) {
+	definition, diags := applyNotificationChannelIDs(ctx, m.CheckRuleYaml.ValueString(), m.NotificationChannelIDs, converter.SetCheckRuleNotificationChannels)
+	if diags.HasError() {
+		return "", diags
+	}
+	merged, mergeDiags := mergeManagedMetadata(ctx, definition, m.managedMetadata(defaultLabels))
+	diags.Append(mergeDiags...)
+	return merged, diags
 }
 
 // Configure adds the provider configured client to the resource.
@@ -64,12 +105,49 @@ func (r *CheckRuleResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_check_rule")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
 }
 
 func (r *CheckRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_check_rule"
 }
 
+// ValidateConfig checks the check rule definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *CheckRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model checkRuleModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.CheckRuleYaml, definitionCheck{
+		attribute:    "check_rule_yaml",
+		metadata:     &metadata,
+		validateYAML: client.ValidateCheckRule,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the labels the provider merges into the definition, and
+// checks the permissions of the auth token when the permission preflight is
+// enabled.
+func (r *CheckRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_check_rule", datasetCreateCheckRuleAction, &resp.Diagnostics)
+	var plan checkRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.CheckRuleYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *CheckRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `Manages a Dash0 Check Rule. Check rules define alerting conditions based on PromQL expressions that are continuously evaluated against your telemetry data. See [About Alerting](https://dash0.com/docs/dash0/monitoring/alerting/alerting) and [About Creating Check Rules](https://dash0.com/docs/dash0/monitoring/alerting/create-check-rules) for more details. The check rule definition uses the [Prometheus Rule format](https://prometheus.io/docs/prometheus/latest/configuration/alerting_rules/).
@@ -105,6 +183,14 @@ More information on how Prometheus rules are mapped to Dash0 check rules can be
 					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing),
 				},
 			},
+			"notification_channel_ids": notificationChannelIDsAttribute("check rule", "the `"+converter.CheckRuleNotificationChannelsAnnotation+"` annotation of the rule"),
+			"labels":                   ruleLabelsAttribute("check rule"),
+			"annotations":              ruleAnnotationsAttribute("check rule"),
+			"labels_all":               ruleLabelsAllAttribute("check rule"),
+			"name_prefix":              ruleNameAffixAttribute("check rule", "prepended to", "the name of every group"),
+			"name_suffix":              ruleNameAffixAttribute("check rule", "appended to", "the `alert` name of every rule"),
+			"on_destroy":               onDestroyAttribute("check rule"),
+			"ignore_server_defaults":   ignoreServerDefaultsAttribute("check rule"),
 			"url": schema.StringAttribute{
 				Description: "The URL to open this check rule in the Dash0 web app, derived from the Dash0 API URL and the check rule's server-assigned identifier. Computed by the provider after creation. May be empty if the app URL cannot be derived (e.g. for self-hosted deployments with a custom web app domain).",
 				Computed:    true,
@@ -156,8 +242,16 @@ func (r *CheckRuleResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// Route the rule's alerts to the referenced notification channels and
+	// apply the provider-managed labels and annotations
+	definition, diags := model.definition(ctx, r.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Pass YAML directly to client (the client handles Prometheus->Dash0 conversion)
-	err = r.client.CreateCheckRule(ctx, model.Origin.ValueString(), model.CheckRuleYaml.ValueString(), model.Dataset.ValueString())
+	err = r.client.CreateCheckRule(ctx, model.Origin.ValueString(), definition, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create check rule, got error: %s", err))
 		return
@@ -166,6 +260,9 @@ func (r *CheckRuleResource) Create(ctx context.Context, req resource.CreateReque
 	// Resolve the id and web app URL for the newly created check rule (best-effort).
 	r.resolveCheckRule(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.CheckRuleYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a check rule resource")
 
 	// Set state to fully populated data
@@ -200,9 +297,20 @@ func (r *CheckRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Compare the current state with the retrieved check rule
 	if state.CheckRuleYaml.ValueString() != "" {
-		stateYAML := state.CheckRuleYaml.ValueString()
+		// Compare including the notification channel IDs and the
+		// provider-managed metadata, which were written into the definition but
+		// are not part of the YAML stored in state. The default labels are the
+		// ones recorded in labels_all, so that a change to default_labels is
+		// planned through labels_all rather than reported as drift of the
+		// rules.
+		stateYAML, diags := state.definition(ctx, appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseYAML, additionalIgnored, []string{converter.AnnotationSharing})
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseYAML, state.IgnoreServerDefaults), additionalIgnored, []string{converter.AnnotationSharing})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Check Rule Comparison Error",
@@ -252,6 +360,14 @@ func (r *CheckRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// Route the rule's alerts to the referenced notification channels and
+	// apply the provider-managed labels and annotations
+	definition, diags := plan.definition(ctx, r.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the existing check rule (dataset changes force recreation via RequiresReplace)
 	// Pass YAML directly to client (the client handles Prometheus->Dash0 conversion)
 	plan.Origin = state.Origin
@@ -260,12 +376,15 @@ func (r *CheckRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	// the API.
 	plan.ID = state.ID
 	plan.URL = state.URL
-	err = r.client.UpdateCheckRule(ctx, plan.Origin.ValueString(), plan.CheckRuleYaml.ValueString(), plan.Dataset.ValueString())
+	err = r.client.UpdateCheckRule(ctx, plan.Origin.ValueString(), definition, plan.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update check rule, got error: %s", err))
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.CheckRuleYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a check rule resource")
 
 	// Set state to fully populated data
@@ -281,6 +400,11 @@ func (r *CheckRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.OnDestroy.ValueString() == onDestroyDisable {
+		r.disable(ctx, state, &resp.Diagnostics)
+		return
+	}
+
 	err := r.client.DeleteCheckRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete check rule, got error: %s", err))
@@ -324,6 +448,31 @@ func (r *CheckRuleResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
 }
 
+// disable keeps the check rule on destroy and only sets the dash0-enabled
+// annotation to "false" on its rules, re-sending the definition last written by
+// the provider with the default labels recorded in labels_all.
+func (r *CheckRuleResource) disable(ctx context.Context, state checkRuleModel, diags *diag.Diagnostics) {
+	definition, applyDiags := state.definition(ctx, appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels))
+	diags.Append(applyDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	disabled, err := converter.DisableCheckRule(definition)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to disable check rule definition: %s", err))
+		return
+	}
+
+	err = r.client.UpdateCheckRule(ctx, state.Origin.ValueString(), disabled, state.Dataset.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to disable check rule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "disabled a check rule resource instead of deleting it")
+}
+
 // injectMetadataName copies metadata.name from sourceYAML into targetYAML when
 // it is present in the source but absent from the target. This compensates for
 // the Dash0 API not preserving the CRD metadata name in check rules.
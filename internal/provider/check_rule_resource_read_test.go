@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -127,6 +128,34 @@ spec:
 					"check_rule_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"notification_channel_ids": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"on_destroy": schema.StringAttribute{
+						Optional: true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -144,19 +173,35 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":          tftypes.String,
-						"id":              tftypes.String,
-						"dataset":         tftypes.String,
-						"check_rule_yaml": tftypes.String,
-						"url":             tftypes.String,
+						"origin":                   tftypes.String,
+						"id":                       tftypes.String,
+						"dataset":                  tftypes.String,
+						"check_rule_yaml":          tftypes.String,
+						"labels":                   tftypes.Map{ElementType: tftypes.String},
+						"annotations":              tftypes.Map{ElementType: tftypes.String},
+						"labels_all":               tftypes.Map{ElementType: tftypes.String},
+						"name_prefix":              tftypes.String,
+						"name_suffix":              tftypes.String,
+						"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+						"on_destroy":               tftypes.String,
+						"ignore_server_defaults":   tftypes.Bool,
+						"url":                      tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":          tftypes.NewValue(tftypes.String, testOrigin),
-					"id":              tftypes.NewValue(tftypes.String, nil),
-					"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-					"check_rule_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-					"url":             tftypes.NewValue(tftypes.String, testURL),
+					"origin":                   tftypes.NewValue(tftypes.String, testOrigin),
+					"id":                       tftypes.NewValue(tftypes.String, nil),
+					"dataset":                  tftypes.NewValue(tftypes.String, testDataset),
+					"check_rule_yaml":          tftypes.NewValue(tftypes.String, originalYaml),
+					"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+					"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+					"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+					"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+					"url":                      tftypes.NewValue(tftypes.String, testURL),
 				},
 			)
 
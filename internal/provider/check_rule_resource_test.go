@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -164,19 +165,35 @@ func TestCheckRuleResource_Create_InvalidYAML(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":          tftypes.String,
-					"id":              tftypes.String,
-					"dataset":         tftypes.String,
-					"check_rule_yaml": tftypes.String,
-					"url":             tftypes.String,
+					"origin":                   tftypes.String,
+					"id":                       tftypes.String,
+					"dataset":                  tftypes.String,
+					"check_rule_yaml":          tftypes.String,
+					"labels":                   tftypes.Map{ElementType: tftypes.String},
+					"annotations":              tftypes.Map{ElementType: tftypes.String},
+					"labels_all":               tftypes.Map{ElementType: tftypes.String},
+					"name_prefix":              tftypes.String,
+					"name_suffix":              tftypes.String,
+					"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+					"on_destroy":               tftypes.String,
+					"ignore_server_defaults":   tftypes.Bool,
+					"url":                      tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":          tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":              tftypes.NewValue(tftypes.String, nil),
-				"dataset":         tftypes.NewValue(tftypes.String, "test-dataset"),
-				"check_rule_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
-				"url":             tftypes.NewValue(tftypes.String, nil),
+				"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+				"id":                       tftypes.NewValue(tftypes.String, nil),
+				"dataset":                  tftypes.NewValue(tftypes.String, "test-dataset"),
+				"check_rule_yaml":          tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+				"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+				"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+				"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+				"url":                      tftypes.NewValue(tftypes.String, nil),
 			},
 		),
 		Schema: schema.Schema{
@@ -193,6 +210,34 @@ func TestCheckRuleResource_Create_InvalidYAML(t *testing.T) {
 				"check_rule_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
+				"notification_channel_ids": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"on_destroy": schema.StringAttribute{
+					Optional: true,
+				},
+				"ignore_server_defaults": schema.BoolAttribute{
+					Optional: true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -224,6 +269,34 @@ func testCheckRuleSchema() schema.Schema {
 			"check_rule_yaml": schema.StringAttribute{
 				Required: true,
 			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_suffix": schema.StringAttribute{
+				Optional: true,
+			},
+			"notification_channel_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+			},
+			"ignore_server_defaults": schema.BoolAttribute{
+				Optional: true,
+			},
 			"url": schema.StringAttribute{
 				Computed: true,
 			},
@@ -250,11 +323,19 @@ spec:
 
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":          tftypes.NewValue(tftypes.String, ""),
-			"id":              tftypes.NewValue(tftypes.String, nil),
-			"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":             tftypes.NewValue(tftypes.String, nil),
+			"origin":                   tftypes.NewValue(tftypes.String, ""),
+			"id":                       tftypes.NewValue(tftypes.String, nil),
+			"dataset":                  tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":          tftypes.NewValue(tftypes.String, testYaml),
+			"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+			"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+			"url":                      tftypes.NewValue(tftypes.String, nil),
 		}),
 		Schema: testCheckRuleSchema(),
 	}
@@ -278,12 +359,172 @@ spec:
 	assert.Equal(t, testURL, resultState.URL.ValueString())
 }
 
-func TestCheckRuleResource_Update(t *testing.T) {
+func TestCheckRuleResource_CreateWithNotificationChannelIDs(t *testing.T) {
 	mockClient := new(MockClient)
 	r := &CheckRuleResource{client: mockClient}
 
-	testOrigin := "test-origin"
+	testYaml := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-rule
+spec:
+  groups:
+    - name: TestGroup
+      rules:
+        - alert: TestAlert
+          expr: up == 0`
+
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+			"origin":          tftypes.NewValue(tftypes.String, ""),
+			"id":              tftypes.NewValue(tftypes.String, nil),
+			"dataset":         tftypes.NewValue(tftypes.String, "test-dataset"),
+			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml),
+			"labels":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"name_prefix":     tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":     tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "channel-b"),
+				tftypes.NewValue(tftypes.String, "channel-a"),
+			}),
+			"on_destroy":             tftypes.NewValue(tftypes.String, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testCheckRuleSchema(),
+	}
+	resp := resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema},
+	}
+
+	// The IDs are written, sorted, into the rule annotations of the definition
+	// sent to the API.
+	mockClient.On("CreateCheckRule", mock.Anything, mock.Anything, mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, converter.CheckRuleNotificationChannelsAnnotation+": channel-a,channel-b")
+	}), "test-dataset").Return(nil)
+	mockClient.On("ResolveCheckRule", mock.Anything, mock.Anything, "test-dataset").Return("test-id", "", nil)
+
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+
+	mockClient.AssertExpectations(t)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// The YAML in state is the one from the configuration.
+	var resultState checkRuleModel
+	require.False(t, resp.State.Get(context.Background(), &resultState).HasError())
+	assert.Equal(t, testYaml, resultState.CheckRuleYaml.ValueString())
+}
+
+func TestCheckRuleResource_CreateWithLabels(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &CheckRuleResource{client: mockClient}
+
+	testYaml := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-rule
+spec:
+  groups:
+    - name: TestGroup
+      rules:
+        - alert: TestAlert
+          expr: up == 0`
+
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+			"origin":          tftypes.NewValue(tftypes.String, ""),
+			"id":              tftypes.NewValue(tftypes.String, nil),
+			"dataset":         tftypes.NewValue(tftypes.String, "test-dataset"),
+			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml),
+			"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"team": tftypes.NewValue(tftypes.String, "checkout"),
+			}),
+			"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+			"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+			"url":                      tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testCheckRuleSchema(),
+	}
+	resp := resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema},
+	}
+
+	// The labels are written into the rule, since the API does not keep the
+	// metadata of the PrometheusRule.
+	mockClient.On("CreateCheckRule", mock.Anything, mock.Anything, mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "          labels:\n            team: checkout")
+	}), "test-dataset").Return(nil)
+	mockClient.On("ResolveCheckRule", mock.Anything, mock.Anything, "test-dataset").Return("test-id", "", nil)
+
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+
+	mockClient.AssertExpectations(t)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestCheckRuleResource_DeleteWithDisable(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &CheckRuleResource{client: mockClient}
+
+	testYaml := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-rule
+spec:
+  groups:
+    - name: TestGroup
+      rules:
+        - alert: TestAlert
+          expr: up == 0`
 	testDataset := "test-dataset"
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+			"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+			"id":                       tftypes.NewValue(tftypes.String, nil),
+			"dataset":                  tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":          tftypes.NewValue(tftypes.String, testYaml),
+			"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"on_destroy":               tftypes.NewValue(tftypes.String, onDestroyDisable),
+			"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+			"url":                      tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testCheckRuleSchema(),
+	}
+	req := resource.DeleteRequest{State: state}
+	resp := resource.DeleteResponse{}
+
+	// The rule is updated with dash0-enabled set to "false" instead of being deleted.
+	mockClient.On("UpdateCheckRule", mock.Anything, "test-origin", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, `dash0-enabled: "false"`)
+	}), testDataset).Return(nil)
+
+	r.Delete(context.Background(), req, &resp)
+
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "DeleteCheckRule", mock.Anything, mock.Anything, mock.Anything)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+// TestCheckRuleResource_DeleteWithDisable_DefaultLabelsChanged checks that
+// disabling re-sends the definition with the default labels recorded in
+// labels_all rather than the current default labels of the provider.
+func TestCheckRuleResource_DeleteWithDisable_DefaultLabelsChanged(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &CheckRuleResource{client: mockClient, defaultLabels: map[string]string{"tier": "gold"}}
+
 	testYaml := `apiVersion: monitoring.coreos.com/v1
 kind: PrometheusRule
 metadata:
@@ -294,25 +535,92 @@ spec:
       rules:
         - alert: TestAlert
           expr: up == 0`
-	testURL := "https://app.dash0.com/goto/alerting/check-rules?check_rule_id=internal-uuid"
+	testDataset := "test-dataset"
 
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":          tftypes.NewValue(tftypes.String, testOrigin),
+			"origin":          tftypes.NewValue(tftypes.String, "test-origin"),
 			"id":              tftypes.NewValue(tftypes.String, nil),
 			"dataset":         tftypes.NewValue(tftypes.String, testDataset),
 			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":             tftypes.NewValue(tftypes.String, testURL),
+			"labels":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"tier": tftypes.NewValue(tftypes.String, "silver"),
+			}),
+			"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"on_destroy":               tftypes.NewValue(tftypes.String, onDestroyDisable),
+			"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+			"url":                      tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testCheckRuleSchema(),
+	}
+	req := resource.DeleteRequest{State: state}
+	resp := resource.DeleteResponse{}
+
+	mockClient.On("UpdateCheckRule", mock.Anything, "test-origin", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, `dash0-enabled: "false"`) && strings.Contains(body, "tier: silver") && !strings.Contains(body, "gold")
+	}), testDataset).Return(nil)
+
+	r.Delete(context.Background(), req, &resp)
+
+	mockClient.AssertExpectations(t)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestCheckRuleResource_Update(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &CheckRuleResource{client: mockClient}
+
+	testOrigin := "test-origin"
+	testDataset := "test-dataset"
+	testYaml := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-rule
+spec:
+  groups:
+    - name: TestGroup
+      rules:
+        - alert: TestAlert
+          expr: up == 0`
+	testURL := "https://app.dash0.com/goto/alerting/check-rules?check_rule_id=internal-uuid"
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+			"origin":                   tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                       tftypes.NewValue(tftypes.String, nil),
+			"dataset":                  tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":          tftypes.NewValue(tftypes.String, testYaml),
+			"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+			"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+			"url":                      tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: testCheckRuleSchema(),
 	}
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":          tftypes.NewValue(tftypes.String, testOrigin),
-			"id":              tftypes.NewValue(tftypes.String, nil),
-			"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml+"\n          for: 5m"),
-			"url":             tftypes.NewValue(tftypes.String, testURL),
+			"origin":                   tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                       tftypes.NewValue(tftypes.String, nil),
+			"dataset":                  tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":          tftypes.NewValue(tftypes.String, testYaml+"\n          for: 5m"),
+			"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+			"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+			"url":                      tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: state.Schema,
 	}
@@ -460,19 +768,35 @@ func TestCheckRuleResource_ReadError(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":          tftypes.String,
-					"id":              tftypes.String,
-					"dataset":         tftypes.String,
-					"check_rule_yaml": tftypes.String,
-					"url":             tftypes.String,
+					"origin":                   tftypes.String,
+					"id":                       tftypes.String,
+					"dataset":                  tftypes.String,
+					"check_rule_yaml":          tftypes.String,
+					"labels":                   tftypes.Map{ElementType: tftypes.String},
+					"annotations":              tftypes.Map{ElementType: tftypes.String},
+					"labels_all":               tftypes.Map{ElementType: tftypes.String},
+					"name_prefix":              tftypes.String,
+					"name_suffix":              tftypes.String,
+					"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+					"on_destroy":               tftypes.String,
+					"ignore_server_defaults":   tftypes.Bool,
+					"url":                      tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":          tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":              tftypes.NewValue(tftypes.String, nil),
-				"dataset":         tftypes.NewValue(tftypes.String, "test-dataset"),
-				"check_rule_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
-				"url":             tftypes.NewValue(tftypes.String, nil),
+				"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+				"id":                       tftypes.NewValue(tftypes.String, nil),
+				"dataset":                  tftypes.NewValue(tftypes.String, "test-dataset"),
+				"check_rule_yaml":          tftypes.NewValue(tftypes.String, "test-yaml"),
+				"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+				"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+				"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+				"url":                      tftypes.NewValue(tftypes.String, nil),
 			},
 		),
 		Schema: schema.Schema{
@@ -489,6 +813,34 @@ func TestCheckRuleResource_ReadError(t *testing.T) {
 				"check_rule_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
+				"notification_channel_ids": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"on_destroy": schema.StringAttribute{
+					Optional: true,
+				},
+				"ignore_server_defaults": schema.BoolAttribute{
+					Optional: true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
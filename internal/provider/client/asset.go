@@ -0,0 +1,288 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// The generic asset endpoints back the dash0_resource escape hatch, which
+// manages asset kinds that have no dedicated resource yet. An asset of a given
+// kind lives at /api/{kind}/{origin}, is upserted with PUT and scoped to a
+// dataset by the dataset query parameter. The library has no high-level
+// methods for a generic asset, so the requests go through its generated
+// operations, and only the kinds the library has operations for are
+// supported. The definition is sent as-is, so that fields the library's types
+// do not declare yet are kept.
+
+// assetOperations are the generated library operations on the assets of one
+// kind.
+type assetOperations struct {
+	get    func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error)
+	put    func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error)
+	delete func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error)
+}
+
+// assetKinds maps each asset kind supported by the generic asset endpoints,
+// as it appears in the API path, to its operations.
+var assetKinds = map[string]assetOperations{
+	"agentic-workflows": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiAgenticWorkflowsOriginOrId(ctx, origin, &dash0.GetApiAgenticWorkflowsOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiAgenticWorkflowsOriginOrIdWithBody(ctx, origin, &dash0.PutApiAgenticWorkflowsOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiAgenticWorkflowsOriginOrId(ctx, origin, &dash0.DeleteApiAgenticWorkflowsOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"dashboards": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiDashboardsOriginOrId(ctx, origin, &dash0.GetApiDashboardsOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiDashboardsOriginOrIdWithBody(ctx, origin, &dash0.PutApiDashboardsOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiDashboardsOriginOrId(ctx, origin, &dash0.DeleteApiDashboardsOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"recording-rules": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiRecordingRulesOriginOrId(ctx, origin, &dash0.GetApiRecordingRulesOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiRecordingRulesOriginOrIdWithBody(ctx, origin, &dash0.PutApiRecordingRulesOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiRecordingRulesOriginOrId(ctx, origin, &dash0.DeleteApiRecordingRulesOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"sampling-rules": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiSamplingRulesOriginOrId(ctx, origin, &dash0.GetApiSamplingRulesOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiSamplingRulesOriginOrIdWithBody(ctx, origin, &dash0.PutApiSamplingRulesOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiSamplingRulesOriginOrId(ctx, origin, &dash0.DeleteApiSamplingRulesOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"signal-to-metrics": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiSignalToMetricsOriginOrId(ctx, origin, &dash0.GetApiSignalToMetricsOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiSignalToMetricsOriginOrIdWithBody(ctx, origin, &dash0.PutApiSignalToMetricsOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiSignalToMetricsOriginOrId(ctx, origin, &dash0.DeleteApiSignalToMetricsOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"slos": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiSlosOriginOrId(ctx, origin, &dash0.GetApiSlosOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiSlosOriginOrIdWithBody(ctx, origin, &dash0.PutApiSlosOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiSlosOriginOrId(ctx, origin, &dash0.DeleteApiSlosOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"spam-filters": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiSpamFiltersOriginOrId(ctx, origin, &dash0.GetApiSpamFiltersOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiSpamFiltersOriginOrIdWithBody(ctx, origin, &dash0.PutApiSpamFiltersOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiSpamFiltersOriginOrId(ctx, origin, &dash0.DeleteApiSpamFiltersOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"synthetic-checks": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiSyntheticChecksOriginOrId(ctx, origin, &dash0.GetApiSyntheticChecksOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiSyntheticChecksOriginOrIdWithBody(ctx, origin, &dash0.PutApiSyntheticChecksOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiSyntheticChecksOriginOrId(ctx, origin, &dash0.DeleteApiSyntheticChecksOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"time-series-aggregations": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiTimeSeriesAggregationsOriginOrId(ctx, origin, &dash0.GetApiTimeSeriesAggregationsOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiTimeSeriesAggregationsOriginOrIdWithBody(ctx, origin, &dash0.PutApiTimeSeriesAggregationsOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiTimeSeriesAggregationsOriginOrId(ctx, origin, &dash0.DeleteApiTimeSeriesAggregationsOriginOrIdParams{Dataset: dataset})
+		},
+	},
+	"views": {
+		get: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.GetApiViewsOriginOrId(ctx, origin, &dash0.GetApiViewsOriginOrIdParams{Dataset: dataset})
+		},
+		put: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string, body io.Reader) (*http.Response, error) {
+			return api.PutApiViewsOriginOrIdWithBody(ctx, origin, &dash0.PutApiViewsOriginOrIdParams{Dataset: dataset}, "application/json", body)
+		},
+		delete: func(ctx context.Context, api *dash0.ClientWithResponses, origin string, dataset *string) (*http.Response, error) {
+			return api.DeleteApiViewsOriginOrId(ctx, origin, &dash0.DeleteApiViewsOriginOrIdParams{Dataset: dataset})
+		},
+	},
+}
+
+// AssetKinds returns the asset kinds supported by the generic asset
+// endpoints, sorted alphabetically.
+func AssetKinds() []string {
+	kinds := make([]string, 0, len(assetKinds))
+	for kind := range assetKinds {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// CreateAsset creates or replaces the asset of the given kind with the
+// provided origin, stamping the origin into metadata.labels["dash0.com/origin"]
+// when the definition has a metadata object.
+func (c *dash0Client) CreateAsset(ctx context.Context, kind, origin, assetJSON, dataset string) error {
+	ops, err := assetOperationsOf(kind)
+	if err != nil {
+		return err
+	}
+	body, err := setAssetOrigin(assetJSON, origin)
+	if err != nil {
+		return fmt.Errorf("error parsing %s asset JSON: %w", kind, err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s asset with origin: %s", kind, origin))
+
+	if _, err := assetResponse(ops.put(ctx, c.inner.Inner(), origin, datasetParam(dataset), bytes.NewReader(body))); err != nil {
+		return reconcileCreate(ctx, kind+" asset", origin, err, func(ctx context.Context) error {
+			_, err := c.GetAsset(ctx, kind, origin, dataset)
+			return err
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s asset created with origin: %s", kind, origin))
+	return nil
+}
+
+// GetAsset retrieves the asset of the given kind with the given origin and
+// returns the response body unchanged.
+func (c *dash0Client) GetAsset(ctx context.Context, kind, origin, dataset string) (string, error) {
+	ops, err := assetOperationsOf(kind)
+	if err != nil {
+		return "", err
+	}
+	body, err := assetResponse(ops.get(ctx, c.inner.Inner(), origin, datasetParam(dataset)))
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s asset retrieved with origin: %s", kind, origin))
+	return string(body), nil
+}
+
+// UpdateAsset updates the asset of the given kind with the given origin. Uses
+// the same PUT endpoint as CreateAsset.
+func (c *dash0Client) UpdateAsset(ctx context.Context, kind, origin, assetJSON, dataset string) error {
+	ops, err := assetOperationsOf(kind)
+	if err != nil {
+		return err
+	}
+	body, err := setAssetOrigin(assetJSON, origin)
+	if err != nil {
+		return fmt.Errorf("error parsing %s asset JSON: %w", kind, err)
+	}
+
+	if _, err := assetResponse(ops.put(ctx, c.inner.Inner(), origin, datasetParam(dataset), bytes.NewReader(body))); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s asset updated with origin: %s", kind, origin))
+	return nil
+}
+
+// DeleteAsset deletes the asset of the given kind with the given origin.
+func (c *dash0Client) DeleteAsset(ctx context.Context, kind, origin, dataset string) error {
+	ops, err := assetOperationsOf(kind)
+	if err != nil {
+		return err
+	}
+	if _, err := assetResponse(ops.delete(ctx, c.inner.Inner(), origin, datasetParam(dataset))); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s asset deleted with origin: %s", kind, origin))
+	return nil
+}
+
+// assetOperationsOf returns the operations of the given asset kind, or an
+// error naming the supported kinds.
+func assetOperationsOf(kind string) (assetOperations, error) {
+	ops, ok := assetKinds[kind]
+	if !ok {
+		return assetOperations{}, fmt.Errorf("unsupported asset kind %q, expected one of: %s", kind, strings.Join(AssetKinds(), ", "))
+	}
+	return ops, nil
+}
+
+// datasetParam returns the dataset query parameter, which is omitted for an
+// empty dataset.
+func datasetParam(dataset string) *string {
+	if dataset == "" {
+		return nil
+	}
+	return &dataset
+}
+
+// assetResponse returns the body of a response to a generic asset request.
+// Responses with a status outside the 2xx range are returned as
+// *dash0.APIError, so that helpers like dash0.IsNotFound apply to them.
+func assetResponse(resp *http.Response, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, dash0.NewAPIError(resp)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// setAssetOrigin returns the JSON definition with the origin stamped into
+// metadata.labels["dash0.com/origin"]. Definitions without a metadata object
+// are returned re-encoded but otherwise unchanged.
+func setAssetOrigin(assetJSON, origin string) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(assetJSON), &doc); err != nil {
+		return nil, err
+	}
+	if metadata, ok := doc["metadata"].(map[string]interface{}); ok {
+		labels, ok := metadata["labels"].(map[string]interface{})
+		if !ok {
+			labels = map[string]interface{}{}
+			metadata["labels"] = labels
+		}
+		labels["dash0.com/origin"] = origin
+	}
+	return json.Marshal(doc)
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func TestAssetEndpoints(t *testing.T) {
+	var requests []string
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		assert.Equal(t, "Bearer auth_test-token", r.Header.Get("Authorization"))
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &putBody))
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			if r.URL.Path == "/api/sampling-rules/tf_missing" {
+				http.Error(w, `{"error":{"code":404,"message":"not found"}}`, http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"kind":"Dash0SamplingRule","metadata":{"name":"errors"}}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	require.NoError(t, c.CreateAsset(ctx, "sampling-rules", "tf_abc", `{"kind":"Dash0SamplingRule","metadata":{"name":"errors"}}`, "default"))
+	assert.Equal(t, map[string]interface{}{"dash0.com/origin": "tf_abc"}, putBody["metadata"].(map[string]interface{})["labels"])
+
+	got, err := c.GetAsset(ctx, "sampling-rules", "tf_abc", "default")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"Dash0SamplingRule","metadata":{"name":"errors"}}`, got)
+
+	_, err = c.GetAsset(ctx, "sampling-rules", "tf_missing", "default")
+	assert.True(t, dash0.IsNotFound(err), err)
+
+	require.NoError(t, c.UpdateAsset(ctx, "sampling-rules", "tf_abc", `{"kind":"Dash0SamplingRule"}`, ""))
+	require.NoError(t, c.DeleteAsset(ctx, "sampling-rules", "tf_abc", "default"))
+
+	assert.Equal(t, []string{
+		"PUT /api/sampling-rules/tf_abc?dataset=default",
+		"GET /api/sampling-rules/tf_abc?dataset=default",
+		"GET /api/sampling-rules/tf_missing?dataset=default",
+		"PUT /api/sampling-rules/tf_abc",
+		"DELETE /api/sampling-rules/tf_abc?dataset=default",
+	}, requests)
+
+	err = c.CreateAsset(ctx, "sampling-rules", "tf_abc", "not json", "default")
+	assert.ErrorContains(t, err, "error parsing sampling-rules asset JSON")
+
+	_, err = c.GetAsset(ctx, "widgets", "tf_abc", "default")
+	assert.ErrorContains(t, err, `unsupported asset kind "widgets"`)
+	assert.Len(t, requests, 5, "unsupported kinds must not be sent to the API")
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// auditOutcomeSuccess and auditOutcomeError are the values of the outcome field
+// of an audit log entry.
+const (
+	auditOutcomeSuccess = "success"
+	auditOutcomeError   = "error"
+)
+
+// auditEntry is a single line of the audit log.
+type auditEntry struct {
+	Time      string `json:"time"`
+	Operation string `json:"operation"`
+	Kind      string `json:"kind"`
+	Dataset   string `json:"dataset,omitempty"`
+	Origin    string `json:"origin"`
+	Outcome   string `json:"outcome"`
+	Error     string `json:"error,omitempty"`
+}
+
+// auditLog appends JSON lines to a local file. Each entry is written with a
+// single unbuffered write so that entries survive the provider process being
+// terminated at the end of a Terraform run.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+	now  func() time.Time
+}
+
+func (l *auditLog) record(operation, kind, dataset, origin string, err error) error {
+	entry := auditEntry{
+		Time:      l.now().UTC().Format(time.RFC3339Nano),
+		Operation: operation,
+		Kind:      kind,
+		Dataset:   dataset,
+		Origin:    origin,
+		Outcome:   auditOutcomeSuccess,
+	}
+	if err != nil {
+		entry.Outcome = auditOutcomeError
+		entry.Error = err.Error()
+	}
+	line, marshalErr := json.Marshal(entry)
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, writeErr := l.file.Write(append(line, '\n'))
+	return writeErr
+}
+
+// mutationRecorder records the outcome of a mutation. It is implemented by
+// the audit log and the operation summary.
+type mutationRecorder interface {
+	record(operation, kind, dataset, origin string, err error) error
+}
+
+// auditingClient decorates a Client so that every create, update and delete
+// it performs is recorded, whether it succeeds or not. Reads are passed
+// through unrecorded.
+type auditingClient struct {
+	Client
+	log mutationRecorder
+}
+
+// NewAuditingClient wraps inner so that every mutation is appended as a JSON
+// line to the file at path, which is created if it does not exist.
+func NewAuditingClient(inner Client, path string) (Client, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	return &auditingClient{Client: inner, log: &auditLog{file: file, now: time.Now}}, nil
+}
+
+// audit records the outcome of a mutation and returns its error. A failure to
+// write the audit log fails the operation, so that no mutation goes
+// unrecorded without the user noticing.
+func (c *auditingClient) audit(operation, kind, dataset, origin string, err error) error {
+	if logErr := c.log.record(operation, kind, dataset, origin, err); logErr != nil {
+		if err != nil {
+			return fmt.Errorf("%w (additionally, writing the audit log failed: %v)", err, logErr)
+		}
+		return fmt.Errorf("%s %s with origin %s succeeded, but writing the audit log failed: %w", operation, kind, origin, logErr)
+	}
+	return err
+}
+
+func (c *auditingClient) CreateDashboard(ctx context.Context, origin string, dashboardJSON string, dataset string) error {
+	return c.audit("create", "dashboard", dataset, origin, c.Client.CreateDashboard(ctx, origin, dashboardJSON, dataset))
+}
+
+func (c *auditingClient) UpdateDashboard(ctx context.Context, origin string, dashboardJSON string, dataset string) error {
+	return c.audit("update", "dashboard", dataset, origin, c.Client.UpdateDashboard(ctx, origin, dashboardJSON, dataset))
+}
+
+func (c *auditingClient) DeleteDashboard(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "dashboard", dataset, origin, c.Client.DeleteDashboard(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error {
+	return c.audit("create", "synthetic_check", dataset, origin, c.Client.CreateSyntheticCheck(ctx, origin, checkJSON, dataset))
+}
+
+func (c *auditingClient) UpdateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error {
+	return c.audit("update", "synthetic_check", dataset, origin, c.Client.UpdateSyntheticCheck(ctx, origin, checkJSON, dataset))
+}
+
+func (c *auditingClient) DeleteSyntheticCheck(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "synthetic_check", dataset, origin, c.Client.DeleteSyntheticCheck(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateView(ctx context.Context, origin string, viewJSON string, dataset string) error {
+	return c.audit("create", "view", dataset, origin, c.Client.CreateView(ctx, origin, viewJSON, dataset))
+}
+
+func (c *auditingClient) UpdateView(ctx context.Context, origin string, viewJSON string, dataset string) error {
+	return c.audit("update", "view", dataset, origin, c.Client.UpdateView(ctx, origin, viewJSON, dataset))
+}
+
+func (c *auditingClient) DeleteView(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "view", dataset, origin, c.Client.DeleteView(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error {
+	return c.audit("create", "check_rule", dataset, origin, c.Client.CreateCheckRule(ctx, origin, ruleYAML, dataset))
+}
+
+func (c *auditingClient) UpdateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error {
+	return c.audit("update", "check_rule", dataset, origin, c.Client.UpdateCheckRule(ctx, origin, ruleYAML, dataset))
+}
+
+func (c *auditingClient) DeleteCheckRule(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "check_rule", dataset, origin, c.Client.DeleteCheckRule(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateRecordingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	return c.audit("create", "recording_rule", dataset, origin, c.Client.CreateRecordingRule(ctx, origin, ruleJSON, dataset))
+}
+
+func (c *auditingClient) UpdateRecordingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	return c.audit("update", "recording_rule", dataset, origin, c.Client.UpdateRecordingRule(ctx, origin, ruleJSON, dataset))
+}
+
+func (c *auditingClient) DeleteRecordingRule(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "recording_rule", dataset, origin, c.Client.DeleteRecordingRule(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error {
+	return c.audit("create", "slo", dataset, origin, c.Client.CreateSLO(ctx, origin, sloJSON, dataset))
+}
+
+func (c *auditingClient) UpdateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error {
+	return c.audit("update", "slo", dataset, origin, c.Client.UpdateSLO(ctx, origin, sloJSON, dataset))
+}
+
+func (c *auditingClient) DeleteSLO(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "slo", dataset, origin, c.Client.DeleteSLO(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	return c.audit("create", "sampling_rule", dataset, origin, c.Client.CreateSamplingRule(ctx, origin, ruleJSON, dataset))
+}
+
+func (c *auditingClient) UpdateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	return c.audit("update", "sampling_rule", dataset, origin, c.Client.UpdateSamplingRule(ctx, origin, ruleJSON, dataset))
+}
+
+func (c *auditingClient) DeleteSamplingRule(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "sampling_rule", dataset, origin, c.Client.DeleteSamplingRule(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateNotificationChannel(ctx context.Context, origin string, channelJSON string) error {
+	return c.audit("create", "notification_channel", "", origin, c.Client.CreateNotificationChannel(ctx, origin, channelJSON))
+}
+
+func (c *auditingClient) UpdateNotificationChannel(ctx context.Context, origin string, channelJSON string) error {
+	return c.audit("update", "notification_channel", "", origin, c.Client.UpdateNotificationChannel(ctx, origin, channelJSON))
+}
+
+func (c *auditingClient) DeleteNotificationChannel(ctx context.Context, origin string) error {
+	return c.audit("delete", "notification_channel", "", origin, c.Client.DeleteNotificationChannel(ctx, origin))
+}
+
+func (c *auditingClient) CreateTeam(ctx context.Context, origin string, teamJSON string) error {
+	return c.audit("create", "team", "", origin, c.Client.CreateTeam(ctx, origin, teamJSON))
+}
+
+func (c *auditingClient) UpdateTeam(ctx context.Context, origin string, teamJSON string) error {
+	return c.audit("update", "team", "", origin, c.Client.UpdateTeam(ctx, origin, teamJSON))
+}
+
+func (c *auditingClient) DeleteTeam(ctx context.Context, origin string) error {
+	return c.audit("delete", "team", "", origin, c.Client.DeleteTeam(ctx, origin))
+}
+
+func (c *auditingClient) CreateTeamMembership(ctx context.Context, team string, memberID string) error {
+	return c.audit("create", "team_membership", "", team+"/"+memberID, c.Client.CreateTeamMembership(ctx, team, memberID))
+}
+
+func (c *auditingClient) DeleteTeamMembership(ctx context.Context, team string, memberID string) error {
+	return c.audit("delete", "team_membership", "", team+"/"+memberID, c.Client.DeleteTeamMembership(ctx, team, memberID))
+}
+
+func (c *auditingClient) CreateMember(ctx context.Context, email string, role string) error {
+	return c.audit("create", "member", "", email, c.Client.CreateMember(ctx, email, role))
+}
+
+func (c *auditingClient) DeleteMember(ctx context.Context, id string) error {
+	return c.audit("delete", "member", "", id, c.Client.DeleteMember(ctx, id))
+}
+
+func (c *auditingClient) CreateSpamFilter(ctx context.Context, origin string, filterJSON string, dataset string) error {
+	return c.audit("create", "spam_filter", dataset, origin, c.Client.CreateSpamFilter(ctx, origin, filterJSON, dataset))
+}
+
+func (c *auditingClient) UpdateSpamFilter(ctx context.Context, origin string, filterJSON string, dataset string) error {
+	return c.audit("update", "spam_filter", dataset, origin, c.Client.UpdateSpamFilter(ctx, origin, filterJSON, dataset))
+}
+
+func (c *auditingClient) DeleteSpamFilter(ctx context.Context, origin string, dataset string) error {
+	return c.audit("delete", "spam_filter", dataset, origin, c.Client.DeleteSpamFilter(ctx, origin, dataset))
+}
+
+func (c *auditingClient) CreateAsset(ctx context.Context, kind string, origin string, assetJSON string, dataset string) error {
+	return c.audit("create", kind, dataset, origin, c.Client.CreateAsset(ctx, kind, origin, assetJSON, dataset))
+}
+
+func (c *auditingClient) UpdateAsset(ctx context.Context, kind string, origin string, assetJSON string, dataset string) error {
+	return c.audit("update", kind, dataset, origin, c.Client.UpdateAsset(ctx, kind, origin, assetJSON, dataset))
+}
+
+func (c *auditingClient) DeleteAsset(ctx context.Context, kind string, origin string, dataset string) error {
+	return c.audit("delete", kind, dataset, origin, c.Client.DeleteAsset(ctx, kind, origin, dataset))
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMutationClient implements the mutations used by the audit tests; every
+// other method panics through the nil embedded interface.
+type stubMutationClient struct {
+	Client
+	err error
+}
+
+func (c *stubMutationClient) CreateDashboard(context.Context, string, string, string) error {
+	return c.err
+}
+
+func (c *stubMutationClient) DeleteTeam(context.Context, string) error {
+	return c.err
+}
+
+func readAuditEntries(t *testing.T, path string) []auditEntry {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var entries []auditEntry
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry auditEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestAuditingClient_RecordsMutations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	inner := &stubMutationClient{}
+	c, err := NewAuditingClient(inner, path)
+	require.NoError(t, err)
+	c.(*auditingClient).log.(*auditLog).now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	require.NoError(t, c.CreateDashboard(t.Context(), "tf_dash", "{}", "production"))
+
+	inner.err = errors.New("dash0 api error: not found (status: 404)")
+	err = c.DeleteTeam(t.Context(), "tf_team")
+	require.EqualError(t, err, "dash0 api error: not found (status: 404)")
+
+	assert.Equal(t, []auditEntry{
+		{
+			Time: "2026-01-02T03:04:05Z", Operation: "create", Kind: "dashboard",
+			Dataset: "production", Origin: "tf_dash", Outcome: "success",
+		},
+		{
+			Time: "2026-01-02T03:04:05Z", Operation: "delete", Kind: "team",
+			Origin: "tf_team", Outcome: "error", Error: "dash0 api error: not found (status: 404)",
+		},
+	}, readAuditEntries(t, path))
+}
+
+func TestAuditingClient_AppendsToExistingLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	for range 2 {
+		c, err := NewAuditingClient(&stubMutationClient{}, path)
+		require.NoError(t, err)
+		require.NoError(t, c.CreateDashboard(t.Context(), "tf_dash", "{}", "default"))
+	}
+	assert.Len(t, readAuditEntries(t, path), 2)
+}
+
+func TestNewAuditingClient_UnwritablePath(t *testing.T) {
+	_, err := NewAuditingClient(&stubMutationClient{}, filepath.Join(t.TempDir(), "missing", "audit.jsonl"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "opening audit log")
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hangingServer returns a server that never answers: each request signals
+// received and then blocks until the client gives up on it, which is reported
+// on aborted.
+func hangingServer(t *testing.T, requestCount *atomic.Int32) (srv *httptest.Server, received, aborted <-chan struct{}) {
+	t.Helper()
+	receivedCh := make(chan struct{}, 10)
+	abortedCh := make(chan struct{}, 10)
+	srv = httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+		// The server only notices a client disconnect once the body is read.
+		_, _ = io.Copy(io.Discard, r.Body)
+		receivedCh <- struct{}{}
+		<-r.Context().Done()
+		abortedCh <- struct{}{}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, receivedCh, abortedCh
+}
+
+func TestCancellation_AbortsInFlightRequest(t *testing.T) {
+	dashboardJSON := `{"kind":"Dashboard","metadata":{"name":"test"},"spec":{"title":"Test"}}`
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, c *dash0Client) error
+	}{
+		{
+			name: "create",
+			call: func(ctx context.Context, c *dash0Client) error {
+				return c.CreateDashboard(ctx, "tf_test", dashboardJSON, "default")
+			},
+		},
+		{
+			name: "read",
+			call: func(ctx context.Context, c *dash0Client) error {
+				_, err := c.GetDashboard(ctx, "tf_test", "default")
+				return err
+			},
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *dash0Client) error {
+				return c.UpdateDashboard(ctx, "tf_test", dashboardJSON, "default")
+			},
+		},
+		{
+			name: "delete",
+			call: func(ctx context.Context, c *dash0Client) error {
+				return c.DeleteDashboard(ctx, "tf_test", "default")
+			},
+		},
+		{
+			name: "list (id resolution)",
+			call: func(ctx context.Context, c *dash0Client) error {
+				_, _, err := c.ResolveDashboard(ctx, "tf_test", "default")
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestCount atomic.Int32
+			server, received, aborted := hangingServer(t, &requestCount)
+
+			c, err := NewDash0Client(server.URL, "auth_test-token", "test", 3)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+
+			done := make(chan error, 1)
+			go func() { done <- tt.call(ctx, c) }()
+
+			<-received
+			cancel()
+
+			select {
+			case err := <-done:
+				require.Error(t, err)
+				assert.ErrorIs(t, err, context.Canceled)
+			case <-time.After(5 * time.Second):
+				t.Fatal("client call did not return after cancellation")
+			}
+
+			select {
+			case <-aborted:
+			case <-time.After(5 * time.Second):
+				t.Fatal("server did not observe the cancelled request")
+			}
+
+			// A cancelled request is not retried.
+			assert.Equal(t, int32(1), requestCount.Load())
+		})
+	}
+}
+
+func TestCancellation_StopsRetryBackoff(t *testing.T) {
+	var requestCount atomic.Int32
+	received := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		received <- struct{}{}
+	}))
+	t.Cleanup(server.Close)
+
+	// With the default backoff, the first retry would only be sent after
+	// 500ms; cancelling before then must end the operation without it.
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 5)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetDashboard(ctx, "tf_test", "default")
+		done <- err
+	}()
+
+	<-received
+	start := time.Now()
+	cancel()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(start), 400*time.Millisecond)
+	case <-time.After(5 * time.Second):
+		t.Fatal("client call did not return after cancellation")
+	}
+
+	// No retry is left running in the background.
+	time.Sleep(600 * time.Millisecond)
+	assert.Equal(t, int32(1), requestCount.Load())
+}
+
+func TestCancellation_AlreadyCancelledContextSendsNothing(t *testing.T) {
+	var requestCount atomic.Int32
+	server, _, _ := hangingServer(t, &requestCount)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 3)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err = c.DeleteDashboard(ctx, "tf_test", "default")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(0), requestCount.Load())
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -21,7 +22,10 @@ func (c *dash0Client) CreateCheckRule(ctx context.Context, origin string, ruleYA
 
 	_, err = c.inner.UpdateCheckRule(ctx, origin, alertRule, &dataset)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "check rule", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetCheckRule(ctx, origin, &dataset)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Check rule created with origin: %s", origin))
@@ -97,3 +101,22 @@ func (c *dash0Client) ResolveCheckRule(ctx context.Context, origin string, datas
 	logResolvedURL(ctx, "check rule", origin, checkRuleURL)
 	return id, checkRuleURL, nil
 }
+
+// ListCheckRuleOrigins matches prefix against the origin of every item of the list
+// endpoint. Check rules without an origin, such as those created in the Dash0 web
+// app, are skipped.
+func (c *dash0Client) ListCheckRuleOrigins(ctx context.Context, prefix string, dataset string) ([]string, error) {
+	items, err := c.inner.ListCheckRules(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	var origins []string
+	for _, item := range items {
+		if item != nil && item.Origin != nil && strings.HasPrefix(*item.Origin, prefix) {
+			origins = append(origins, *item.Origin)
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Found %d check rules with origin prefix %s", len(origins), prefix))
+	return origins, nil
+}
@@ -56,3 +56,31 @@ func TestResolveCheckRule(t *testing.T) {
 		assert.Equal(t, "", url)
 	})
 }
+
+// TestListCheckRuleOrigins verifies that ListCheckRuleOrigins returns the
+// origins with the given prefix and skips check rules without an origin.
+func TestListCheckRuleOrigins(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]dash0.PrometheusAlertRuleApiListItem{
+			{Id: "tf_group-availability--down", Origin: strPtr("tf_group-availability--down")},
+			{Id: "tf_other", Origin: strPtr("tf_other")},
+			{Id: "ui_created"},
+			{Id: "tf_group-latency--slow", Origin: strPtr("tf_group-latency--slow")},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	inner, err := dash0.NewClient(
+		dash0.WithApiUrl(server.URL),
+		dash0.WithAuthToken("auth_test-token"),
+		dash0.WithUserAgent("test"),
+	)
+	require.NoError(t, err)
+
+	c := &dash0Client{inner: inner, apiURL: "https://api.us-west-2.aws.dash0.com"}
+	origins, err := c.ListCheckRuleOrigins(t.Context(), "tf_group-", "production")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tf_group-availability--down", "tf_group-latency--slow"}, origins)
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// defaultCircuitBreakerThreshold is the number of consecutive failed HTTP
+// attempts after which the circuit breaker opens and all further requests of
+// the provider process fail fast.
+const defaultCircuitBreakerThreshold = 10
+
+// circuitBreaker is an http.RoundTripper that stops sending requests to the
+// Dash0 API once it has failed persistently.
+//
+// It sits underneath the library's rate-limit and retry transports, so every
+// attempt — including retries — is observed individually. An attempt fails
+// when it returns a transport error or a 5xx response; any other response
+// resets the count. After threshold consecutive failures the breaker opens
+// for the rest of the provider process: a large apply against a degraded API
+// then fails each remaining operation immediately with the same diagnostic,
+// instead of grinding through every resource with full retries.
+//
+// While open, the breaker rejects requests without touching the network. The
+// retry transport still applies its backoff to the rejected attempts, so a
+// rejected operation costs at most the configured retry waits, never a
+// request timeout.
+type circuitBreaker struct {
+	base      http.RoundTripper
+	threshold int
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	open                bool
+	lastFailure         string
+}
+
+// newCircuitBreaker wraps base in a circuit breaker that opens after threshold
+// consecutive failures. A threshold of 0 or less disables the breaker.
+func newCircuitBreaker(base http.RoundTripper, threshold int) *circuitBreaker {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &circuitBreaker{base: base, threshold: threshold}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (b *circuitBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	if b.threshold <= 0 {
+		return b.base.RoundTrip(req)
+	}
+
+	if err := b.rejectIfOpen(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := b.base.RoundTrip(req)
+	switch {
+	case err != nil:
+		// A cancelled or timed-out request says nothing about the API's health.
+		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			b.recordFailure(err.Error())
+		}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		b.recordFailure(resp.Status)
+	default:
+		b.recordSuccess()
+	}
+	return resp, err
+}
+
+// rejectIfOpen returns the error for an open breaker, or nil when the request
+// may proceed.
+func (b *circuitBreaker) rejectIfOpen(req *http.Request) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return nil
+	}
+	return fmt.Errorf(
+		"circuit breaker open: the Dash0 API failed %d consecutive requests (last failure: %s); not sending %s %s. Check the Dash0 API status and re-run once it has recovered",
+		b.threshold, b.lastFailure, req.Method, req.URL.Path,
+	)
+}
+
+func (b *circuitBreaker) recordFailure(reason string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutiveFailures++
+	b.lastFailure = reason
+	if b.consecutiveFailures >= b.threshold {
+		b.open = true
+	}
+}
+
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		b.consecutiveFailures = 0
+	}
+}
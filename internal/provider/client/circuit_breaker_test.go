@@ -0,0 +1,116 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// newCircuitBreakerTestClient builds a client whose base transport is wrapped
+// in a circuit breaker with the given threshold, mirroring NewDash0Client.
+func newCircuitBreakerTestClient(t *testing.T, serverURL string, maxRetries, threshold int) *dash0Client {
+	t.Helper()
+	c, err := dash0.NewClient(
+		dash0.WithApiUrl(serverURL),
+		dash0.WithAuthToken("auth_test-token"),
+		dash0.WithUserAgent("test"),
+		dash0.WithMaxRetries(maxRetries),
+		dash0.WithRetryWaitMin(1*time.Millisecond),
+		dash0.WithRetryWaitMax(5*time.Millisecond),
+		dash0.WithHTTPClient(&http.Client{Transport: newCircuitBreaker(http.DefaultTransport, threshold)}),
+	)
+	require.NoError(t, err)
+	return &dash0Client{inner: c}
+}
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	// 1 initial attempt + 2 retries per operation; the breaker opens after the
+	// third failed attempt, i.e. at the end of the first operation.
+	c := newCircuitBreakerTestClient(t, server.URL, 2, 3)
+
+	_, err := c.GetDashboard(t.Context(), "tf_one", "default")
+	require.Error(t, err)
+	assert.Equal(t, int32(3), requestCount.Load())
+
+	// Remaining operations fail fast without reaching the server.
+	_, err = c.GetDashboard(t.Context(), "tf_two", "default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "circuit breaker open")
+	assert.Equal(t, int32(3), requestCount.Load())
+
+	err = c.DeleteDashboard(t.Context(), "tf_three", "default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "circuit breaker open")
+	assert.Equal(t, int32(3), requestCount.Load())
+}
+
+func TestCircuitBreaker_SuccessResetsFailureCount(t *testing.T) {
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Alternate between a failure and a success.
+		if requestCount.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"Dashboard","metadata":{"name":"test"},"spec":{}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newCircuitBreakerTestClient(t, server.URL, 1, 2)
+
+	for i := 0; i < 5; i++ {
+		_, err := c.GetDashboard(t.Context(), "tf_origin", "default")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(10), requestCount.Load())
+}
+
+func TestCircuitBreaker_ClientErrorsDoNotCount(t *testing.T) {
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newCircuitBreakerTestClient(t, server.URL, 0, 2)
+
+	for i := 0; i < 4; i++ {
+		_, err := c.GetDashboard(t.Context(), "tf_missing", "default")
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "circuit breaker open")
+	}
+	assert.Equal(t, int32(4), requestCount.Load())
+}
+
+func TestCircuitBreaker_DisabledWithZeroThreshold(t *testing.T) {
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newCircuitBreakerTestClient(t, server.URL, 0, 0)
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetDashboard(t.Context(), "tf_origin", "default")
+		require.Error(t, err)
+	}
+	assert.Equal(t, int32(3), requestCount.Load())
+}
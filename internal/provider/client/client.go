@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -24,24 +25,32 @@ type Client interface {
 	UpdateDashboard(ctx context.Context, origin string, dashboardJSON string, dataset string) error
 	DeleteDashboard(ctx context.Context, origin string, dataset string) error
 	ResolveDashboard(ctx context.Context, origin string, dataset string) (string, string, error)
+	// FindDashboardByName returns the identifier of an existing dashboard with
+	// the given name (see matchName), or an empty string when there is none.
+	FindDashboardByName(ctx context.Context, name string, dataset string) (string, error)
 
 	CreateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error
 	GetSyntheticCheck(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error
 	DeleteSyntheticCheck(ctx context.Context, origin string, dataset string) error
 	ResolveSyntheticCheck(ctx context.Context, origin string, dataset string) (string, string, error)
+	FindSyntheticCheckByName(ctx context.Context, name string, dataset string) (string, error)
 
 	CreateView(ctx context.Context, origin string, viewJSON string, dataset string) error
 	GetView(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateView(ctx context.Context, origin string, viewJSON string, dataset string) error
 	DeleteView(ctx context.Context, origin string, dataset string) error
 	ResolveView(ctx context.Context, origin string, dataset string) (string, string, error)
+	FindViewByName(ctx context.Context, name string, dataset string) (string, error)
 
 	CreateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error
 	GetCheckRule(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error
 	DeleteCheckRule(ctx context.Context, origin string, dataset string) error
 	ResolveCheckRule(ctx context.Context, origin string, dataset string) (string, string, error)
+	// ListCheckRuleOrigins returns the origins of the check rules of the
+	// dataset that start with prefix.
+	ListCheckRuleOrigins(ctx context.Context, prefix string, dataset string) ([]string, error)
 
 	CreateRecordingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error
 	GetRecordingRule(ctx context.Context, origin string, dataset string) (string, error)
@@ -51,6 +60,26 @@ type Client interface {
 	// with the given origin (no deep-link URL — the Dash0 web app does not
 	// expose a per-recording-rule page).
 	ResolveRecordingRule(ctx context.Context, origin string, dataset string) (string, error)
+	// ListRecordingRuleOrigins returns the origins of the recording rules of
+	// the dataset that start with prefix.
+	ListRecordingRuleOrigins(ctx context.Context, prefix string, dataset string) ([]string, error)
+
+	CreateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error
+	GetSLO(ctx context.Context, origin string, dataset string) (string, error)
+	UpdateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error
+	DeleteSLO(ctx context.Context, origin string, dataset string) error
+	// ResolveSLO returns the server-assigned id of the SLO with the given
+	// origin.
+	ResolveSLO(ctx context.Context, origin string, dataset string) (string, error)
+
+	CreateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error
+	GetSamplingRule(ctx context.Context, origin string, dataset string) (string, error)
+	UpdateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error
+	DeleteSamplingRule(ctx context.Context, origin string, dataset string) error
+	// ResolveSamplingRule returns the server-assigned id of the sampling rule
+	// with the given origin (no deep-link URL — the Dash0 web app does not
+	// expose a per-sampling-rule page).
+	ResolveSamplingRule(ctx context.Context, origin string, dataset string) (string, error)
 
 	CreateNotificationChannel(ctx context.Context, origin string, channelJSON string) error
 	GetNotificationChannel(ctx context.Context, origin string) (string, error)
@@ -66,6 +95,19 @@ type Client interface {
 	// origin (no deep-link URL — the Dash0 web app does not currently expose
 	// a per-team page distinct from the settings screen).
 	ResolveTeam(ctx context.Context, origin string) (string, error)
+	// ListTeamMembers, CreateTeamMembership and DeleteTeamMembership manage
+	// single members of a team by member id, without replacing the rest of
+	// spec.members the way UpdateTeam does.
+	ListTeamMembers(ctx context.Context, team string) ([]Member, error)
+	CreateTeamMembership(ctx context.Context, team string, memberID string) error
+	DeleteTeamMembership(ctx context.Context, team string, memberID string) error
+
+	// ListMembers, CreateMember and DeleteMember manage organization members.
+	// CreateMember sends an invitation; the API has no endpoint to change the
+	// role of a member or to revoke a pending invitation.
+	ListMembers(ctx context.Context) ([]Member, error)
+	CreateMember(ctx context.Context, email string, role string) error
+	DeleteMember(ctx context.Context, id string) error
 
 	CreateSpamFilter(ctx context.Context, origin string, filterJSON string, dataset string) error
 	GetSpamFilter(ctx context.Context, origin string, dataset string) (string, error)
@@ -75,6 +117,29 @@ type Client interface {
 	// the given origin (no deep-link URL — the Dash0 web app does not expose
 	// a per-spam-filter page).
 	ResolveSpamFilter(ctx context.Context, origin string, dataset string) (string, error)
+
+	// CreateAsset, GetAsset, UpdateAsset and DeleteAsset manage assets of any
+	// kind through the generic /api/{kind}/{origin} endpoints, for kinds that
+	// have no dedicated methods. An empty dataset omits the dataset parameter.
+	CreateAsset(ctx context.Context, kind string, origin string, assetJSON string, dataset string) error
+	GetAsset(ctx context.Context, kind string, origin string, dataset string) (string, error)
+	UpdateAsset(ctx context.Context, kind string, origin string, assetJSON string, dataset string) error
+	DeleteAsset(ctx context.Context, kind string, origin string, dataset string) error
+
+	// CountFailedChecks returns the number of checks in the dataset that are
+	// currently failing with critical and with degraded severity. Only failed
+	// checks whose labels include every given label are counted.
+	CountFailedChecks(ctx context.Context, dataset string, labels map[string]string) (int, int, error)
+
+	// QueryRange evaluates a PromQL expression in the dataset at every step
+	// between start and end and returns the series it produced.
+	QueryRange(ctx context.Context, dataset string, query string, start, end time.Time, step time.Duration) ([]RangeSeries, error)
+
+	// PermittedDatasetActions returns, per dataset identifier, the
+	// dataset-level actions the auth token is permitted to perform, for
+	// example "dataset:createCheckRule". Datasets the token cannot see are
+	// missing from the map.
+	PermittedDatasetActions(ctx context.Context) (map[string][]string, error)
 }
 
 // Ensure dash0Client implements Client
@@ -112,6 +177,30 @@ func matchOriginID[T any](items []*T, identifier string, accessor func(*T) (stri
 	return ""
 }
 
+// matchName returns the identifier of the first list item whose name equals
+// the given name, or an empty string when no item matches. The identifier is
+// the item's origin when it has one and its server-assigned id otherwise —
+// the API's GET/PUT/DELETE endpoints accept either, so the result can be used
+// directly to address the existing asset.
+//
+// The accessor extracts the (id, origin, name) triple from each list item type.
+func matchName[T any](items []*T, name string, accessor func(*T) (string, *string, *string)) string {
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		id, itemOrigin, itemName := accessor(item)
+		if itemName == nil || *itemName != name {
+			continue
+		}
+		if itemOrigin != nil && *itemOrigin != "" {
+			return *itemOrigin
+		}
+		return id
+	}
+	return ""
+}
+
 // logResolvedURL emits a debug log for a resolved deep link, mirroring the
 // logging done by the per-asset URL resolvers.
 func logResolvedURL(ctx context.Context, assetType, origin, resolvedURL string) {
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// Creates are idempotent by construction: every asset is written with PUT to
+// its client-generated origin, so the origin acts as the idempotency key and a
+// retried request overwrites the object it already created instead of adding
+// a twin.
+//
+// What retries cannot cover is a create whose outcome is unknown — the write
+// reached the API, but the final attempt failed with a transport error or a
+// 5xx response. Terraform would then record nothing, and the next apply would
+// create the asset again under a fresh origin. reconcileCreate closes that gap
+// by checking whether the asset exists at the origin after such a failure.
+
+// createOutcomeUnknown reports whether err leaves it open whether a create
+// request was applied by the API. Errors the API answered with a 4xx status
+// are definite rejections.
+func createOutcomeUnknown(err error) bool {
+	var apiErr *dash0.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// reconcileCreate returns nil when the create that failed with createErr was
+// nevertheless applied, as determined by exists, and createErr otherwise.
+func reconcileCreate(ctx context.Context, assetName, origin string, createErr error, exists func(ctx context.Context) error) error {
+	if !createOutcomeUnknown(createErr) {
+		return createErr
+	}
+	if err := exists(ctx); err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("%s with origin %s not found after failed create: %s", assetName, origin, err))
+		return createErr
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Creating %s with origin %s failed with %q, but the %s exists; treating the create as successful", assetName, origin, createErr, assetName))
+	return nil
+}
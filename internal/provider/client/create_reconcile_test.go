@@ -0,0 +1,85 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func TestCreateOutcomeUnknown(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"transport error", errors.New("connection reset by peer"), true},
+		{"server error", &dash0.APIError{StatusCode: http.StatusBadGateway}, true},
+		{"wrapped server error", errors.Join(errors.New("create"), &dash0.APIError{StatusCode: http.StatusServiceUnavailable}), true},
+		{"validation error", &dash0.APIError{StatusCode: http.StatusBadRequest}, false},
+		{"rate limited", &dash0.APIError{StatusCode: http.StatusTooManyRequests}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, createOutcomeUnknown(tt.err))
+		})
+	}
+}
+
+// TestCreateSyntheticCheck_Reconcile verifies that a create whose final
+// attempt failed is reported as successful when the check exists at the
+// origin, so the next apply does not create a twin under a fresh origin.
+func TestCreateSyntheticCheck_Reconcile(t *testing.T) {
+	checkJSON := `{"kind":"Dash0SyntheticCheck","metadata":{"name":"test"},"spec":{"plugin":{"kind":"http"}}}`
+
+	tests := []struct {
+		name       string
+		putStatus  int
+		getStatus  int
+		wantErr    bool
+		wantGetHit bool
+	}{
+		{name: "server error but check exists", putStatus: http.StatusBadGateway, getStatus: http.StatusOK, wantGetHit: true},
+		{name: "server error and check missing", putStatus: http.StatusBadGateway, getStatus: http.StatusNotFound, wantErr: true, wantGetHit: true},
+		{name: "validation error is not reconciled", putStatus: http.StatusBadRequest, getStatus: http.StatusOK, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var getCount atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodPut:
+					w.WriteHeader(tt.putStatus)
+					_, _ = w.Write([]byte(`{"message":"failed"}`))
+				case http.MethodGet:
+					getCount.Add(1)
+					w.WriteHeader(tt.getStatus)
+					if tt.getStatus == http.StatusOK {
+						_, _ = w.Write([]byte(checkJSON))
+					} else {
+						_, _ = w.Write([]byte(`{"message":"not found"}`))
+					}
+				}
+			}))
+			t.Cleanup(server.Close)
+
+			c := newTestClient(t, server.URL, 0)
+			err := c.CreateSyntheticCheck(t.Context(), "tf_test", checkJSON, "default")
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "failed")
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantGetHit, getCount.Load() > 0)
+		})
+	}
+}
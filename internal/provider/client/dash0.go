@@ -1,7 +1,11 @@
 package client
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"net/http"
+	"time"
 
 	dash0 "github.com/dash0hq/dash0-api-client-go"
 )
@@ -14,16 +18,99 @@ type dash0Client struct {
 	apiURL string
 }
 
+// options holds the optional settings of NewDash0Client.
+type options struct {
+	operationBudget time.Duration
+	tokenSource     TokenSource
+	summary         *OperationSummary
+}
+
+// Option configures optional behavior of the client returned by
+// NewDash0Client.
+type Option func(*options)
+
+// WithOperationBudget caps the total wall-clock time the client may spend on
+// API requests, retries and backoff waits included. The budget starts when the
+// client is created; zero (the default) disables it.
+func WithOperationBudget(d time.Duration) Option {
+	return func(o *options) {
+		o.operationBudget = d
+	}
+}
+
+// WithTokenRefresh makes the client consult source for a new auth token when
+// the API rejects the current one with 401, so short-lived tokens that expire
+// mid-apply do not fail the remaining operations.
+func WithTokenRefresh(source TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithOperationSummary counts the API requests of the client, the time spent
+// on them, retries and rate-limited responses in summary.
+func WithOperationSummary(summary *OperationSummary) Option {
+	return func(o *options) {
+		o.summary = summary
+	}
+}
+
 // NewDash0Client creates a new Dash0 API client backed by the shared library.
-func NewDash0Client(url, authToken, version string, maxRetries int) (*dash0Client, error) {
+//
+// The transport stack is assembled here rather than by the library so that the
+// operation budget can observe whole operations, retries included:
+//
+//	operation budget -> rate limit -> retry -> token refresh -> circuit breaker -> network
+//
+// With WithOperationSummary, requests are counted above the operation budget
+// and attempts between the retry and the token refresh layer.
+//
+// The library's own retry layer is therefore disabled.
+func NewDash0Client(url, authToken, version string, maxRetries int, opts ...Option) (*dash0Client, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	breaker := newCircuitBreaker(http.DefaultTransport, defaultCircuitBreakerThreshold)
+	var base http.RoundTripper = newTokenRefresher(breaker, authToken, o.tokenSource)
+	if o.summary != nil {
+		base = &summaryAttempts{base: base, summary: o.summary}
+	}
+	transport := dash0.NewTransport(
+		dash0.WithBaseTransport(base),
+		dash0.WithTransportMaxRetries(maxRetries),
+	)
+
+	var top http.RoundTripper = newOperationBudget(transport.RoundTripper(), o.operationBudget)
+	if o.summary != nil {
+		top = &summaryRequests{base: top, summary: o.summary}
+	}
 	c, err := dash0.NewClient(
 		dash0.WithApiUrl(url),
 		dash0.WithAuthToken(authToken),
 		dash0.WithUserAgent(fmt.Sprintf("Dash0 Terraform Provider/%s", version)),
-		dash0.WithMaxRetries(maxRetries),
+		dash0.WithMaxRetries(0),
+		// Concurrency is limited by the inner transport; the outer limiter
+		// would otherwise hold a slot during retry backoff.
+		dash0.WithMaxConcurrentRequests(dash0.MaxConcurrentRequests),
+		dash0.WithHTTPClient(&http.Client{Transport: top}),
 	)
 	if err != nil {
 		return nil, err
 	}
 	return &dash0Client{inner: c, apiURL: url}, nil
 }
+
+// generatedAPIError returns the error for a response of the library's
+// generated client with an unexpected status. The generated client has
+// already read the body, so it is restored before the response is handed to
+// dash0.NewAPIError, which parses the error message and trace ID the same way
+// as the library's own methods do.
+func generatedAPIError(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return &dash0.APIError{Body: string(body)}
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return dash0.NewAPIError(resp)
+}
@@ -20,7 +20,10 @@ func (c *dash0Client) CreateDashboard(ctx context.Context, origin string, dashbo
 	// Use PUT (update) for upsert-by-origin behavior
 	_, err = c.inner.UpdateDashboard(ctx, origin, def, &dataset)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "dashboard", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetDashboard(ctx, origin, &dataset)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Dashboard created with origin: %s", origin))
@@ -92,3 +95,18 @@ func (c *dash0Client) ResolveDashboard(ctx context.Context, origin string, datas
 	logResolvedURL(ctx, "dashboard", origin, dashboardURL)
 	return id, dashboardURL, nil
 }
+
+// FindDashboardByName returns the identifier of the dashboard with the given
+// name in the dataset, or an empty string (and no error) when none exists.
+func (c *dash0Client) FindDashboardByName(ctx context.Context, name string, dataset string) (string, error) {
+	items, err := c.inner.ListDashboards(ctx, &dataset)
+	if err != nil {
+		return "", err
+	}
+
+	identifier := matchName(items, name, func(item *dash0.DashboardApiListItem) (string, *string, *string) {
+		return item.Id, item.Origin, item.Name
+	})
+	tflog.Debug(ctx, fmt.Sprintf("Looked up dashboard by name %q in dataset %q: %q", name, dataset, identifier))
+	return identifier, nil
+}
@@ -108,3 +108,50 @@ func TestGetDashboardURL(t *testing.T) {
 		assert.Equal(t, "", url)
 	})
 }
+
+func TestMatchName(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	accessor := func(item *dash0.DashboardApiListItem) (string, *string, *string) {
+		return item.Id, item.Origin, item.Name
+	}
+	items := []*dash0.DashboardApiListItem{
+		nil, // tolerate nil entries
+		{Id: "11111111-1111-1111-1111-111111111111", Origin: strPtr("tf_abc"), Name: strPtr("Checkout")},
+		{Id: "22222222-2222-2222-2222-222222222222", Name: strPtr("Payments")}, // UI-created, no origin
+		{Id: "33333333-3333-3333-3333-333333333333", Origin: strPtr("tf_unnamed")},
+	}
+
+	t.Run("match returns the origin", func(t *testing.T) {
+		assert.Equal(t, "tf_abc", matchName(items, "Checkout", accessor))
+	})
+
+	t.Run("match without origin returns the id", func(t *testing.T) {
+		assert.Equal(t, "22222222-2222-2222-2222-222222222222", matchName(items, "Payments", accessor))
+	})
+
+	t.Run("no match returns empty", func(t *testing.T) {
+		assert.Equal(t, "", matchName(items, "Inventory", accessor))
+	})
+}
+
+func TestFindDashboardByName(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]dash0.DashboardApiListItem{
+			{Id: "11111111-1111-1111-1111-111111111111", Origin: strPtr("tf_other"), Name: strPtr("Other")},
+			{Id: "33333333-3333-3333-3333-333333333333", Origin: strPtr("tf_target"), Name: strPtr("Checkout")},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTestClient(t, server.URL, 0)
+
+	identifier, err := c.FindDashboardByName(t.Context(), "Checkout", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "tf_target", identifier)
+
+	identifier, err = c.FindDashboardByName(t.Context(), "Missing", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "", identifier)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// failedChecksPageSize is the number of failed checks requested per page.
+const failedChecksPageSize = 100
+
+// failedChecksTimeRange is the window queried for failed checks. A check that
+// is currently failing was evaluated within it, however long ago the issue
+// started.
+var failedChecksTimeRange = dash0.TimeReferenceRange{From: "now-5m", To: "now"}
+
+func (c *dash0Client) CountFailedChecks(ctx context.Context, dataset string, labels map[string]string) (int, int, error) {
+	iter := c.inner.GetFailedChecksIter(ctx, &dash0.GetFailedChecksRequest{
+		Dataset:    &dataset,
+		TimeRange:  failedChecksTimeRange,
+		Pagination: &dash0.CursorPagination{Limit: dash0.Int64(failedChecksPageSize)},
+	})
+
+	var critical, degraded int
+	for iter.Next() {
+		issue := iter.Current()
+		if issue == nil || !issueLabelsMatch(issue.Labels, labels) {
+			continue
+		}
+		switch issue.InstanceStatus {
+		case dash0.IssueInstanceStatusCritical:
+			critical++
+		case dash0.IssueInstanceStatusDegraded:
+			degraded++
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Counted %d critical and %d degraded failed checks in dataset %s", critical, degraded, dataset))
+	return critical, degraded, nil
+}
+
+// issueLabelsMatch reports whether the issue carries every given label with a
+// string value equal to the selector's.
+func issueLabelsMatch(issueLabels []dash0.KeyValue, selector map[string]string) bool {
+	for key, want := range selector {
+		found := false
+		for _, label := range issueLabels {
+			if label.Key == key && label.Value.StringValue != nil && *label.Value.StringValue == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func failedCheck(status dash0.IssueInstanceStatus, labels map[string]string) dash0.Issue {
+	issue := dash0.Issue{InstanceStatus: status}
+	for key, value := range labels {
+		issue.Labels = append(issue.Labels, dash0.KeyValue{Key: key, Value: dash0.AnyValue{StringValue: &value}})
+	}
+	return issue
+}
+
+func TestCountFailedChecks(t *testing.T) {
+	pages := [][]dash0.Issue{
+		{
+			failedCheck(dash0.IssueInstanceStatusCritical, map[string]string{"service_name": "checkout", "team": "payments"}),
+			failedCheck(dash0.IssueInstanceStatusDegraded, map[string]string{"service_name": "checkout"}),
+			failedCheck(dash0.IssueInstanceStatusHealthy, map[string]string{"service_name": "checkout"}),
+		},
+		{
+			failedCheck(dash0.IssueInstanceStatusCritical, map[string]string{"service_name": "cart"}),
+			failedCheck(dash0.IssueInstanceStatusPending, map[string]string{"service_name": "checkout"}),
+		},
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req dash0.GetFailedChecksRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "production", *req.Dataset)
+
+		page := 0
+		if req.Pagination != nil && req.Pagination.Cursor != nil {
+			page = 1
+		}
+		requests.Add(1)
+		resp := dash0.GetFailedChecksResponse{Issues: pages[page]}
+		if page == 0 {
+			next := dash0.Cursor("page-2")
+			resp.Cursors = &dash0.NextCursors{After: &next}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+
+	t.Run("all failed checks", func(t *testing.T) {
+		critical, degraded, err := c.CountFailedChecks(t.Context(), "production", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, critical)
+		assert.Equal(t, 1, degraded)
+	})
+
+	t.Run("label selector", func(t *testing.T) {
+		critical, degraded, err := c.CountFailedChecks(t.Context(), "production", map[string]string{"service_name": "checkout"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, critical)
+		assert.Equal(t, 1, degraded)
+
+		critical, degraded, err = c.CountFailedChecks(t.Context(), "production", map[string]string{"service_name": "checkout", "team": "payments"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, critical)
+		assert.Equal(t, 0, degraded)
+	})
+
+	assert.Equal(t, int32(6), requests.Load())
+}
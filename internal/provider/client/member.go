@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// Member is an organization member as listed by the Dash0 API.
+type Member struct {
+	// ID is the internal Dash0 id of the member, the dash0.com/id label.
+	ID    string
+	Email string
+	// Joined reports whether the member has accepted the invitation, that is
+	// whether the dash0.com/joinedAt label is set.
+	Joined bool
+}
+
+// ListMembers returns the members of the organization.
+func (c *dash0Client) ListMembers(ctx context.Context) ([]Member, error) {
+	defs, err := c.inner.ListMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(defs))
+	for _, def := range defs {
+		if def == nil {
+			continue
+		}
+		members = append(members, memberFromDefinition(def))
+	}
+	return members, nil
+}
+
+// memberFromDefinition extracts the id, email and joined state of a member
+// definition returned by the API.
+func memberFromDefinition(def *dash0.MemberDefinition) Member {
+	var member Member
+	if labels := def.Metadata.Labels; labels != nil {
+		if labels.Dash0Comid != nil {
+			member.ID = *labels.Dash0Comid
+		}
+		member.Joined = labels.Dash0ComjoinedAt != nil
+	}
+	if def.Spec.Display.Email != nil {
+		member.Email = *def.Spec.Display.Email
+	}
+	return member
+}
+
+// FindMember returns the member with the given email address, compared
+// case-insensitively, and whether there is one.
+func FindMember(members []Member, email string) (Member, bool) {
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return member, true
+		}
+	}
+	return Member{}, false
+}
+
+// CreateMember invites the person with the given email address to the
+// organization with the given role.
+func (c *dash0Client) CreateMember(ctx context.Context, email string, role string) error {
+	tflog.Debug(ctx, fmt.Sprintf("Inviting member: %s", email))
+
+	if err := c.inner.InviteMember(ctx, &dash0.InviteMemberRequest{EmailAddress: email, Role: role}); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Member invited: %s", email))
+	return nil
+}
+
+// DeleteMember removes the member with the given id from the organization.
+func (c *dash0Client) DeleteMember(ctx context.Context, id string) error {
+	if err := c.inner.DeleteMember(ctx, id); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Member deleted with id: %s", id))
+	return nil
+}
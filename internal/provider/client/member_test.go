@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/members", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"kind": "Dash0Member", "metadata": {"name": "Alice", "labels": {"dash0.com/id": "user_alice", "dash0.com/joinedAt": "2026-01-05T10:00:00Z"}}, "spec": {"display": {"email": "Alice@Example.com"}}},
+			{"kind": "Dash0Member", "metadata": {"name": "bob@example.com", "labels": {"dash0.com/id": "user_bob"}}, "spec": {"display": {"email": "bob@example.com"}}}
+		]`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	members, err := c.ListMembers(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []Member{
+		{ID: "user_alice", Email: "Alice@Example.com", Joined: true},
+		{ID: "user_bob", Email: "bob@example.com"},
+	}, members)
+
+	member, ok := FindMember(members, "alice@example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "user_alice", member.ID)
+	_, ok = FindMember(members, "carol@example.com")
+	assert.False(t, ok)
+}
+
+func TestCreateMember_SendsInvitation(t *testing.T) {
+	var seenMethod string
+	var seenBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &seenBody)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.CreateMember(t.Context(), "carol@example.com", "basic_member"))
+
+	assert.Equal(t, http.MethodPost, seenMethod)
+	assert.Equal(t, map[string]interface{}{"emailAddress": "carol@example.com", "role": "basic_member"}, seenBody)
+}
+
+func TestDeleteMember(t *testing.T) {
+	var seenMethod, seenPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		seenPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.DeleteMember(t.Context(), "user_bob"))
+
+	assert.Equal(t, http.MethodDelete, seenMethod)
+	assert.Equal(t, "/api/members/user_bob", seenPath)
+}
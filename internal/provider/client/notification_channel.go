@@ -22,7 +22,10 @@ func (c *dash0Client) CreateNotificationChannel(ctx context.Context, origin stri
 
 	_, err = c.inner.UpdateNotificationChannel(ctx, origin, def)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "notification channel", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetNotificationChannel(ctx, origin)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Notification channel created with origin: %s", origin))
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// errOperationBudgetExhausted is the cause attached to requests cut off by the
+// operation budget.
+var errOperationBudgetExhausted = errors.New("operation budget exhausted")
+
+// operationBudget is an http.RoundTripper that caps the total wall-clock time
+// of all requests sent through it.
+//
+// It sits on top of the retry transport, so the deadline covers retries and
+// the backoff waits between them: a request in flight when the budget runs
+// out is cancelled, and every later request fails immediately without
+// touching the network. CI jobs against a degraded API then fail after a
+// predictable amount of time instead of the sum of all per-resource retries.
+type operationBudget struct {
+	base     http.RoundTripper
+	limit    time.Duration
+	deadline time.Time
+}
+
+// newOperationBudget wraps base in an operation budget of limit, starting now.
+// A limit of 0 or less disables the budget.
+func newOperationBudget(base http.RoundTripper, limit time.Duration) *operationBudget {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &operationBudget{base: base, limit: limit, deadline: time.Now().Add(limit)}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (b *operationBudget) RoundTrip(req *http.Request) (*http.Response, error) {
+	if b.limit <= 0 {
+		return b.base.RoundTrip(req)
+	}
+
+	if !time.Now().Before(b.deadline) {
+		return nil, b.exhausted(req)
+	}
+
+	ctx, cancel := context.WithDeadlineCause(req.Context(), b.deadline, errOperationBudgetExhausted)
+	resp, err := b.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		if errors.Is(context.Cause(ctx), errOperationBudgetExhausted) {
+			return nil, b.exhausted(req)
+		}
+		return nil, err
+	}
+
+	// The context must outlive RoundTrip until the caller has read the body.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (b *operationBudget) exhausted(req *http.Request) error {
+	return fmt.Errorf(
+		"%w: the provider's operation_budget of %s has elapsed; not sending %s %s. Increase operation_budget or re-run once the Dash0 API has recovered",
+		errOperationBudgetExhausted, b.limit, req.Method, req.URL.Path,
+	)
+}
+
+// cancelOnClose releases a request context once the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationBudget_CapsRetries(t *testing.T) {
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	// Without the budget, 5 retries with the default backoff would take about
+	// 15 seconds.
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 5, WithOperationBudget(200*time.Millisecond))
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = c.GetDashboard(t.Context(), "tf_one", "default")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errOperationBudgetExhausted)
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	// Later operations fail without reaching the server.
+	sent := requestCount.Load()
+	err = c.DeleteDashboard(t.Context(), "tf_two", "default")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errOperationBudgetExhausted)
+	assert.Contains(t, err.Error(), "DELETE")
+	assert.Equal(t, sent, requestCount.Load())
+}
+
+func TestOperationBudget_CancelsInFlightRequest(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	t.Cleanup(func() {
+		close(release)
+		server.Close()
+	})
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0, WithOperationBudget(100*time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = c.GetDashboard(t.Context(), "tf_slow", "default")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errOperationBudgetExhausted)
+}
+
+func TestOperationBudget_DisabledByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"Dashboard","metadata":{"name":"test"},"spec":{}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+
+	_, err = c.GetDashboard(t.Context(), "tf_one", "default")
+	require.NoError(t, err)
+}
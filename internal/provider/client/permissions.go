@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// PermittedDatasetActions returns, per dataset identifier, the dataset-level
+// actions the auth token is permitted to perform, as reported by the edge
+// settings of the organization.
+//
+// TODO Switch to the library once dash0-api-client-go wraps the edge settings
+// API; until then the generated client is called directly.
+func (c *dash0Client) PermittedDatasetActions(ctx context.Context) (map[string][]string, error) {
+	resp, err := c.inner.Inner().GetApiEdgeSettingsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, generatedAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	actions := make(map[string][]string, len(resp.JSON200.DatasetSettings))
+	for _, settings := range resp.JSON200.DatasetSettings {
+		permitted := []string{}
+		if settings.PermittedActions != nil {
+			for _, action := range *settings.PermittedActions {
+				permitted = append(permitted, string(action))
+			}
+		}
+		actions[settings.Slug] = permitted
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read the permitted actions of %d datasets", len(actions)))
+	return actions, nil
+}
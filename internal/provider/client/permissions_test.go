@@ -0,0 +1,49 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermittedDatasetActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/edge/settings", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"datasetSettings":[
+			{"slug":"default","name":"Default","permittedActions":["dataset:read","dataset:createCheckRule"]},
+			{"slug":"staging","name":"Staging"}
+		],"samplingSettings":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+
+	actions, err := c.PermittedDatasetActions(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"default": {"dataset:read", "dataset:createCheckRule"},
+		"staging": {},
+	}, actions)
+}
+
+func TestPermittedDatasetActions_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":403,"message":"missing permission"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+
+	_, err = c.PermittedDatasetActions(t.Context())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.Contains(t, err.Error(), "missing permission")
+}
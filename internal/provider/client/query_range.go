@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// RangeSeries is a series returned by a PromQL range query: its labels and
+// the evaluation timestamps at which the query returned a sample for it, in
+// ascending order.
+type RangeSeries struct {
+	Labels     map[string]string
+	Timestamps []time.Time
+}
+
+// QueryRange evaluates a PromQL query over the [start, end] range at the given
+// step in the given dataset and returns the series of the resulting range
+// vector.
+//
+// TODO Switch to the library once dash0-api-client-go wraps the Prometheus
+// query API; until then the generated client is called directly.
+func (c *dash0Client) QueryRange(ctx context.Context, dataset, query string, start, end time.Time, step time.Duration) ([]RangeSeries, error) {
+	startParam := strconv.FormatInt(start.Unix(), 10)
+	endParam := strconv.FormatInt(end.Unix(), 10)
+	resp, err := c.inner.Inner().GetApiPrometheusApiV1QueryRangeWithResponse(ctx, &dash0.GetApiPrometheusApiV1QueryRangeParams{
+		Query:   query,
+		Start:   &startParam,
+		End:     &endParam,
+		Step:    strconv.FormatFloat(step.Seconds(), 'f', -1, 64),
+		Dataset: &dataset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, generatedAPIError(resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200.Data == nil {
+		return nil, nil
+	}
+	if resp.JSON200.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("expected a range vector, got a %s result", resp.JSON200.Data.ResultType)
+	}
+	matrix, err := resp.JSON200.Data.Result.AsPrometheusMatrixResult()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query result: %w", err)
+	}
+
+	series := make([]RangeSeries, 0, len(matrix))
+	for _, m := range matrix {
+		s := RangeSeries{Labels: map[string]string(m.Metric)}
+		for _, sample := range m.Values {
+			if len(sample) == 0 {
+				continue
+			}
+			seconds, ok := sample[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("unexpected sample timestamp %v", sample[0])
+			}
+			whole, frac := math.Modf(seconds)
+			s.Timestamps = append(s.Timestamps, time.Unix(int64(whole), int64(frac*1e9)).UTC())
+		}
+		sort.Slice(s.Timestamps, func(i, j int) bool { return s.Timestamps[i].Before(s.Timestamps[j]) })
+		series = append(series, s)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Range query returned %d series in dataset %s", len(series), dataset))
+	return series, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func TestQueryRange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/prometheus/api/v1/query_range", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "up == 0", query.Get("query"))
+		assert.Equal(t, "1759320000", query.Get("start"))
+		assert.Equal(t, "1759323600", query.Get("end"))
+		assert.Equal(t, "60", query.Get("step"))
+		assert.Equal(t, "production", query.Get("dataset"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"instance":"a"},"values":[[1759320060,"0"],[1759320000,"0"]]}
+		]}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+
+	start := time.Unix(1759320000, 0)
+	series, err := c.QueryRange(t.Context(), "production", "up == 0", start, start.Add(time.Hour), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+	assert.Equal(t, map[string]string{"instance": "a"}, series[0].Labels)
+	assert.Equal(t, []time.Time{start.UTC(), start.Add(time.Minute).UTC()}, series[0].Timestamps)
+}
+
+func TestQueryRange_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-trace-id", "trace-123")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 0)
+	require.NoError(t, err)
+
+	_, err = c.QueryRange(t.Context(), "default", "up ==", time.Now().Add(-time.Hour), time.Now(), time.Minute)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+	var apiErr *dash0.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "trace-123", apiErr.TraceID)
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrReadOnly is returned for every create, update and delete attempted
+// through a client returned by NewReadOnlyClient.
+var ErrReadOnly = errors.New("the Dash0 provider is configured with read_only = true")
+
+// readOnlyClient decorates a Client so that it never writes: every create,
+// update and delete fails with ErrReadOnly before a request is sent, while
+// reads are passed through.
+type readOnlyClient struct {
+	Client
+}
+
+// NewReadOnlyClient wraps inner so that all mutations are rejected.
+func NewReadOnlyClient(inner Client) Client {
+	return &readOnlyClient{Client: inner}
+}
+
+func rejectMutation(operation, kind, origin string) error {
+	return fmt.Errorf("%w; refusing to %s %s with origin %s. Apply changes with a provider configuration that does not set read_only", ErrReadOnly, operation, kind, origin)
+}
+
+func (c *readOnlyClient) CreateDashboard(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "dashboard", origin)
+}
+
+func (c *readOnlyClient) UpdateDashboard(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "dashboard", origin)
+}
+
+func (c *readOnlyClient) DeleteDashboard(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "dashboard", origin)
+}
+
+func (c *readOnlyClient) CreateSyntheticCheck(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "synthetic check", origin)
+}
+
+func (c *readOnlyClient) UpdateSyntheticCheck(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "synthetic check", origin)
+}
+
+func (c *readOnlyClient) DeleteSyntheticCheck(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "synthetic check", origin)
+}
+
+func (c *readOnlyClient) CreateView(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "view", origin)
+}
+
+func (c *readOnlyClient) UpdateView(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "view", origin)
+}
+
+func (c *readOnlyClient) DeleteView(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "view", origin)
+}
+
+func (c *readOnlyClient) CreateCheckRule(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "check rule", origin)
+}
+
+func (c *readOnlyClient) UpdateCheckRule(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "check rule", origin)
+}
+
+func (c *readOnlyClient) DeleteCheckRule(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "check rule", origin)
+}
+
+func (c *readOnlyClient) CreateRecordingRule(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "recording rule", origin)
+}
+
+func (c *readOnlyClient) UpdateRecordingRule(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "recording rule", origin)
+}
+
+func (c *readOnlyClient) DeleteRecordingRule(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "recording rule", origin)
+}
+
+func (c *readOnlyClient) CreateSLO(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "SLO", origin)
+}
+
+func (c *readOnlyClient) UpdateSLO(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "SLO", origin)
+}
+
+func (c *readOnlyClient) DeleteSLO(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "SLO", origin)
+}
+
+func (c *readOnlyClient) CreateSamplingRule(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "sampling rule", origin)
+}
+
+func (c *readOnlyClient) UpdateSamplingRule(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "sampling rule", origin)
+}
+
+func (c *readOnlyClient) DeleteSamplingRule(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "sampling rule", origin)
+}
+
+func (c *readOnlyClient) CreateNotificationChannel(_ context.Context, origin string, _ string) error {
+	return rejectMutation("create", "notification channel", origin)
+}
+
+func (c *readOnlyClient) UpdateNotificationChannel(_ context.Context, origin string, _ string) error {
+	return rejectMutation("update", "notification channel", origin)
+}
+
+func (c *readOnlyClient) DeleteNotificationChannel(_ context.Context, origin string) error {
+	return rejectMutation("delete", "notification channel", origin)
+}
+
+func (c *readOnlyClient) CreateTeam(_ context.Context, origin string, _ string) error {
+	return rejectMutation("create", "team", origin)
+}
+
+func (c *readOnlyClient) UpdateTeam(_ context.Context, origin string, _ string) error {
+	return rejectMutation("update", "team", origin)
+}
+
+func (c *readOnlyClient) DeleteTeam(_ context.Context, origin string) error {
+	return rejectMutation("delete", "team", origin)
+}
+
+func (c *readOnlyClient) CreateTeamMembership(_ context.Context, team string, memberID string) error {
+	return rejectMutation("create", "team membership", team+"/"+memberID)
+}
+
+func (c *readOnlyClient) DeleteTeamMembership(_ context.Context, team string, memberID string) error {
+	return rejectMutation("delete", "team membership", team+"/"+memberID)
+}
+
+func (c *readOnlyClient) CreateMember(_ context.Context, email string, _ string) error {
+	return rejectMutation("create", "member", email)
+}
+
+func (c *readOnlyClient) DeleteMember(_ context.Context, id string) error {
+	return rejectMutation("delete", "member", id)
+}
+
+func (c *readOnlyClient) CreateSpamFilter(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("create", "spam filter", origin)
+}
+
+func (c *readOnlyClient) UpdateSpamFilter(_ context.Context, origin string, _ string, _ string) error {
+	return rejectMutation("update", "spam filter", origin)
+}
+
+func (c *readOnlyClient) DeleteSpamFilter(_ context.Context, origin string, _ string) error {
+	return rejectMutation("delete", "spam filter", origin)
+}
+
+func (c *readOnlyClient) CreateAsset(_ context.Context, kind string, origin string, _ string, _ string) error {
+	return rejectMutation("create", kind+" asset", origin)
+}
+
+func (c *readOnlyClient) UpdateAsset(_ context.Context, kind string, origin string, _ string, _ string) error {
+	return rejectMutation("update", kind+" asset", origin)
+}
+
+func (c *readOnlyClient) DeleteAsset(_ context.Context, kind string, origin string, _ string) error {
+	return rejectMutation("delete", kind+" asset", origin)
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadOnlyClient_RejectsEveryMutation calls every Create, Update and Delete
+// method of the Client interface on a read-only client without an inner
+// client. A mutation that is not overridden would be promoted to the nil inner
+// client and panic, so new mutating methods cannot slip past the guard.
+func TestReadOnlyClient_RejectsEveryMutation(t *testing.T) {
+	c := reflect.ValueOf(NewReadOnlyClient(nil))
+	clientType := reflect.TypeFor[Client]()
+
+	var mutations int
+	for i := range clientType.NumMethod() {
+		method := clientType.Method(i)
+		if !strings.HasPrefix(method.Name, "Create") && !strings.HasPrefix(method.Name, "Update") && !strings.HasPrefix(method.Name, "Delete") {
+			continue
+		}
+		mutations++
+
+		t.Run(method.Name, func(t *testing.T) {
+			fn := c.MethodByName(method.Name)
+			args := make([]reflect.Value, fn.Type().NumIn())
+			args[0] = reflect.ValueOf(context.Background())
+			for j := 1; j < len(args); j++ {
+				args[j] = reflect.ValueOf("tf_test")
+			}
+
+			var results []reflect.Value
+			require.NotPanics(t, func() { results = fn.Call(args) }, "%s is not rejected by readOnlyClient", method.Name)
+			err, _ := results[len(results)-1].Interface().(error)
+			require.ErrorIs(t, err, ErrReadOnly)
+			assert.Contains(t, err.Error(), "origin tf_test")
+		})
+	}
+	assert.Equal(t, 37, mutations)
+}
+
+func TestReadOnlyClient_PassesReadsThrough(t *testing.T) {
+	inner := &testGetDashboardClient{response: `{"kind":"Dashboard"}`}
+	c := NewReadOnlyClient(inner)
+
+	got, err := c.GetDashboard(t.Context(), "tf_test", "default")
+	require.NoError(t, err)
+	assert.Equal(t, `{"kind":"Dashboard"}`, got)
+}
+
+type testGetDashboardClient struct {
+	Client
+	response string
+}
+
+func (c *testGetDashboardClient) GetDashboard(context.Context, string, string) (string, error) {
+	return c.response, nil
+}
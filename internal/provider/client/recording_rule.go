@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -23,7 +24,10 @@ func (c *dash0Client) CreateRecordingRule(ctx context.Context, origin string, ru
 
 	_, err = c.inner.UpdateRecordingRule(ctx, origin, rule, &dataset)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "recording rule", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetRecordingRule(ctx, origin, &dataset)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Recording rule created with origin: %s", origin))
@@ -101,6 +105,28 @@ func (c *dash0Client) ResolveRecordingRule(ctx context.Context, origin string, d
 	return "", nil
 }
 
+// ListRecordingRuleOrigins matches prefix against the origin of every item of the list
+// endpoint. Recording rules without an origin, such as those created in the Dash0 web
+// app, are skipped.
+func (c *dash0Client) ListRecordingRuleOrigins(ctx context.Context, prefix string, dataset string) ([]string, error) {
+	items, err := c.inner.ListRecordingRules(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	var origins []string
+	for _, rule := range items {
+		if rule == nil || rule.Metadata.Labels == nil {
+			continue
+		}
+		if origin := (*rule.Metadata.Labels)[dash0.LabelOrigin]; origin != "" && strings.HasPrefix(origin, prefix) {
+			origins = append(origins, origin)
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Found %d recording rules with origin prefix %s", len(origins), prefix))
+	return origins, nil
+}
+
 // unmarshalRecordingRule parses a JSON string into a RecordingRule.
 func unmarshalRecordingRule(jsonStr string) (*dash0.RecordingRule, error) {
 	var rule dash0.RecordingRule
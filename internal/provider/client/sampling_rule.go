@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func (c *dash0Client) CreateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	rule, err := unmarshalSamplingRule(ruleJSON)
+	if err != nil {
+		return fmt.Errorf("error parsing sampling rule JSON: %w", err)
+	}
+
+	setSamplingRuleOrigin(rule, origin, dataset)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating sampling rule with origin: %s", origin))
+
+	_, err = c.inner.UpdateSamplingRule(ctx, origin, rule, &dataset)
+	if err != nil {
+		return reconcileCreate(ctx, "sampling rule", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetSamplingRule(ctx, origin, &dataset)
+			return err
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Sampling rule created with origin: %s", origin))
+	return nil
+}
+
+func (c *dash0Client) GetSamplingRule(ctx context.Context, origin string, dataset string) (string, error) {
+	rule, err := c.inner.GetSamplingRule(ctx, origin, &dataset)
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Sampling rule retrieved with origin: %s", origin))
+
+	stripSamplingRuleServerFields(rule)
+	return marshalToJSON(rule)
+}
+
+func (c *dash0Client) UpdateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	rule, err := unmarshalSamplingRule(ruleJSON)
+	if err != nil {
+		return fmt.Errorf("error parsing sampling rule JSON: %w", err)
+	}
+
+	setSamplingRuleOrigin(rule, origin, dataset)
+
+	_, err = c.inner.UpdateSamplingRule(ctx, origin, rule, &dataset)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Sampling rule updated with origin: %s", origin))
+	return nil
+}
+
+func (c *dash0Client) DeleteSamplingRule(ctx context.Context, origin string, dataset string) error {
+	err := c.inner.DeleteSamplingRule(ctx, origin, &dataset)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Sampling rule deleted with origin: %s", origin))
+	return nil
+}
+
+// ResolveSamplingRule looks up the server-assigned id of the sampling rule
+// with the given origin by matching against the list endpoint.
+//
+// Sampling rules are not addressable in the Dash0 web app, so this function
+// returns only an id (no deep-link URL). It returns an empty string (and no
+// error) when the sampling rule is not present in the list, so that callers
+// can treat the id as best-effort metadata rather than failing the operation.
+func (c *dash0Client) ResolveSamplingRule(ctx context.Context, origin string, dataset string) (string, error) {
+	items, err := c.inner.ListSamplingRules(ctx, &dataset)
+	if err != nil {
+		return "", err
+	}
+
+	// Match on origin first, fall back to matching on id — see matchOriginID for
+	// the rationale (imports of UI-created rules have no origin label).
+	for _, rule := range items {
+		if rule == nil || rule.Metadata.Labels == nil {
+			continue
+		}
+		labels := rule.Metadata.Labels
+		var id string
+		if labels.Dash0Comid != nil {
+			id = *labels.Dash0Comid
+		}
+		originMatches := labels.Dash0Comorigin != nil && *labels.Dash0Comorigin == origin
+		if originMatches || (id != "" && id == origin) {
+			tflog.Debug(ctx, fmt.Sprintf("Resolved sampling rule id for origin %s: %s", origin, id))
+			return id, nil
+		}
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("Sampling rule with origin %q not found in dataset %q; id will be empty", origin, dataset))
+	return "", nil
+}
+
+// unmarshalSamplingRule parses a JSON string into a SamplingDefinition.
+func unmarshalSamplingRule(jsonStr string) (*dash0.SamplingDefinition, error) {
+	var rule dash0.SamplingDefinition
+	if err := json.Unmarshal([]byte(jsonStr), &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// setSamplingRuleOrigin stamps the provided origin and dataset into
+// metadata.labels, initializing the labels struct as needed.
+func setSamplingRuleOrigin(rule *dash0.SamplingDefinition, origin string, dataset string) {
+	if rule.Metadata.Labels == nil {
+		rule.Metadata.Labels = &dash0.SamplingLabels{}
+	}
+	o, d := origin, dataset
+	rule.Metadata.Labels.Dash0Comorigin = &o
+	rule.Metadata.Labels.Dash0Comdataset = &d
+}
+
+// stripSamplingRuleServerFields clears the labels the server manages, keeping
+// the custom labels.
+func stripSamplingRuleServerFields(rule *dash0.SamplingDefinition) {
+	labels := rule.Metadata.Labels
+	if labels == nil {
+		return
+	}
+	labels.Dash0Comid = nil
+	labels.Dash0Comorigin = nil
+	labels.Dash0Comdataset = nil
+	labels.Dash0Comversion = nil
+	labels.Dash0Comsource = nil
+	if labels.Custom == nil {
+		rule.Metadata.Labels = nil
+	}
+}
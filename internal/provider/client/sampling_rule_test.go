@@ -0,0 +1,92 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSamplingRuleJSON = `{
+  "kind": "Dash0Sampling",
+  "metadata": {"name": "checkout-errors"},
+  "spec": {
+    "enabled": true,
+    "conditions": {"kind": "error", "spec": {}},
+    "rateLimit": {"rate": 120}
+  }
+}`
+
+// testSamplingRuleServerResponse is a sampling rule as stored by the server,
+// with the server-managed labels set.
+const testSamplingRuleServerResponse = `{
+  "kind": "Dash0Sampling",
+  "metadata": {
+    "name": "checkout-errors",
+    "labels": {"dash0.com/id": "sampling_01abc", "dash0.com/origin": "tf_checkout", "dash0.com/dataset": "default", "dash0.com/version": "2", "dash0.com/source": "terraform"}
+  },
+  "spec": {
+    "enabled": true,
+    "conditions": {"kind": "error", "spec": {}},
+    "rateLimit": {"rate": 120}
+  }
+}`
+
+func TestCreateSamplingRule_StampsOriginAndUsesPUT(t *testing.T) {
+	var seenMethod, seenPath, seenDataset string
+	var seenBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		seenPath = r.URL.Path
+		seenDataset = r.URL.Query().Get("dataset")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &seenBody)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.CreateSamplingRule(t.Context(), "tf_checkout", testSamplingRuleJSON, "default"))
+
+	assert.Equal(t, http.MethodPut, seenMethod)
+	assert.Equal(t, "/api/sampling-rules/tf_checkout", seenPath)
+	assert.Equal(t, "default", seenDataset)
+	metadata, _ := seenBody["metadata"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+	require.NotNil(t, labels)
+	assert.Equal(t, "tf_checkout", labels["dash0.com/origin"])
+	assert.Equal(t, "default", labels["dash0.com/dataset"])
+	spec, _ := seenBody["spec"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"kind": "error", "spec": map[string]interface{}{}}, spec["conditions"])
+}
+
+func TestGetSamplingRule_StripsServerFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/sampling-rules" {
+			_, _ = w.Write([]byte(`{"samplingRules": [` + testSamplingRuleServerResponse + `]}`))
+			return
+		}
+		_, _ = w.Write([]byte(testSamplingRuleServerResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	ruleJSON, err := c.GetSamplingRule(t.Context(), "tf_checkout", "default")
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(ruleJSON), &got))
+	metadata, _ := got["metadata"].(map[string]interface{})
+	assert.NotContains(t, metadata, "labels")
+	assert.Equal(t, "checkout-errors", metadata["name"])
+
+	id, err := c.ResolveSamplingRule(t.Context(), "tf_checkout", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "sampling_01abc", id)
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// The library does not wrap the SLO endpoints yet, so the SLO methods call the
+// generated client directly. It shares the library's transport stack, so
+// retries, rate limiting and the operation budget apply as usual.
+//
+// TODO Switch to the library once dash0-api-client-go wraps the SLO endpoints,
+// and drop getSLO and putSLO.
+
+// CreateSLO creates or replaces the SLO with the provided origin via
+// PUT /api/slos/{origin}, stamping the origin and dataset labels onto the
+// definition.
+func (c *dash0Client) CreateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error {
+	slo, err := unmarshalSLO(sloJSON)
+	if err != nil {
+		return fmt.Errorf("error parsing SLO JSON: %w", err)
+	}
+
+	setSLOOrigin(slo, origin, dataset)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating SLO with origin: %s", origin))
+
+	if err := c.putSLO(ctx, origin, slo, dataset); err != nil {
+		return reconcileCreate(ctx, "SLO", origin, err, func(ctx context.Context) error {
+			_, err := c.getSLO(ctx, origin, dataset)
+			return err
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("SLO created with origin: %s", origin))
+	return nil
+}
+
+// GetSLO retrieves the SLO with the given origin. Server-managed labels and
+// annotations are stripped so drift detection ignores fields the API produced.
+func (c *dash0Client) GetSLO(ctx context.Context, origin string, dataset string) (string, error) {
+	slo, err := c.getSLO(ctx, origin, dataset)
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("SLO retrieved with origin: %s", origin))
+
+	stripSLOServerFields(slo)
+	return marshalToJSON(slo)
+}
+
+// UpdateSLO updates the SLO with the given origin. The API rejects updates
+// without the current dash0.com/version label, so the SLO is read first to
+// carry its version over.
+func (c *dash0Client) UpdateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error {
+	slo, err := unmarshalSLO(sloJSON)
+	if err != nil {
+		return fmt.Errorf("error parsing SLO JSON: %w", err)
+	}
+
+	current, err := c.getSLO(ctx, origin, dataset)
+	if err != nil {
+		return err
+	}
+
+	setSLOOrigin(slo, origin, dataset)
+	if current.Metadata.Labels != nil {
+		slo.Metadata.Labels.Dash0Comversion = current.Metadata.Labels.Dash0Comversion
+	}
+
+	if err := c.putSLO(ctx, origin, slo, dataset); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("SLO updated with origin: %s", origin))
+	return nil
+}
+
+// DeleteSLO deletes the SLO identified by origin.
+func (c *dash0Client) DeleteSLO(ctx context.Context, origin string, dataset string) error {
+	resp, err := c.inner.Inner().DeleteApiSlosOriginOrIdWithResponse(ctx, origin, &dash0.DeleteApiSlosOriginOrIdParams{Dataset: &dataset})
+	if err != nil {
+		return fmt.Errorf("dash0: delete SLO failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return generatedAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("SLO deleted with origin: %s", origin))
+	return nil
+}
+
+// ResolveSLO looks up the server-assigned id of the SLO with the given origin.
+// Best-effort: returns an empty id and no error when the SLO carries no id
+// label, so callers surface the id as optional metadata.
+func (c *dash0Client) ResolveSLO(ctx context.Context, origin string, dataset string) (string, error) {
+	slo, err := c.getSLO(ctx, origin, dataset)
+	if err != nil {
+		return "", err
+	}
+	if slo.Metadata.Labels == nil || slo.Metadata.Labels.Dash0Comid == nil {
+		return "", nil
+	}
+	return *slo.Metadata.Labels.Dash0Comid, nil
+}
+
+// getSLO retrieves the SLO with the given origin unchanged.
+func (c *dash0Client) getSLO(ctx context.Context, origin string, dataset string) (*dash0.SloDefinition, error) {
+	resp, err := c.inner.Inner().GetApiSlosOriginOrIdWithResponse(ctx, origin, &dash0.GetApiSlosOriginOrIdParams{Dataset: &dataset})
+	if err != nil {
+		return nil, fmt.Errorf("dash0: get SLO failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, generatedAPIError(resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("dash0: unexpected nil response")
+	}
+	return resp.JSON200, nil
+}
+
+// putSLO upserts the SLO with the given origin.
+func (c *dash0Client) putSLO(ctx context.Context, origin string, slo *dash0.SloDefinition, dataset string) error {
+	resp, err := c.inner.Inner().PutApiSlosOriginOrIdWithResponse(ctx, origin, &dash0.PutApiSlosOriginOrIdParams{Dataset: &dataset}, *slo)
+	if err != nil {
+		return fmt.Errorf("dash0: put SLO failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+		return generatedAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// unmarshalSLO parses a JSON string into an SloDefinition.
+func unmarshalSLO(jsonStr string) (*dash0.SloDefinition, error) {
+	var slo dash0.SloDefinition
+	if err := json.Unmarshal([]byte(jsonStr), &slo); err != nil {
+		return nil, err
+	}
+	return &slo, nil
+}
+
+// setSLOOrigin stamps the provided origin and dataset into metadata.labels,
+// initializing the labels struct as needed.
+func setSLOOrigin(slo *dash0.SloDefinition, origin string, dataset string) {
+	if slo.Metadata.Labels == nil {
+		slo.Metadata.Labels = &dash0.SloLabels{}
+	}
+	o, d := origin, dataset
+	slo.Metadata.Labels.Dash0Comorigin = &o
+	slo.Metadata.Labels.Dash0Comdataset = &d
+}
+
+// stripSLOServerFields clears the labels and annotations the server manages,
+// keeping the client-settable annotations (enabled, folder path, display name
+// and sharing).
+func stripSLOServerFields(slo *dash0.SloDefinition) {
+	if labels := slo.Metadata.Labels; labels != nil {
+		labels.Dash0Comid = nil
+		labels.Dash0Comorigin = nil
+		labels.Dash0Comdataset = nil
+		labels.Dash0Comversion = nil
+		labels.Dash0Comsource = nil
+	}
+	if annotations := slo.Metadata.Annotations; annotations != nil {
+		annotations.Dash0ComcreatedAt = nil
+		annotations.Dash0ComupdatedAt = nil
+		annotations.Dash0ComdeletedAt = nil
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+const testSLOJSON = `{
+  "apiVersion": "openslo/v1",
+  "kind": "SLO",
+  "metadata": {"name": "checkout-availability"},
+  "spec": {
+    "budgetingMethod": "Occurrences",
+    "indicator": {"spec": {"ratioMetric": {"good": {"metricSource": {"spec": {"query": "http_requests_total{status!~\"5..\"}"}}}, "total": {"metricSource": {"spec": {"query": "http_requests_total"}}}}}},
+    "objectives": [{"target": 0.995}]
+  }
+}`
+
+// testSLOServerResponse is an SLO as stored by the server, with the
+// server-managed labels and annotations set.
+const testSLOServerResponse = `{
+  "apiVersion": "openslo/v1",
+  "kind": "SLO",
+  "metadata": {
+    "name": "checkout-availability",
+    "labels": {"dash0.com/id": "slo_01abc", "dash0.com/origin": "tf_checkout", "dash0.com/dataset": "default", "dash0.com/version": "3", "dash0.com/source": "terraform", "team": "payments"},
+    "annotations": {"dash0.com/created-at": "2026-01-05T10:00:00Z", "dash0.com/updated-at": "2026-01-06T10:00:00Z", "dash0.com/folder-path": "/payments"}
+  },
+  "spec": {
+    "budgetingMethod": "Occurrences",
+    "objectives": [{"target": 0.995}]
+  }
+}`
+
+func TestCreateSLO_StampsOriginAndUsesPUT(t *testing.T) {
+	var seenMethod, seenPath, seenDataset string
+	var seenBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		seenPath = r.URL.Path
+		seenDataset = r.URL.Query().Get("dataset")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &seenBody)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.CreateSLO(t.Context(), "tf_checkout", testSLOJSON, "default"))
+
+	assert.Equal(t, http.MethodPut, seenMethod)
+	assert.Equal(t, "/api/slos/tf_checkout", seenPath)
+	assert.Equal(t, "default", seenDataset)
+	metadata, _ := seenBody["metadata"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+	require.NotNil(t, labels)
+	assert.Equal(t, "tf_checkout", labels["dash0.com/origin"])
+	assert.Equal(t, "default", labels["dash0.com/dataset"])
+}
+
+// TestUpdateSLO_CarriesVersion asserts that the update sends the current
+// version the server requires to detect conflicting writes.
+func TestUpdateSLO_CarriesVersion(t *testing.T) {
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(testSLOServerResponse))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &putBody)
+			_, _ = w.Write(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.UpdateSLO(t.Context(), "tf_checkout", testSLOJSON, "default"))
+
+	metadata, _ := putBody["metadata"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+	require.NotNil(t, labels)
+	assert.Equal(t, "3", labels["dash0.com/version"])
+	assert.Equal(t, "tf_checkout", labels["dash0.com/origin"])
+}
+
+func TestGetSLO_StripsServerFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testSLOServerResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	sloJSON, err := c.GetSLO(t.Context(), "tf_checkout", "default")
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(sloJSON), &got))
+	metadata, _ := got["metadata"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"team": "payments"}, metadata["labels"])
+	assert.Equal(t, map[string]interface{}{"dash0.com/folder-path": "/payments"}, metadata["annotations"])
+
+	id, err := c.ResolveSLO(t.Context(), "tf_checkout", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "slo_01abc", id)
+}
+
+func TestDeleteSLO_NotFound(t *testing.T) {
+	var seenMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	err := c.DeleteSLO(t.Context(), "tf_checkout", "default")
+	assert.Equal(t, http.MethodDelete, seenMethod)
+	assert.True(t, dash0.IsNotFound(err), "a missing SLO must surface as a not-found API error")
+}
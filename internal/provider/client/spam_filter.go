@@ -60,7 +60,7 @@ func (c *dash0Client) upsertSpamFilter(ctx context.Context, origin, filterJSON,
 
 		tflog.Debug(ctx, fmt.Sprintf("Upserting v1alpha2 spam filter with origin: %s", origin))
 		if _, err := c.inner.UpdateSpamFilterV1Alpha2(ctx, origin, filter, &dataset); err != nil {
-			return err
+			return c.upsertSpamFilterFailed(ctx, origin, dataset, op, err)
 		}
 	} else {
 		filter, err := unmarshalSpamFilter(filterJSON)
@@ -75,7 +75,7 @@ func (c *dash0Client) upsertSpamFilter(ctx context.Context, origin, filterJSON,
 
 		tflog.Debug(ctx, fmt.Sprintf("Upserting v1alpha1 spam filter with origin: %s", origin))
 		if _, err := c.inner.UpdateSpamFilter(ctx, origin, filter, &dataset); err != nil {
-			return err
+			return c.upsertSpamFilterFailed(ctx, origin, dataset, op, err)
 		}
 	}
 
@@ -83,6 +83,18 @@ func (c *dash0Client) upsertSpamFilter(ctx context.Context, origin, filterJSON,
 	return nil
 }
 
+// upsertSpamFilterFailed returns the error of a failed upsert, reconciling
+// creates whose outcome is unknown (see reconcileCreate).
+func (c *dash0Client) upsertSpamFilterFailed(ctx context.Context, origin, dataset string, op upsertOp, err error) error {
+	if op != upsertCreate {
+		return err
+	}
+	return reconcileCreate(ctx, "spam filter", origin, err, func(ctx context.Context) error {
+		_, err := c.inner.GetSpamFilter(ctx, origin, &dataset)
+		return err
+	})
+}
+
 func (c *dash0Client) GetSpamFilter(ctx context.Context, origin string, dataset string) (string, error) {
 	obj, err := c.inner.GetSpamFilter(ctx, origin, &dataset)
 	if err != nil {
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// OperationSummary collects per-run statistics of the provider: the creates,
+// updates and deletes per kind, the number of API requests and the time spent
+// on them, retries and rate-limited responses.
+//
+// A provider cannot observe the end of a Terraform run, so the summary is
+// rewritten to its file after every mutation and every API request: when
+// Terraform stops the provider, the file holds the totals of the run. The file
+// is replaced atomically, so readers never see a partial document. Writing is
+// best-effort; a failure to write the summary does not fail the operation.
+//
+// The totals are guarded by mu and the file by writeMu, so that concurrent
+// requests only wait for each other while they update the counters, not while
+// the file is written.
+type OperationSummary struct {
+	mu   sync.Mutex
+	path string
+	now  func() time.Time
+
+	writeMu sync.Mutex
+	// version counts the changes to the totals, and written is the version
+	// of the document last written, so that a document taken before a newer
+	// one has been written is dropped instead of overwriting it.
+	version int
+	written int
+
+	startedAt   time.Time
+	mutations   map[string]map[string]int
+	errors      int
+	requests    int
+	attempts    int
+	rateLimited int
+	apiTime     time.Duration
+}
+
+// summaryDocument is the JSON document written to the summary file.
+type summaryDocument struct {
+	StartedAt string `json:"started_at"`
+	UpdatedAt string `json:"updated_at"`
+	// Operations counts the mutations per kind and operation, for example
+	// {"dashboard": {"create": 2}}. Failed mutations are included.
+	Operations     map[string]map[string]int `json:"operations"`
+	Errors         int                       `json:"errors"`
+	APIRequests    int                       `json:"api_requests"`
+	APITimeSeconds float64                   `json:"api_time_seconds"`
+	Retries        int                       `json:"retries"`
+	RateLimited    int                       `json:"rate_limited"`
+}
+
+// NewOperationSummary returns an empty summary that is written to the file at
+// path. An initial document is written right away, so that a path that is not
+// writable is reported when the provider is configured.
+func NewOperationSummary(path string) (*OperationSummary, error) {
+	s := &OperationSummary{
+		path:      path,
+		now:       time.Now,
+		mutations: map[string]map[string]int{},
+	}
+	s.startedAt = s.now()
+	if err := s.write(s.snapshot()); err != nil {
+		return nil, fmt.Errorf("writing operation summary: %w", err)
+	}
+	return s, nil
+}
+
+// record counts a mutation. It implements mutationRecorder, so that the
+// summary can be fed by the same decorator as the audit log.
+func (s *OperationSummary) record(operation, kind, _, _ string, err error) error {
+	s.mu.Lock()
+	if s.mutations[kind] == nil {
+		s.mutations[kind] = map[string]int{}
+	}
+	s.mutations[kind][operation]++
+	if err != nil {
+		s.errors++
+	}
+	doc := s.snapshot()
+	s.mu.Unlock()
+
+	_ = s.write(doc)
+	return nil
+}
+
+// versionedDocument is a summary document along with the version of the
+// totals it was taken from.
+type versionedDocument struct {
+	summaryDocument
+	version int
+}
+
+// snapshot returns a document of the current totals. The caller holds s.mu,
+// except in NewOperationSummary.
+func (s *OperationSummary) snapshot() versionedDocument {
+	s.version++
+	operations := make(map[string]map[string]int, len(s.mutations))
+	for kind, counts := range s.mutations {
+		operations[kind] = make(map[string]int, len(counts))
+		for operation, n := range counts {
+			operations[kind][operation] = n
+		}
+	}
+	return versionedDocument{
+		summaryDocument: summaryDocument{
+			StartedAt:      s.startedAt.UTC().Format(time.RFC3339Nano),
+			UpdatedAt:      s.now().UTC().Format(time.RFC3339Nano),
+			Operations:     operations,
+			Errors:         s.errors,
+			APIRequests:    s.requests,
+			APITimeSeconds: s.apiTime.Seconds(),
+			Retries:        max(s.attempts-s.requests, 0),
+			RateLimited:    s.rateLimited,
+		},
+		version: s.version,
+	}
+}
+
+// write replaces the summary file with doc, unless a newer document has
+// already been written. The caller must not hold s.mu.
+func (s *OperationSummary) write(doc versionedDocument) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if doc.version <= s.written {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(doc.summaryDocument, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), "."+filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+	s.written = doc.version
+	return nil
+}
+
+// NewSummarizingClient wraps inner so that every mutation is counted in
+// summary.
+func NewSummarizingClient(inner Client, summary *OperationSummary) Client {
+	return &auditingClient{Client: inner, log: summary}
+}
+
+// summaryRequests is an http.RoundTripper that counts the API requests sent
+// through it and the time spent on them. It sits on top of the retry
+// transport, so a request and its retries count once, backoff waits included.
+type summaryRequests struct {
+	base    http.RoundTripper
+	summary *OperationSummary
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *summaryRequests) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	t.summary.mu.Lock()
+	t.summary.requests++
+	t.summary.apiTime += elapsed
+	doc := t.summary.snapshot()
+	t.summary.mu.Unlock()
+
+	_ = t.summary.write(doc)
+	return resp, err
+}
+
+// summaryAttempts is an http.RoundTripper that counts every attempt the retry
+// transport makes and the responses rejected with 429 Too Many Requests.
+type summaryAttempts struct {
+	base    http.RoundTripper
+	summary *OperationSummary
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *summaryAttempts) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+
+	t.summary.mu.Lock()
+	defer t.summary.mu.Unlock()
+	t.summary.attempts++
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		t.summary.rateLimited++
+	}
+	return resp, err
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readSummary(t *testing.T, path string) summaryDocument {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var doc summaryDocument
+	require.NoError(t, json.Unmarshal(data, &doc))
+	return doc
+}
+
+func TestNewOperationSummary_WritesInitialDocument(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.json")
+	_, err := NewOperationSummary(path)
+	require.NoError(t, err)
+
+	doc := readSummary(t, path)
+	assert.NotEmpty(t, doc.StartedAt)
+	assert.Empty(t, doc.Operations)
+	assert.Zero(t, doc.APIRequests)
+}
+
+func TestNewOperationSummary_UnwritablePath(t *testing.T) {
+	_, err := NewOperationSummary(filepath.Join(t.TempDir(), "missing", "summary.json"))
+	assert.Error(t, err)
+}
+
+func TestSummarizingClient_CountsMutations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.json")
+	summary, err := NewOperationSummary(path)
+	require.NoError(t, err)
+	inner := &stubMutationClient{}
+	c := NewSummarizingClient(inner, summary)
+
+	require.NoError(t, c.CreateDashboard(t.Context(), "tf_a", "{}", "default"))
+	require.NoError(t, c.CreateDashboard(t.Context(), "tf_b", "{}", "default"))
+	inner.err = errors.New("dash0 api error: not found (status: 404)")
+	require.Error(t, c.DeleteTeam(t.Context(), "tf_team"))
+
+	doc := readSummary(t, path)
+	assert.Equal(t, map[string]map[string]int{
+		"dashboard": {"create": 2},
+		"team":      {"delete": 1},
+	}, doc.Operations)
+	assert.Equal(t, 1, doc.Errors)
+}
+
+func TestOperationSummary_CountsRequestsAndRetries(t *testing.T) {
+	var requestCount atomic.Int32
+	server := httptest.NewServer(throttledServer(1, &requestCount))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "summary.json")
+	summary, err := NewOperationSummary(path)
+	require.NoError(t, err)
+	c, err := NewDash0Client(server.URL, "auth_test-token", "test", 3, WithOperationSummary(summary))
+	require.NoError(t, err)
+
+	_, err = c.GetDashboard(t.Context(), "tf_dash", "default")
+	require.NoError(t, err)
+
+	doc := readSummary(t, path)
+	assert.Equal(t, 1, doc.APIRequests)
+	assert.Equal(t, 1, doc.Retries)
+	assert.Equal(t, 1, doc.RateLimited)
+	assert.Positive(t, doc.APITimeSeconds)
+}
+
+func TestOperationSummary_DropsStaleDocuments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.json")
+	summary, err := NewOperationSummary(path)
+	require.NoError(t, err)
+
+	summary.mu.Lock()
+	summary.requests = 1
+	stale := summary.snapshot()
+	summary.requests = 2
+	latest := summary.snapshot()
+	summary.mu.Unlock()
+
+	require.NoError(t, summary.write(latest))
+	require.NoError(t, summary.write(stale))
+	assert.Equal(t, 2, readSummary(t, path).APIRequests, "a document taken before the one already written must not replace it")
+}
+
+func TestOperationSummary_ConcurrentMutations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.json")
+	summary, err := NewOperationSummary(path)
+	require.NoError(t, err)
+	c := NewSummarizingClient(&stubMutationClient{}, summary)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = c.CreateDashboard(t.Context(), "tf_a", "{}", "default")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, map[string]map[string]int{"dashboard": {"create": 20}}, readSummary(t, path).Operations)
+}
@@ -19,7 +19,10 @@ func (c *dash0Client) CreateSyntheticCheck(ctx context.Context, origin string, c
 
 	_, err = c.inner.UpdateSyntheticCheck(ctx, origin, def, &dataset)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "synthetic check", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetSyntheticCheck(ctx, origin, &dataset)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Synthetic check created with origin: %s", origin))
@@ -87,3 +90,19 @@ func (c *dash0Client) ResolveSyntheticCheck(ctx context.Context, origin string,
 	logResolvedURL(ctx, "synthetic check", origin, syntheticCheckURL)
 	return id, syntheticCheckURL, nil
 }
+
+// FindSyntheticCheckByName returns the identifier of the synthetic check with
+// the given name in the dataset, or an empty string (and no error) when none
+// exists.
+func (c *dash0Client) FindSyntheticCheckByName(ctx context.Context, name string, dataset string) (string, error) {
+	items, err := c.inner.ListSyntheticChecks(ctx, &dataset)
+	if err != nil {
+		return "", err
+	}
+
+	identifier := matchName(items, name, func(item *dash0.SyntheticChecksApiListItem) (string, *string, *string) {
+		return item.Id, item.Origin, item.Name
+	})
+	tflog.Debug(ctx, fmt.Sprintf("Looked up synthetic check by name %q in dataset %q: %q", name, dataset, identifier))
+	return identifier, nil
+}
@@ -29,7 +29,10 @@ func (c *dash0Client) CreateTeam(ctx context.Context, origin string, teamJSON st
 
 	_, err = c.inner.UpsertTeam(ctx, origin, def)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "team", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetTeam(ctx, origin)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Team created with origin: %s", origin))
@@ -140,3 +143,41 @@ func setTeamOrigin(def *dash0.TeamDefinitionV1Alpha1, origin string) {
 	o := origin
 	def.Metadata.Labels.Dash0Comorigin = &o
 }
+
+// ListTeamMembers returns the members of the team with the given origin or id.
+func (c *dash0Client) ListTeamMembers(ctx context.Context, team string) ([]Member, error) {
+	resp, err := c.inner.GetTeamWithAssets(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(resp.Members))
+	for i := range resp.Members {
+		members = append(members, memberFromDefinition(&resp.Members[i]))
+	}
+	return members, nil
+}
+
+// CreateTeamMembership adds the member with the given id to the team with the
+// given origin or id, leaving the other members of the team untouched.
+func (c *dash0Client) CreateTeamMembership(ctx context.Context, team string, memberID string) error {
+	err := c.inner.AddTeamMembers(ctx, team, &dash0.AddTeamMembersRequest{MemberIds: []string{memberID}})
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Member %s added to team %s", memberID, team))
+	return nil
+}
+
+// DeleteTeamMembership removes the member with the given id from the team with
+// the given origin or id.
+func (c *dash0Client) DeleteTeamMembership(ctx context.Context, team string, memberID string) error {
+	err := c.inner.RemoveTeamMember(ctx, team, memberID)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Member %s removed from team %s", memberID, team))
+	return nil
+}
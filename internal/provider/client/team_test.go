@@ -447,3 +447,61 @@ func TestResolveTeam(t *testing.T) {
 		})
 	}
 }
+
+// TestListTeamMembers covers the enriched team response: members are taken
+// from the top-level members array, not from spec.members.
+func TestListTeamMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/teams/tf_backend", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+		  "team": {"kind": "Dash0Team", "metadata": {"name": "backend-team"}, "spec": {"display": {"name": "Backend"}, "members": ["user_alice"]}},
+		  "members": [{"kind": "Dash0Member", "metadata": {"name": "Alice", "labels": {"dash0.com/id": "user_alice", "dash0.com/joinedAt": "2026-01-05T10:00:00Z"}}, "spec": {"display": {"email": "alice@example.com"}}}],
+		  "checkRules": [], "dashboards": [], "datasets": [], "syntheticChecks": [], "views": []
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	members, err := c.ListTeamMembers(t.Context(), "tf_backend")
+	require.NoError(t, err)
+	assert.Equal(t, []Member{{ID: "user_alice", Email: "alice@example.com", Joined: true}}, members)
+}
+
+// TestCreateTeamMembership_AddsSingleMember asserts that a membership is
+// added through the members sub-resource instead of replacing spec.members.
+func TestCreateTeamMembership_AddsSingleMember(t *testing.T) {
+	var seenMethod, seenPath string
+	var seenBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		seenPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &seenBody)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.CreateTeamMembership(t.Context(), "tf_backend", "user_alice"))
+
+	assert.Equal(t, http.MethodPost, seenMethod)
+	assert.Equal(t, "/api/teams/tf_backend/members", seenPath)
+	assert.Equal(t, []interface{}{"user_alice"}, seenBody["memberIds"])
+}
+
+func TestDeleteTeamMembership_RemovesSingleMember(t *testing.T) {
+	var seenMethod, seenPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenMethod = r.Method
+		seenPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+	require.NoError(t, c.DeleteTeamMembership(t.Context(), "tf_backend", "user_alice"))
+
+	assert.Equal(t, http.MethodDelete, seenMethod)
+	assert.Equal(t, "/api/teams/tf_backend/members/user_alice", seenPath)
+}
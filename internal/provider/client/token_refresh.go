@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TokenSource re-invokes the credential source the auth token was originally
+// obtained from and returns the token it currently yields.
+type TokenSource func(ctx context.Context) (string, error)
+
+// tokenRefresher is an http.RoundTripper that recovers from auth tokens
+// expiring mid-apply.
+//
+// It stamps every attempt with the current token. When the API answers 401,
+// the token source is consulted once; if it yields a different token, the
+// request is replayed with it and all later requests use it too. Concurrent
+// requests that fail with the same stale token share a single refresh.
+type tokenRefresher struct {
+	base   http.RoundTripper
+	source TokenSource
+
+	mu    sync.Mutex
+	token string
+}
+
+// newTokenRefresher wraps base in a token refresher starting from token. A nil
+// source disables refreshing.
+func newTokenRefresher(base http.RoundTripper, token string, source TokenSource) *tokenRefresher {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tokenRefresher{base: base, source: source, token: token}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenRefresher) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.source == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	used := t.current()
+	resp, err := t.base.RoundTrip(withToken(req, req.Body, used))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// The request cannot be replayed without a fresh copy of its body.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	fresh, err := t.refresh(req.Context(), used)
+	if err != nil {
+		tflog.Warn(req.Context(), fmt.Sprintf("Dash0 API rejected the auth token and refreshing it failed: %s", err))
+		return resp, nil
+	}
+	if fresh == used {
+		return resp, nil
+	}
+
+	var body io.ReadCloser
+	if req.GetBody != nil {
+		if body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	tflog.Info(req.Context(), "Dash0 API rejected the auth token; retrying with a refreshed token")
+	return t.base.RoundTrip(withToken(req, body, fresh))
+}
+
+func (t *tokenRefresher) current() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
+
+// refresh returns a token to replace used. When another request has already
+// refreshed used, its result is returned without consulting the source again.
+func (t *tokenRefresher) refresh(ctx context.Context, used string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != used {
+		return t.token, nil
+	}
+	fresh, err := t.source(ctx)
+	if err != nil {
+		return "", err
+	}
+	if fresh != "" {
+		t.token = fresh
+	}
+	return t.token, nil
+}
+
+// withToken returns a copy of req with the given body and auth token.
+func withToken(req *http.Request, body io.ReadCloser, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Body = body
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
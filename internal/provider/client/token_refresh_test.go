@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenCheckingServer accepts only requests authenticated with validToken and
+// echoes a dashboard for everything else that gets through.
+func tokenCheckingServer(t *testing.T, validToken string, requestCount *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"token expired"}`))
+			return
+		}
+		if r.Method == http.MethodPut && len(body) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"Dashboard","metadata":{"name":"test"},"spec":{}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTokenRefresh_RetriesWithRefreshedToken(t *testing.T) {
+	var requestCount, refreshCount atomic.Int32
+	server := tokenCheckingServer(t, "auth_fresh", &requestCount)
+
+	source := func(context.Context) (string, error) {
+		refreshCount.Add(1)
+		return "auth_fresh", nil
+	}
+	c, err := NewDash0Client(server.URL, "auth_expired", "test", 0, WithTokenRefresh(source))
+	require.NoError(t, err)
+
+	// The request body is replayed on the retried request.
+	err = c.UpdateDashboard(t.Context(), "tf_test", `{"kind":"Dashboard","metadata":{"name":"test"},"spec":{}}`, "default")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requestCount.Load())
+
+	// Later requests use the refreshed token straight away.
+	_, err = c.GetDashboard(t.Context(), "tf_test", "default")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requestCount.Load())
+	assert.Equal(t, int32(1), refreshCount.Load())
+}
+
+func TestTokenRefresh_UnchangedTokenReturnsUnauthorized(t *testing.T) {
+	var requestCount atomic.Int32
+	server := tokenCheckingServer(t, "auth_fresh", &requestCount)
+
+	source := func(context.Context) (string, error) { return "auth_expired", nil }
+	c, err := NewDash0Client(server.URL, "auth_expired", "test", 0, WithTokenRefresh(source))
+	require.NoError(t, err)
+
+	_, err = c.GetDashboard(t.Context(), "tf_test", "default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "token expired")
+	assert.Equal(t, int32(1), requestCount.Load())
+}
+
+func TestTokenRefresh_SourceErrorReturnsUnauthorized(t *testing.T) {
+	var requestCount atomic.Int32
+	server := tokenCheckingServer(t, "auth_fresh", &requestCount)
+
+	source := func(context.Context) (string, error) { return "", errors.New("re-authentication required") }
+	c, err := NewDash0Client(server.URL, "auth_expired", "test", 0, WithTokenRefresh(source))
+	require.NoError(t, err)
+
+	_, err = c.GetDashboard(t.Context(), "tf_test", "default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "token expired")
+	assert.Equal(t, int32(1), requestCount.Load())
+}
+
+func TestTokenRefresh_DisabledWithoutSource(t *testing.T) {
+	var requestCount atomic.Int32
+	server := tokenCheckingServer(t, "auth_fresh", &requestCount)
+
+	c, err := NewDash0Client(server.URL, "auth_expired", "test", 0)
+	require.NoError(t, err)
+
+	_, err = c.GetDashboard(t.Context(), "tf_test", "default")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), requestCount.Load())
+}
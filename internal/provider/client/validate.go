@@ -0,0 +1,87 @@
+package client
+
+import (
+	"fmt"
+
+	dash0yaml "github.com/dash0hq/dash0-api-client-go/yaml"
+)
+
+// The Validate functions decode a definition exactly as the corresponding
+// Create and Update methods do, without contacting the API. The provider runs
+// them at plan time so that definitions the client would reject are reported
+// for all resources at once, before the first mutation of an apply.
+
+// ValidateDashboard checks that dashboardJSON decodes into a dashboard
+// definition.
+func ValidateDashboard(dashboardJSON string) error {
+	_, err := unmarshalDashboard(dashboardJSON)
+	return err
+}
+
+// ValidateSyntheticCheck checks that checkJSON decodes into a synthetic check
+// definition.
+func ValidateSyntheticCheck(checkJSON string) error {
+	_, err := unmarshalSyntheticCheck(checkJSON)
+	return err
+}
+
+// ValidateView checks that viewJSON decodes into a view definition.
+func ValidateView(viewJSON string) error {
+	_, err := unmarshalView(viewJSON)
+	return err
+}
+
+// ValidateCheckRule checks that ruleYAML converts into a Dash0 check rule,
+// which requires exactly one group holding exactly one rule.
+func ValidateCheckRule(ruleYAML string) error {
+	if _, err := dash0yaml.UnmarshalPrometheusRule([]byte(ruleYAML)); err != nil {
+		return fmt.Errorf("error converting check rule YAML to Dash0 format: %w", err)
+	}
+	return nil
+}
+
+// ValidateRecordingRule checks that ruleJSON decodes into a recording rule.
+func ValidateRecordingRule(ruleJSON string) error {
+	_, err := unmarshalRecordingRule(ruleJSON)
+	return err
+}
+
+// ValidateSLO checks that sloJSON decodes into an SLO definition.
+func ValidateSLO(sloJSON string) error {
+	_, err := unmarshalSLO(sloJSON)
+	return err
+}
+
+// ValidateSamplingRule checks that ruleJSON decodes into a sampling rule.
+func ValidateSamplingRule(ruleJSON string) error {
+	_, err := unmarshalSamplingRule(ruleJSON)
+	return err
+}
+
+// ValidateSpamFilter checks that filterJSON decodes into the spam filter
+// version selected by its apiVersion.
+func ValidateSpamFilter(filterJSON string) error {
+	v1alpha2, err := spamFilterIsV1Alpha2(filterJSON)
+	if err != nil {
+		return err
+	}
+	if v1alpha2 {
+		_, err = unmarshalSpamFilterV1Alpha2(filterJSON)
+	} else {
+		_, err = unmarshalSpamFilter(filterJSON)
+	}
+	return err
+}
+
+// ValidateNotificationChannel checks that channelJSON decodes into a
+// notification channel definition.
+func ValidateNotificationChannel(channelJSON string) error {
+	_, err := unmarshalNotificationChannel(channelJSON)
+	return err
+}
+
+// ValidateTeam checks that teamJSON decodes into a team definition.
+func ValidateTeam(teamJSON string) error {
+	_, err := unmarshalTeam(teamJSON)
+	return err
+}
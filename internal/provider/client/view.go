@@ -19,7 +19,10 @@ func (c *dash0Client) CreateView(ctx context.Context, origin string, viewJSON st
 
 	_, err = c.inner.UpdateView(ctx, origin, def, &dataset)
 	if err != nil {
-		return err
+		return reconcileCreate(ctx, "view", origin, err, func(ctx context.Context) error {
+			_, err := c.inner.GetView(ctx, origin, &dataset)
+			return err
+		})
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("View created with origin: %s", origin))
@@ -101,3 +104,18 @@ func (c *dash0Client) ResolveView(ctx context.Context, origin string, dataset st
 	logResolvedURL(ctx, "view", origin, viewURL)
 	return matched.Id, viewURL, nil
 }
+
+// FindViewByName returns the identifier of the view with the given name in
+// the dataset, or an empty string (and no error) when none exists.
+func (c *dash0Client) FindViewByName(ctx context.Context, name string, dataset string) (string, error) {
+	items, err := c.inner.ListViews(ctx, &dataset)
+	if err != nil {
+		return "", err
+	}
+
+	identifier := matchName(items, name, func(item *dash0.ViewApiListItem) (string, *string, *string) {
+		return item.Id, item.Origin, item.Name
+	})
+	tflog.Debug(ctx, fmt.Sprintf("Looked up view by name %q in dataset %q: %q", name, dataset, identifier))
+	return identifier, nil
+}
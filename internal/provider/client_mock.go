@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
 )
 
 // MockClient mocks the client.Client interface
@@ -36,6 +39,11 @@ func (m *MockClient) ResolveDashboard(ctx context.Context, origin string, datase
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) FindDashboardByName(ctx context.Context, name string, dataset string) (string, error) {
+	args := m.Called(ctx, name, dataset)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockClient) CreateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error {
 	args := m.Called(ctx, origin, checkJSON, dataset)
 	return args.Error(0)
@@ -61,6 +69,11 @@ func (m *MockClient) ResolveSyntheticCheck(ctx context.Context, origin string, d
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) FindSyntheticCheckByName(ctx context.Context, name string, dataset string) (string, error) {
+	args := m.Called(ctx, name, dataset)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockClient) CreateView(ctx context.Context, origin string, viewJSON string, dataset string) error {
 	args := m.Called(ctx, origin, viewJSON, dataset)
 	return args.Error(0)
@@ -86,6 +99,11 @@ func (m *MockClient) ResolveView(ctx context.Context, origin string, dataset str
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) FindViewByName(ctx context.Context, name string, dataset string) (string, error) {
+	args := m.Called(ctx, name, dataset)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockClient) CreateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error {
 	args := m.Called(ctx, origin, ruleYAML, dataset)
 	return args.Error(0)
@@ -111,6 +129,12 @@ func (m *MockClient) ResolveCheckRule(ctx context.Context, origin string, datase
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) ListCheckRuleOrigins(ctx context.Context, prefix string, dataset string) ([]string, error) {
+	args := m.Called(ctx, prefix, dataset)
+	origins, _ := args.Get(0).([]string)
+	return origins, args.Error(1)
+}
+
 func (m *MockClient) CreateRecordingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
 	args := m.Called(ctx, origin, ruleJSON, dataset)
 	return args.Error(0)
@@ -136,6 +160,62 @@ func (m *MockClient) ResolveRecordingRule(ctx context.Context, origin string, da
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) ListRecordingRuleOrigins(ctx context.Context, prefix string, dataset string) ([]string, error) {
+	args := m.Called(ctx, prefix, dataset)
+	origins, _ := args.Get(0).([]string)
+	return origins, args.Error(1)
+}
+
+func (m *MockClient) CreateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error {
+	args := m.Called(ctx, origin, sloJSON, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) GetSLO(ctx context.Context, origin string, dataset string) (string, error) {
+	args := m.Called(ctx, origin, dataset)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) UpdateSLO(ctx context.Context, origin string, sloJSON string, dataset string) error {
+	args := m.Called(ctx, origin, sloJSON, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) DeleteSLO(ctx context.Context, origin string, dataset string) error {
+	args := m.Called(ctx, origin, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) ResolveSLO(ctx context.Context, origin string, dataset string) (string, error) {
+	args := m.Called(ctx, origin, dataset)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) CreateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	args := m.Called(ctx, origin, ruleJSON, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) GetSamplingRule(ctx context.Context, origin string, dataset string) (string, error) {
+	args := m.Called(ctx, origin, dataset)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) UpdateSamplingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
+	args := m.Called(ctx, origin, ruleJSON, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) DeleteSamplingRule(ctx context.Context, origin string, dataset string) error {
+	args := m.Called(ctx, origin, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) ResolveSamplingRule(ctx context.Context, origin string, dataset string) (string, error) {
+	args := m.Called(ctx, origin, dataset)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockClient) CreateNotificationChannel(ctx context.Context, origin string, channelJSON string) error {
 	args := m.Called(ctx, origin, channelJSON)
 	return args.Error(0)
@@ -186,6 +266,38 @@ func (m *MockClient) ResolveTeam(ctx context.Context, origin string) (string, er
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) ListTeamMembers(ctx context.Context, team string) ([]client.Member, error) {
+	args := m.Called(ctx, team)
+	members, _ := args.Get(0).([]client.Member)
+	return members, args.Error(1)
+}
+
+func (m *MockClient) CreateTeamMembership(ctx context.Context, team string, memberID string) error {
+	args := m.Called(ctx, team, memberID)
+	return args.Error(0)
+}
+
+func (m *MockClient) DeleteTeamMembership(ctx context.Context, team string, memberID string) error {
+	args := m.Called(ctx, team, memberID)
+	return args.Error(0)
+}
+
+func (m *MockClient) ListMembers(ctx context.Context) ([]client.Member, error) {
+	args := m.Called(ctx)
+	members, _ := args.Get(0).([]client.Member)
+	return members, args.Error(1)
+}
+
+func (m *MockClient) CreateMember(ctx context.Context, email string, role string) error {
+	args := m.Called(ctx, email, role)
+	return args.Error(0)
+}
+
+func (m *MockClient) DeleteMember(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func (m *MockClient) CreateSpamFilter(ctx context.Context, origin string, filterJSON string, dataset string) error {
 	args := m.Called(ctx, origin, filterJSON, dataset)
 	return args.Error(0)
@@ -210,3 +322,40 @@ func (m *MockClient) ResolveSpamFilter(ctx context.Context, origin string, datas
 	args := m.Called(ctx, origin, dataset)
 	return args.String(0), args.Error(1)
 }
+
+func (m *MockClient) CreateAsset(ctx context.Context, kind string, origin string, assetJSON string, dataset string) error {
+	args := m.Called(ctx, kind, origin, assetJSON, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) GetAsset(ctx context.Context, kind string, origin string, dataset string) (string, error) {
+	args := m.Called(ctx, kind, origin, dataset)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) UpdateAsset(ctx context.Context, kind string, origin string, assetJSON string, dataset string) error {
+	args := m.Called(ctx, kind, origin, assetJSON, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) DeleteAsset(ctx context.Context, kind string, origin string, dataset string) error {
+	args := m.Called(ctx, kind, origin, dataset)
+	return args.Error(0)
+}
+
+func (m *MockClient) CountFailedChecks(ctx context.Context, dataset string, labels map[string]string) (int, int, error) {
+	args := m.Called(ctx, dataset, labels)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (m *MockClient) QueryRange(ctx context.Context, dataset string, query string, start, end time.Time, step time.Duration) ([]client.RangeSeries, error) {
+	args := m.Called(ctx, dataset, query, start, end, step)
+	series, _ := args.Get(0).([]client.RangeSeries)
+	return series, args.Error(1)
+}
+
+func (m *MockClient) PermittedDatasetActions(ctx context.Context) (map[string][]string, error) {
+	args := m.Called(ctx)
+	actions, _ := args.Get(0).(map[string][]string)
+	return actions, args.Error(1)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Values accepted by the `conflict_strategy` attribute.
+const (
+	conflictStrategyAdopt   = "adopt"
+	conflictStrategyError   = "error"
+	conflictStrategyReplace = "replace"
+)
+
+// conflictStrategyAttribute returns the schema for the optional
+// `conflict_strategy` attribute of a resource that supports name-based
+// conflict detection on create.
+func conflictStrategyAttribute(assetName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("How to handle an existing %[1]s with the same name in the dataset when the resource is created. `adopt` takes over the existing %[1]s and overwrites it with this definition, `error` fails the apply, and `replace` deletes the existing %[1]s before creating a new one. When unset, no lookup is performed and a second %[1]s with the same name may be created. Only evaluated on create. Independently of this setting, the plan fails when two resources in the configuration declare a %[1]s with the same name in the same dataset. Only resources that are being planned are compared, so a resource excluded with `-target` is not checked against the others.", assetName),
+		Optional:    true,
+		Validators: []validator.String{
+			oneOf(conflictStrategyAdopt, conflictStrategyError, conflictStrategyReplace),
+		},
+	}
+}
+
+// createConflict describes how to look up and remove an existing asset with
+// the same name as the one being created. find and delete match the
+// signatures of the client's FindXByName and DeleteX methods.
+type createConflict struct {
+	assetName string
+	name      string
+	dataset   string
+	find      func(ctx context.Context, name string, dataset string) (string, error)
+	delete    func(ctx context.Context, origin string, dataset string) error
+}
+
+// resolveCreateConflict applies the configured conflict strategy before an
+// asset is created and returns the origin to create the asset with, and
+// whether that origin belongs to an existing asset. For `adopt`, the origin is
+// the identifier of the existing asset, so the subsequent upsert overwrites it
+// in place; in all other cases a fresh provider-generated origin is returned.
+// Errors are reported on diags.
+//
+// Callers write an adopted asset with the client's UpdateX method rather than
+// CreateX: CreateX treats an asset found at the origin after a failed request
+// as created, which for an adopted origin only proves that the asset existed
+// before.
+func resolveCreateConflict(ctx context.Context, strategy types.String, c createConflict, diags *diag.Diagnostics) (types.String, bool) {
+	origin := types.StringValue("tf_" + uuid.New().String())
+	if strategy.IsNull() || strategy.IsUnknown() || c.name == "" {
+		return origin, false
+	}
+
+	existing, err := c.find(ctx, c.name, c.dataset)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to look up existing %s named %q, got error: %s", c.assetName, c.name, err))
+		return origin, false
+	}
+	if existing == "" {
+		return origin, false
+	}
+
+	switch strategy.ValueString() {
+	case conflictStrategyAdopt:
+		tflog.Info(ctx, fmt.Sprintf("Adopting existing %s named %q with identifier %s", c.assetName, c.name, existing))
+		return types.StringValue(existing), true
+	case conflictStrategyReplace:
+		tflog.Info(ctx, fmt.Sprintf("Deleting existing %s named %q with identifier %s before creating a replacement", c.assetName, c.name, existing))
+		if err := c.delete(ctx, existing, c.dataset); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete existing %s named %q, got error: %s", c.assetName, c.name, err))
+		}
+		return origin, false
+	default:
+		diags.AddError(
+			fmt.Sprintf("Conflicting %s", c.assetName),
+			fmt.Sprintf("A %s named %q already exists with identifier %s. Import it, or set conflict_strategy to \"adopt\" or \"replace\".", c.assetName, c.name, existing),
+		)
+		return origin, false
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveCreateConflict(t *testing.T) {
+	ctx := context.Background()
+
+	newConflict := func(existing string, findErr error, deleted *string) createConflict {
+		return createConflict{
+			assetName: "dashboard",
+			name:      "Checkout",
+			dataset:   "default",
+			find: func(_ context.Context, name string, dataset string) (string, error) {
+				assert.Equal(t, "Checkout", name)
+				assert.Equal(t, "default", dataset)
+				return existing, findErr
+			},
+			delete: func(_ context.Context, origin string, _ string) error {
+				*deleted = origin
+				return nil
+			},
+		}
+	}
+
+	t.Run("unset strategy skips the lookup", func(t *testing.T) {
+		var diags diag.Diagnostics
+		c := newConflict("", nil, new(string))
+		c.find = func(context.Context, string, string) (string, error) {
+			t.Fatal("find must not be called when conflict_strategy is unset")
+			return "", nil
+		}
+		origin, _ := resolveCreateConflict(ctx, types.StringNull(), c, &diags)
+		assert.False(t, diags.HasError())
+		assert.True(t, strings.HasPrefix(origin.ValueString(), "tf_"))
+	})
+
+	t.Run("no existing asset generates a new origin", func(t *testing.T) {
+		var diags diag.Diagnostics
+		origin, _ := resolveCreateConflict(ctx, types.StringValue(conflictStrategyError), newConflict("", nil, new(string)), &diags)
+		assert.False(t, diags.HasError())
+		assert.True(t, strings.HasPrefix(origin.ValueString(), "tf_"))
+	})
+
+	t.Run("adopt returns the existing identifier", func(t *testing.T) {
+		var diags diag.Diagnostics
+		origin, adopted := resolveCreateConflict(ctx, types.StringValue(conflictStrategyAdopt), newConflict("tf_existing", nil, new(string)), &diags)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "tf_existing", origin.ValueString())
+		assert.True(t, adopted)
+	})
+
+	t.Run("error fails when an asset exists", func(t *testing.T) {
+		var diags diag.Diagnostics
+		resolveCreateConflict(ctx, types.StringValue(conflictStrategyError), newConflict("tf_existing", nil, new(string)), &diags)
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("replace deletes the existing asset and generates a new origin", func(t *testing.T) {
+		var diags diag.Diagnostics
+		var deleted string
+		origin, adopted := resolveCreateConflict(ctx, types.StringValue(conflictStrategyReplace), newConflict("tf_existing", nil, &deleted), &diags)
+		assert.False(t, diags.HasError())
+		assert.False(t, adopted)
+		assert.Equal(t, "tf_existing", deleted)
+		assert.NotEqual(t, "tf_existing", origin.ValueString())
+	})
+
+	t.Run("lookup failure is reported", func(t *testing.T) {
+		var diags diag.Diagnostics
+		resolveCreateConflict(ctx, types.StringValue(conflictStrategyAdopt), newConflict("", errors.New("API error"), new(string)), &diags)
+		assert.True(t, diags.HasError())
+	})
+}
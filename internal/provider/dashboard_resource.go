@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
@@ -24,9 +23,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DashboardResource{}
-	_ resource.ResourceWithConfigure   = &DashboardResource{}
-	_ resource.ResourceWithImportState = &DashboardResource{}
+	_ resource.Resource                   = &DashboardResource{}
+	_ resource.ResourceWithConfigure      = &DashboardResource{}
+	_ resource.ResourceWithImportState    = &DashboardResource{}
+	_ resource.ResourceWithValidateConfig = &DashboardResource{}
+	_ resource.ResourceWithModifyPlan     = &DashboardResource{}
 )
 
 // NewDashboardResource is a helper function to simplify the provider implementation.
@@ -36,16 +37,39 @@ func NewDashboardResource() resource.Resource {
 
 // DashboardResource is the resource implementation.
 type DashboardResource struct {
-	client client.Client
+	client        client.Client
+	plannedNames  *plannedNames
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
 }
 
 // dashboardModel is the Terraform state model for a dashboard resource.
 type dashboardModel struct {
-	Origin        types.String `tfsdk:"origin"`
-	ID            types.String `tfsdk:"id"`
-	Dataset       types.String `tfsdk:"dataset"`
-	DashboardYaml types.String `tfsdk:"dashboard_yaml"`
-	URL           types.String `tfsdk:"url"`
+	Origin               types.String `tfsdk:"origin"`
+	ID                   types.String `tfsdk:"id"`
+	Dataset              types.String `tfsdk:"dataset"`
+	DashboardYaml        types.String `tfsdk:"dashboard_yaml"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+	NamePrefix           types.String `tfsdk:"name_prefix"`
+	NameSuffix           types.String `tfsdk:"name_suffix"`
+	ConflictStrategy     types.String `tfsdk:"conflict_strategy"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+	URL                  types.String `tfsdk:"url"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model, on top of the provider-level default labels.
+func (m dashboardModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+		NamePrefix:    m.NamePrefix,
+		NameSuffix:    m.NameSuffix,
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -64,12 +88,67 @@ func (r *DashboardResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_dashboard")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
+	r.plannedNames = plannedNamesOf(req.ProviderData)
 }
 
 func (r *DashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dashboard"
 }
 
+// ValidateConfig checks the dashboard definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model dashboardModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.DashboardYaml, definitionCheck{
+		attribute:    "dashboard_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateDashboard,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan reports a dashboard whose name is also declared by another
+// dash0_dashboard resource in the same dataset, plans the labels the provider
+// merges into the definition, and checks the permissions of the auth token
+// when the permission preflight is enabled.
+func (r *DashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_dashboard", "", &resp.Diagnostics)
+	var plan dashboardModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var priorDataset types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("dataset"), &priorDataset)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.plannedNames.check(ctx, plannedAsset{
+		resourceType: "dash0_dashboard",
+		assetName:    "dashboard",
+		attribute:    "dashboard_yaml",
+		definition:   plan.DashboardYaml,
+		metadata:     plan.managedMetadata(r.defaultLabels),
+		dataset:      plan.Dataset,
+		priorDataset: priorDataset,
+		origin:       plan.Origin,
+		namePaths:    []string{"spec.display.name", "metadata.name"},
+	}, &resp.Diagnostics)
+	planLabelsAll(ctx, resp, plan.DashboardYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `Manages a Dash0 Dashboard. Dashboards provide visualizations of your telemetry data such as metrics, logs, and traces. See [About Dashboards](https://dash0.com/docs/dash0/dashboards/about-dashboards) for more details. The dashboard definition uses the [Perses Dashboard format](https://dash0.com/docs/dash0/dashboards/reference-dashboard-source-format).`,
@@ -102,6 +181,13 @@ func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing, converter.AnnotationFolderPath),
 				},
 			},
+			"labels":                 labelsAttribute("dashboard"),
+			"annotations":            annotationsAttribute("dashboard"),
+			"labels_all":             labelsAllAttribute("dashboard"),
+			"name_prefix":            nameAffixAttribute("dashboard", "prepended to"),
+			"name_suffix":            nameAffixAttribute("dashboard", "appended to"),
+			"conflict_strategy":      conflictStrategyAttribute("dashboard"),
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("dashboard"),
 			"url": schema.StringAttribute{
 				Description: "The URL to open this dashboard in the Dash0 web app, derived from the Dash0 API URL and the dashboard's server-assigned identifier. Computed by the provider after creation. May be empty if the app URL cannot be derived (e.g. for self-hosted deployments with a custom web app domain).",
 				Computed:    true,
@@ -140,8 +226,6 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	model.Origin = types.StringValue("tf_" + uuid.New().String())
-
 	// Validate YAML format
 	var dashboardYaml interface{}
 	err := yaml.Unmarshal([]byte(model.DashboardYaml.ValueString()), &dashboardYaml)
@@ -153,14 +237,40 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations, name affixes)
+	definition, diags := mergeManagedMetadata(ctx, model.DashboardYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.DashboardYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert dashboard YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateDashboard(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Generate the origin, applying the conflict strategy to an existing
+	// dashboard with the same name
+	var adopted bool
+	model.Origin, adopted = resolveCreateConflict(ctx, model.ConflictStrategy, createConflict{
+		assetName: "dashboard",
+		name:      converter.DefinitionName(definition, "spec.display.name", "metadata.name"),
+		dataset:   model.Dataset.ValueString(),
+		find:      r.client.FindDashboardByName,
+		delete:    r.client.DeleteDashboard,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An adopted asset is overwritten with an update, see resolveCreateConflict.
+	create := r.client.CreateDashboard
+	if adopted {
+		create = r.client.UpdateDashboard
+	}
+	err = create(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard, got error: %s", err))
 		return
@@ -169,6 +279,9 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 	// Resolve the id and web app URL for the newly created dashboard (best-effort).
 	r.resolveDashboard(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.DashboardYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a dashboard resource")
 
 	// Set state to fully populated data
@@ -195,9 +308,18 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Compare the current state with the retrieved dashboard
 	if state.DashboardYaml.ValueString() != "" {
-		stateYAML := state.DashboardYaml.ValueString()
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.DashboardYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseJSON, state.IgnoreServerDefaults), additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Dashboard Comparison Error",
@@ -214,6 +336,10 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.DashboardYaml = types.StringValue(apiResponseJSON)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -247,8 +373,15 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations, name affixes)
+	definition, diags := mergeManagedMetadata(ctx, plan.DashboardYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.DashboardYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert dashboard YAML to JSON: %s", err))
 		return
@@ -267,6 +400,9 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.DashboardYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a dashboard resource")
 
 	// Set state to fully populated data
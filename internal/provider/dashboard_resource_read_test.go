@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -43,10 +44,12 @@ spec:
 
 	// Test cases for different types of API responses
 	tests := []struct {
-		name              string
-		apiResponseYaml   string
-		expectYamlUpdated bool
-		expectWarning     bool
+		name                 string
+		apiResponseYaml      string
+		ignoredFields        []string
+		ignoreServerDefaults bool
+		expectYamlUpdated    bool
+		expectWarning        bool
 	}{
 		{
 			name: "metadata changes only - no significant diff",
@@ -82,6 +85,52 @@ spec:
 			expectYamlUpdated: true,
 			expectWarning:     false,
 		},
+		{
+			name: "server-injected field in ignored_fields - no significant diff",
+			apiResponseYaml: `
+kind: Dashboard
+metadata:
+  name: test-dashboard
+spec:
+  title: Test Dashboard
+  description: Original description
+  owner: injected-by-enricher
+`,
+			ignoredFields:     []string{"spec.owner"},
+			expectYamlUpdated: false,
+			expectWarning:     false,
+		},
+		{
+			name: "fields set in the web app with ignore_server_defaults - no significant diff",
+			apiResponseYaml: `
+kind: Dashboard
+metadata:
+  name: test-dashboard
+spec:
+  title: Test Dashboard
+  description: Original description
+  duration: 30m
+  refreshInterval: 1m
+`,
+			ignoreServerDefaults: true,
+			expectYamlUpdated:    false,
+			expectWarning:        false,
+		},
+		{
+			name: "declared field changed with ignore_server_defaults - should update state",
+			apiResponseYaml: `
+kind: Dashboard
+metadata:
+  name: test-dashboard
+spec:
+  title: Test Dashboard
+  description: Updated description
+  duration: 30m
+`,
+			ignoreServerDefaults: true,
+			expectYamlUpdated:    true,
+			expectWarning:        false,
+		},
 		{
 			name:              "invalid YAML response - should update and warn",
 			apiResponseYaml:   "invalid: : yaml: that: will: fail",
@@ -107,6 +156,30 @@ spec:
 					"dashboard_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"conflict_strategy": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -119,25 +192,39 @@ spec:
 			}
 
 			// Create the resource with the test client
-			r := &DashboardResource{client: testClient}
+			r := &DashboardResource{client: testClient, ignoredFields: tc.ignoredFields}
 
 			// Create the state object
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":         tftypes.String,
-						"id":             tftypes.String,
-						"dataset":        tftypes.String,
-						"dashboard_yaml": tftypes.String,
-						"url":            tftypes.String,
+						"origin":                 tftypes.String,
+						"id":                     tftypes.String,
+						"dataset":                tftypes.String,
+						"dashboard_yaml":         tftypes.String,
+						"conflict_strategy":      tftypes.String,
+						"name_prefix":            tftypes.String,
+						"name_suffix":            tftypes.String,
+						"labels":                 tftypes.Map{ElementType: tftypes.String},
+						"annotations":            tftypes.Map{ElementType: tftypes.String},
+						"labels_all":             tftypes.Map{ElementType: tftypes.String},
+						"ignore_server_defaults": tftypes.Bool,
+						"url":                    tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-					"id":             tftypes.NewValue(tftypes.String, nil),
-					"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-					"dashboard_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-					"url":            tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
+					"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+					"id":                     tftypes.NewValue(tftypes.String, nil),
+					"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+					"dashboard_yaml":         tftypes.NewValue(tftypes.String, originalYaml),
+					"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+					"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+					"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+					"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, tc.ignoreServerDefaults),
+					"url":                    tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
 				},
 			)
 
@@ -81,11 +81,18 @@ func TestDashboardResource_Create(t *testing.T) {
 	// Setup plan
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":         tftypes.NewValue(tftypes.String, ""),
-			"id":             tftypes.NewValue(tftypes.String, nil),
-			"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-			"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":            tftypes.NewValue(tftypes.String, nil),
+			"origin":                 tftypes.NewValue(tftypes.String, ""),
+			"id":                     tftypes.NewValue(tftypes.String, nil),
+			"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+			"dashboard_yaml":         tftypes.NewValue(tftypes.String, testYaml),
+			"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+			"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, nil),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
@@ -101,6 +108,30 @@ func TestDashboardResource_Create(t *testing.T) {
 				"dashboard_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"conflict_strategy": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"ignore_server_defaults": schema.BoolAttribute{
+					Optional: true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -167,6 +198,30 @@ func TestDashboardResource_Read(t *testing.T) {
 			"dashboard_yaml": schema.StringAttribute{
 				Required: true,
 			},
+			"conflict_strategy": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_suffix": schema.StringAttribute{
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ignore_server_defaults": schema.BoolAttribute{
+				Optional: true,
+			},
 			"url": schema.StringAttribute{
 				Computed: true,
 			},
@@ -178,11 +233,18 @@ func TestDashboardResource_Read(t *testing.T) {
 	// Setup state
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-			"id":             tftypes.NewValue(tftypes.String, nil),
-			"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-			"dashboard_yaml": tftypes.NewValue(tftypes.String, "old yaml"),
-			"url":            tftypes.NewValue(tftypes.String, testURL),
+			"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                     tftypes.NewValue(tftypes.String, nil),
+			"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+			"dashboard_yaml":         tftypes.NewValue(tftypes.String, "old yaml"),
+			"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+			"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: stateSchema,
 	}
@@ -255,11 +317,18 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":            tftypes.NewValue(tftypes.String, testURL),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":         tftypes.NewValue(tftypes.String, testYaml),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -275,6 +344,30 @@ func TestDashboardResource_Update(t *testing.T) {
 					"dashboard_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"conflict_strategy": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -287,11 +380,18 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create plan with updated YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, updatedYaml),
-				"url":            tftypes.NewValue(tftypes.String, testURL),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":         tftypes.NewValue(tftypes.String, updatedYaml),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: state.Schema,
 		}
@@ -331,11 +431,18 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":            tftypes.NewValue(tftypes.String, nil),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":         tftypes.NewValue(tftypes.String, testYaml),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -351,6 +458,30 @@ func TestDashboardResource_Update(t *testing.T) {
 					"dashboard_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"conflict_strategy": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -361,11 +492,18 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create plan with invalid YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
-				"url":            tftypes.NewValue(tftypes.String, nil),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":         tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: state.Schema,
 		}
@@ -758,11 +896,18 @@ func TestDashboardResource_Delete(t *testing.T) {
 	// Create a state with test data
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-			"id":             tftypes.NewValue(tftypes.String, nil),
-			"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-			"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":            tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
+			"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                     tftypes.NewValue(tftypes.String, nil),
+			"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+			"dashboard_yaml":         tftypes.NewValue(tftypes.String, testYaml),
+			"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+			"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
@@ -778,6 +923,30 @@ func TestDashboardResource_Delete(t *testing.T) {
 				"dashboard_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"conflict_strategy": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"ignore_server_defaults": schema.BoolAttribute{
+					Optional: true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &FailedChecksDataSource{}
+	_ datasource.DataSourceWithConfigure = &FailedChecksDataSource{}
+)
+
+// NewFailedChecksDataSource is a helper function to simplify the provider implementation.
+func NewFailedChecksDataSource() datasource.DataSource {
+	return &FailedChecksDataSource{}
+}
+
+// FailedChecksDataSource is the data source implementation.
+type FailedChecksDataSource struct {
+	client client.Client
+}
+
+// failedChecksModel is the Terraform state model for the failed checks data
+// source.
+type failedChecksModel struct {
+	Dataset       types.String `tfsdk:"dataset"`
+	Labels        types.Map    `tfsdk:"labels"`
+	TotalCount    types.Int64  `tfsdk:"total_count"`
+	CriticalCount types.Int64  `tfsdk:"critical_count"`
+	DegradedCount types.Int64  `tfsdk:"degraded_count"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FailedChecksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FailedChecksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failed_checks"
+}
+
+func (d *FailedChecksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Counts the checks in a Dash0 dataset that are currently failing, in other words the alerts that are firing. " +
+			"Use it in a Terraform `check` block to flag a workspace whose monitored services are unhealthy after an apply. " +
+			"The data source is read on every plan and apply, so the counts reflect the state at that moment.",
+
+		Attributes: map[string]schema.Attribute{
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) to query. Provide the dataset's identifier, which is immutable, not the 'name'.",
+				Required:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only count failed checks whose labels include all of these labels with exactly these values, for example `{ service_name = \"checkout\" }`. When omitted, every failed check in the dataset is counted.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "The number of matching failed checks, critical and degraded combined.",
+				Computed:    true,
+			},
+			"critical_count": schema.Int64Attribute{
+				Description: "The number of matching failed checks that breach their critical threshold.",
+				Computed:    true,
+			},
+			"degraded_count": schema.Int64Attribute{
+				Description: "The number of matching failed checks that breach their degraded threshold.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *FailedChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model failedChecksModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := map[string]string{}
+	if !model.Labels.IsNull() {
+		resp.Diagnostics.Append(model.Labels.ElementsAs(ctx, &labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	critical, degraded, err := d.client.CountFailedChecks(ctx, model.Dataset.ValueString(), labels)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read failed checks, got error: %s", err))
+		return
+	}
+
+	model.CriticalCount = types.Int64Value(int64(critical))
+	model.DegradedCount = types.Int64Value(int64(degraded))
+	model.TotalCount = types.Int64Value(int64(critical + degraded))
+
+	tflog.Trace(ctx, "read the failed checks data source")
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// readFailedChecks runs the data source's Read against a config with the given
+// dataset and labels (nil for a null map).
+func readFailedChecks(t *testing.T, d *FailedChecksDataSource, dataset string, labels map[string]string) (*datasource.ReadResponse, failedChecksModel) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	labelsType := tftypes.Map{ElementType: tftypes.String}
+	labelsValue := tftypes.NewValue(labelsType, nil)
+	if labels != nil {
+		values := map[string]tftypes.Value{}
+		for k, v := range labels {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		labelsValue = tftypes.NewValue(labelsType, values)
+	}
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"dataset":        tftypes.NewValue(tftypes.String, dataset),
+		"labels":         labelsValue,
+		"total_count":    tftypes.NewValue(tftypes.Number, nil),
+		"critical_count": tftypes.NewValue(tftypes.Number, nil),
+		"degraded_count": tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}, resp)
+
+	var model failedChecksModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	}
+	return resp, model
+}
+
+func TestFailedChecksDataSource_Read(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("CountFailedChecks", mock.Anything, "production", map[string]string{"service_name": "checkout"}).Return(2, 1, nil)
+
+	resp, model := readFailedChecks(t, &FailedChecksDataSource{client: mockClient}, "production", map[string]string{"service_name": "checkout"})
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.Int64Value(3), model.TotalCount)
+	assert.Equal(t, types.Int64Value(2), model.CriticalCount)
+	assert.Equal(t, types.Int64Value(1), model.DegradedCount)
+	mockClient.AssertExpectations(t)
+}
+
+func TestFailedChecksDataSource_Read_NoLabels(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("CountFailedChecks", mock.Anything, "default", map[string]string{}).Return(0, 0, nil)
+
+	resp, model := readFailedChecks(t, &FailedChecksDataSource{client: mockClient}, "default", nil)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.Int64Value(0), model.TotalCount)
+	mockClient.AssertExpectations(t)
+}
+
+func TestFailedChecksDataSource_Read_Error(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("CountFailedChecks", mock.Anything, "default", map[string]string{}).Return(0, 0, errors.New("dash0 api error: forbidden (status: 403)"))
+
+	resp, _ := readFailedChecks(t, &FailedChecksDataSource{client: mockClient}, "default", nil)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Unable to read failed checks")
+}
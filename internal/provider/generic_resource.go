@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &GenericResource{}
+	_ resource.ResourceWithConfigure      = &GenericResource{}
+	_ resource.ResourceWithImportState    = &GenericResource{}
+	_ resource.ResourceWithValidateConfig = &GenericResource{}
+	_ resource.ResourceWithModifyPlan     = &GenericResource{}
+)
+
+// NewGenericResource is a helper function to simplify the provider implementation.
+func NewGenericResource() resource.Resource {
+	return &GenericResource{}
+}
+
+// GenericResource is the implementation of dash0_resource, which manages
+// assets of kinds that have no dedicated resource yet through the generic
+// asset endpoints of the Dash0 API. Only the kinds listed by
+// client.AssetKinds are supported.
+type GenericResource struct {
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
+}
+
+// genericModel is the Terraform state model for a dash0_resource.
+type genericModel struct {
+	Origin               types.String `tfsdk:"origin"`
+	Kind                 types.String `tfsdk:"kind"`
+	Dataset              types.String `tfsdk:"dataset"`
+	DefinitionYaml       types.String `tfsdk:"definition_yaml"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+	NamePrefix           types.String `tfsdk:"name_prefix"`
+	NameSuffix           types.String `tfsdk:"name_suffix"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model, on top of the provider-level default labels.
+func (m genericModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+		NamePrefix:    m.NamePrefix,
+		NameSuffix:    m.NameSuffix,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *GenericResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_resource")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
+}
+
+func (r *GenericResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+// ValidateConfig checks the kind and the definition at plan time. The
+// definition is only checked to be a YAML mapping that survives the metadata
+// merge and the conversion to JSON, since its format depends on the kind.
+func (r *GenericResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model genericModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !model.Kind.IsNull() && !model.Kind.IsUnknown() && !slices.Contains(client.AssetKinds(), model.Kind.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("kind"),
+			"Invalid kind",
+			fmt.Sprintf("kind must be one of the asset kinds supported by the Dash0 API client (%s); got %q.", strings.Join(client.AssetKinds(), ", "), model.Kind.ValueString()),
+		)
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.DefinitionYaml, definitionCheck{
+		attribute: "definition_yaml",
+		metadata:  &metadata,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the labels the provider merges into the definition, and
+// checks the permissions of the auth token when the permission preflight is
+// enabled.
+func (r *GenericResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_resource", "", &resp.Diagnostics)
+	var plan genericModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.DefinitionYaml, plan.managedMetadata(r.defaultLabels))
+}
+
+func (r *GenericResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Dash0 asset through the generic asset endpoints of the Dash0 API (`/api/{kind}/{origin}`). Use it for asset kinds that have no dedicated resource yet, such as `signal-to-metrics`; the definition is passed to the API as-is, without the kind-specific validation and normalization of the dedicated resources. Prefer the dedicated resource once one exists for the kind.",
+		Attributes: map[string]schema.Attribute{
+			"origin": schema.StringAttribute{
+				Description: "A unique identifier for the asset, automatically generated on creation. Used to reference the asset for updates, reads, deletes, and imports.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: fmt.Sprintf("The kind of asset, as it appears in the path of the Dash0 API, for example `signal-to-metrics` for assets served at `/api/signal-to-metrics`. Only the kinds the Dash0 API client supports can be managed: `%s`. Changing this value forces the resource to be recreated.", strings.Join(client.AssetKinds(), "`, `")),
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the asset belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Leave unset for asset kinds that are not scoped to a dataset. Changing this value forces the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"definition_yaml": schema.StringAttribute{
+				Description: "The asset definition in YAML format, in the shape the Dash0 API expects for the kind. It is converted to JSON and sent to the API unchanged, apart from the provider-managed metadata and the `dash0.com/origin` label. Metadata labels and annotations returned by the API are ignored during drift detection.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					customplanmodifier.YAMLSemanticEqual(),
+				},
+			},
+			"labels":                 labelsAttribute("asset"),
+			"annotations":            annotationsAttribute("asset"),
+			"labels_all":             labelsAllAttribute("asset"),
+			"name_prefix":            nameAffixAttribute("asset", "prepended to"),
+			"name_suffix":            nameAffixAttribute("asset", "appended to"),
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("asset"),
+		},
+	}
+}
+
+// definitionJSON merges the provider-managed metadata into the definition
+// and converts it to the JSON body sent to the API.
+func (m genericModel) definitionJSON(ctx context.Context, defaultLabels map[string]string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var parsed interface{}
+	if err := yaml.Unmarshal([]byte(m.DefinitionYaml.ValueString()), &parsed); err != nil {
+		diags.AddError("Invalid YAML", fmt.Sprintf("Asset definition is not valid YAML: %s", err))
+		return "", diags
+	}
+
+	definition, mergeDiags := mergeManagedMetadata(ctx, m.DefinitionYaml.ValueString(), m.managedMetadata(defaultLabels))
+	diags.Append(mergeDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to convert asset YAML to JSON: %s", err))
+		return "", diags
+	}
+	return jsonBody, diags
+}
+
+func (r *GenericResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model genericModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonBody, diags := model.definitionJSON(ctx, r.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Origin = types.StringValue("tf_" + uuid.New().String())
+	err := r.client.CreateAsset(ctx, model.Kind.ValueString(), model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s asset, got error: %s", model.Kind.ValueString(), err))
+		return
+	}
+
+	model.LabelsAll, diags = labelsAll(ctx, model.DefinitionYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created a generic resource")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state genericModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponseJSON, err := r.client.GetAsset(ctx, state.Kind.ValueString(), state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		if dash0.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("%s asset %s no longer exists on the server; removing from state", state.Kind.ValueString(), state.Origin.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s asset, got error: %s", state.Kind.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a generic resource")
+
+	// Compare the current state with the retrieved asset
+	if state.DefinitionYaml.ValueString() != "" {
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.DefinitionYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		// The annotations a kind supports are unknown, so all of them are
+		// ignored, like the labels.
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseJSON, state.IgnoreServerDefaults), additionalIgnored, nil)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Asset Comparison Error",
+				fmt.Sprintf("Error comparing assets: %s. Using API response as source of truth.", err),
+			)
+			state.DefinitionYaml = types.StringValue(apiResponseJSON)
+		} else if !equivalent {
+			tflog.Debug(ctx, "Asset has changed, updating state")
+			state.DefinitionYaml = types.StringValue(apiResponseJSON)
+		} else {
+			tflog.Debug(ctx, "Asset is equivalent, ignoring changes in metadata fields")
+		}
+	} else {
+		state.DefinitionYaml = types.StringValue(apiResponseJSON)
+	}
+
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GenericResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get current state
+	var state genericModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan genericModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonBody, diags := plan.definitionJSON(ctx, r.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the existing asset (kind and dataset changes force recreation via
+	// RequiresReplace)
+	plan.Origin = state.Origin
+	err := r.client.UpdateAsset(ctx, plan.Kind.ValueString(), plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s asset, got error: %s", plan.Kind.ValueString(), err))
+		return
+	}
+
+	plan.LabelsAll, diags = labelsAll(ctx, plan.DefinitionYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated a generic resource")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GenericResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state genericModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAsset(ctx, state.Kind.ValueString(), state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s asset, got error: %s", state.Kind.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a generic resource")
+}
+
+// ImportState imports an asset by an ID of the form 'kind,dataset,origin', or
+// 'kind,origin' for asset kinds that are not scoped to a dataset.
+func (r *GenericResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	var kind, dataset, origin string
+	switch len(idParts) {
+	case 2:
+		kind, origin = idParts[0], idParts[1]
+	case 3:
+		kind, dataset, origin = idParts[0], idParts[1], idParts[2]
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'kind,dataset,origin' or 'kind,origin'. Got: %s", req.ID),
+		)
+		return
+	}
+
+	apiResponseJSON, err := r.client.GetAsset(ctx, kind, origin, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Asset",
+			fmt.Sprintf("Could not get %s asset with origin=%s, dataset=%s: %s", kind, origin, dataset, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), origin)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kind"), kind)...)
+	if dataset != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset"), dataset)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("definition_yaml"), apiResponseJSON)...)
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+const genericTestYAML = `kind: Dash0SamplingRule
+metadata:
+  name: errors
+spec:
+  conditions:
+    kind: error
+`
+
+// genericTestModel returns the model of a sampling rule managed through
+// dash0_resource.
+func genericTestModel() genericModel {
+	return genericModel{
+		Origin:               types.StringValue("tf_origin"),
+		Kind:                 types.StringValue("sampling-rules"),
+		Dataset:              types.StringValue("default"),
+		DefinitionYaml:       types.StringValue(genericTestYAML),
+		Labels:               types.MapNull(types.StringType),
+		Annotations:          types.MapNull(types.StringType),
+		LabelsAll:            types.MapNull(types.StringType),
+		NamePrefix:           types.StringNull(),
+		NameSuffix:           types.StringNull(),
+		IgnoreServerDefaults: types.BoolNull(),
+	}
+}
+
+// genericTestState returns a state that holds model, using the schema of the
+// resource.
+func genericTestState(t *testing.T, model genericModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewGenericResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, state.Set(ctx, model).HasError())
+	return state
+}
+
+func TestGenericResource_Schema(t *testing.T) {
+	r := NewGenericResource()
+	metadataResp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, metadataResp)
+	assert.Equal(t, "dash0_resource", metadataResp.TypeName)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+	for _, attr := range []string{"origin", "kind", "dataset", "definition_yaml", "labels", "annotations", "name_prefix", "name_suffix", "ignore_server_defaults"} {
+		assert.Contains(t, schemaResp.Schema.Attributes, attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["kind"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["dataset"].IsOptional())
+}
+
+func TestGenericResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		kind        string
+		yaml        string
+		expectError string
+	}{
+		{name: "valid", kind: "sampling-rules", yaml: genericTestYAML},
+		{name: "kind with a path", kind: "alerting/check-rules", yaml: genericTestYAML, expectError: "Invalid kind"},
+		{name: "kind in camel case", kind: "samplingRules", yaml: genericTestYAML, expectError: "Invalid kind"},
+		{name: "kind without library support", kind: "widgets", yaml: genericTestYAML, expectError: "Invalid kind"},
+		{name: "invalid YAML", kind: "sampling-rules", yaml: "kind: [", expectError: "Invalid YAML in definition_yaml"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			model := genericTestModel()
+			model.Origin = types.StringNull()
+			model.Kind = types.StringValue(tc.kind)
+			model.DefinitionYaml = types.StringValue(tc.yaml)
+			state := genericTestState(t, model)
+
+			resp := &resource.ValidateConfigResponse{}
+			(&GenericResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+
+			if tc.expectError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tc.expectError, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
+
+func TestGenericResource_Create(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &GenericResource{client: mockClient}
+
+	model := genericTestModel()
+	model.Origin = types.StringUnknown()
+	model.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")})
+	plan := genericTestState(t, model)
+
+	mockClient.On("CreateAsset", mock.Anything, "sampling-rules", mock.MatchedBy(func(origin string) bool {
+		return strings.HasPrefix(origin, "tf_")
+	}), mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, `"team":"payments"`) && strings.Contains(body, `"kind":"error"`)
+	}), "default").Return(nil)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	var created genericModel
+	resp.State.Get(context.Background(), &created)
+	assert.True(t, strings.HasPrefix(created.Origin.ValueString(), "tf_"))
+	assert.Equal(t, genericTestYAML, created.DefinitionYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestGenericResource_Read(t *testing.T) {
+	tests := []struct {
+		name              string
+		apiResponse       string
+		apiError          error
+		expectYamlUpdated bool
+		expectRemoved     bool
+	}{
+		{
+			name:        "server fields only - no significant diff",
+			apiResponse: `{"kind":"Dash0SamplingRule","metadata":{"name":"errors","labels":{"dash0.com/origin":"tf_origin"},"annotations":{"dash0.com/created-at":"2026-01-01T00:00:00Z"}},"spec":{"conditions":{"kind":"error"}}}`,
+		},
+		{
+			name:              "changed spec - should update state",
+			apiResponse:       `{"kind":"Dash0SamplingRule","metadata":{"name":"errors"},"spec":{"conditions":{"kind":"ottl"}}}`,
+			expectYamlUpdated: true,
+		},
+		{
+			name:          "deleted out of band - should remove from state",
+			apiError:      &dash0.APIError{StatusCode: 404, Status: "404 Not Found"},
+			expectRemoved: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			r := &GenericResource{client: mockClient}
+			mockClient.On("GetAsset", mock.Anything, "sampling-rules", "tf_origin", "default").Return(tc.apiResponse, tc.apiError)
+
+			state := genericTestState(t, genericTestModel())
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tc.expectRemoved {
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+			var result genericModel
+			resp.State.Get(context.Background(), &result)
+			if tc.expectYamlUpdated {
+				assert.Equal(t, tc.apiResponse, result.DefinitionYaml.ValueString())
+			} else {
+				assert.Equal(t, genericTestYAML, result.DefinitionYaml.ValueString())
+			}
+		})
+	}
+}
+
+func TestGenericResource_ImportState(t *testing.T) {
+	tests := []struct {
+		id            string
+		expectDataset types.String
+		expectError   bool
+	}{
+		{id: "sampling-rules,default,tf_origin", expectDataset: types.StringValue("default")},
+		{id: "sampling-rules,tf_origin", expectDataset: types.StringNull()},
+		{id: "tf_origin", expectError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			mockClient := &MockClient{}
+			r := &GenericResource{client: mockClient}
+			mockClient.On("GetAsset", mock.Anything, "sampling-rules", "tf_origin", tc.expectDataset.ValueString()).Return(genericTestYAML, nil)
+
+			state := genericTestState(t, genericTestModel())
+			resp := &resource.ImportStateResponse{State: tfsdk.State{
+				Schema: state.Schema,
+				Raw:    tftypes.NewValue(state.Schema.Type().TerraformType(context.Background()), nil),
+			}}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: tc.id}, resp)
+
+			if tc.expectError {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, "Invalid Import ID", resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var dataset types.String
+			resp.State.GetAttribute(context.Background(), path.Root("dataset"), &dataset)
+			assert.Equal(t, tc.expectDataset, dataset)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
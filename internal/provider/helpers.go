@@ -1,7 +1,19 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 )
 
 // stringOrNull returns a null types.String for an empty input and a value-bearing
@@ -12,3 +24,402 @@ func stringOrNull(s string) types.String {
 	}
 	return types.StringValue(s)
 }
+
+// labelsAttribute returns the schema for the optional provider-managed
+// `labels` map of a YAML-based resource.
+func labelsAttribute(assetName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("Labels merged into `metadata.labels` of the %s definition before it is sent to the API. Values set here take precedence over labels declared in the YAML, and removing a key removes it from the %s on the next apply. Only label keys supported by the Dash0 API for this asset type are retained by the server. A retained label whose value is changed outside Terraform is reported as drift and restored on the next apply.", assetName, assetName),
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// annotationsAttribute returns the schema for the optional provider-managed
+// `annotations` map of a YAML-based resource.
+func annotationsAttribute(assetName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("Annotations merged into `metadata.annotations` of the %s definition before it is sent to the API, for example `dash0.com/sharing` or `dash0.com/folder-path`. Values set here take precedence over annotations declared in the YAML, and removing a key removes it from the %s on the next apply. An annotation whose value is changed outside Terraform is reported as drift and restored on the next apply.", assetName, assetName),
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// ruleLabelsAttribute returns the schema for the optional provider-managed
+// `labels` map of a resource defined by a PrometheusRule document, whose
+// metadata the Dash0 API does not keep.
+func ruleLabelsAttribute(assetName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("Labels merged into the `labels` of every rule of the %[1]s definition before it is sent to the API. The Dash0 API does not keep the `metadata` of a PrometheusRule, so the labels are set on the rules instead, and alerts fired by the %[1]s carry them. Values set here take precedence over labels declared by the rules, and removing a key removes it from the %[1]s on the next apply. Changes made outside Terraform are reported as drift like any other change to the rules.", assetName),
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// ruleAnnotationsAttribute returns the schema for the optional
+// provider-managed `annotations` map of a resource defined by a PrometheusRule
+// document, whose metadata the Dash0 API does not keep.
+func ruleAnnotationsAttribute(assetName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("Annotations merged into the `annotations` of every rule of the %[1]s definition before it is sent to the API, for example `summary` or `dash0-threshold-critical`. The Dash0 API does not keep the `metadata` of a PrometheusRule, so the annotations are set on the rules instead. Values set here take precedence over annotations declared by the rules, and removing a key removes it from the %[1]s on the next apply.", assetName),
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// ruleNameAffixAttribute returns the schema for the optional `name_prefix`
+// or `name_suffix` attribute of a resource defined by a PrometheusRule
+// document, whose rules are named "<group> - <alert>" by the Dash0 API.
+func ruleNameAffixAttribute(assetName, placement, target string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("A string %s %s of the %s definition before it is sent to the API, for example to give copies of the same %s stamped out for preview environments unique names. Dash0 names a %s `<group> - <alert>`, so the affix ends up in that name.", placement, target, assetName, assetName, assetName),
+		Optional:    true,
+	}
+}
+
+// nameAffixAttribute returns the schema for the optional `name_prefix` or
+// `name_suffix` attribute of a YAML-based resource.
+func nameAffixAttribute(assetName, placement string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("A string %s `metadata.name` of the %s definition before it is sent to the API, for example to give copies of the same %s stamped out for preview environments unique names. Requires `metadata.name` to be set in the YAML.", placement, assetName, assetName),
+		Optional:    true,
+	}
+}
+
+// managedMetadata groups the provider-managed metadata attributes that are
+// applied to a resource definition on top of the user-authored YAML.
+type managedMetadata struct {
+	// DefaultLabels holds the `default_labels` provider attribute. Labels of
+	// the resource take precedence over them.
+	DefaultLabels map[string]string
+	Labels        types.Map
+	Annotations   types.Map
+	NamePrefix    types.String
+	NameSuffix    types.String
+	// PrometheusRule applies the default labels, labels and annotations to the
+	// rules of a PrometheusRule document instead of its metadata, and the name
+	// prefix and suffix to its group and alert names, for check rules whose
+	// metadata the Dash0 API does not keep.
+	PrometheusRule bool
+}
+
+// mergeManagedMetadata applies the provider-managed metadata to the given
+// resource YAML: the default labels, then the labels and annotations are
+// merged into the metadata maps, or into the rules of a PrometheusRule, and
+// the name prefix and suffix are applied to metadata.name, or to the group
+// and alert names of a PrometheusRule. Null or unknown values are treated as
+// empty, so the YAML is returned unchanged when none of the attributes is
+// configured.
+func mergeManagedMetadata(ctx context.Context, yamlStr string, m managedMetadata) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	labelValues := map[string]string{}
+	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
+		diags.Append(m.Labels.ElementsAs(ctx, &labelValues, false)...)
+	}
+	annotationValues := map[string]string{}
+	if !m.Annotations.IsNull() && !m.Annotations.IsUnknown() {
+		diags.Append(m.Annotations.ElementsAs(ctx, &annotationValues, false)...)
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var merged string
+	var err error
+	if m.PrometheusRule {
+		merged, err = converter.MergePrometheusRuleDefaultLabels(yamlStr, m.DefaultLabels)
+	} else {
+		merged, err = converter.MergeDefaultLabels(yamlStr, m.DefaultLabels)
+	}
+	if err != nil {
+		diags.AddError("Invalid Metadata", fmt.Sprintf("Unable to merge the default labels into the resource definition: %s", err))
+		return "", diags
+	}
+
+	if m.PrometheusRule {
+		merged, err = converter.MergePrometheusRuleMetadata(merged, labelValues, annotationValues)
+	} else {
+		merged, err = converter.MergeMetadata(merged, labelValues, annotationValues)
+	}
+	if err != nil {
+		diags.AddError("Invalid Metadata", fmt.Sprintf("Unable to merge labels and annotations into the resource definition: %s", err))
+		return "", diags
+	}
+
+	if m.PrometheusRule {
+		merged, err = converter.AffixPrometheusRuleNames(merged, m.NamePrefix.ValueString(), m.NameSuffix.ValueString())
+	} else {
+		merged, err = converter.AffixMetadataName(merged, m.NamePrefix.ValueString(), m.NameSuffix.ValueString())
+	}
+	if err != nil {
+		diags.AddError("Invalid Metadata", fmt.Sprintf("Unable to apply the name prefix and suffix to the resource definition: %s", err))
+		return "", diags
+	}
+	return merged, diags
+}
+
+// refreshManagedMetadata reports changes made outside Terraform to the
+// provider-managed labels and annotations, which the drift detection of the
+// definition ignores: a managed key that the API response holds with a
+// different value is written with that value into the labels, annotations or
+// labels_all in state, so that the next plan restores it. Keys missing from
+// the response are not reported, as the Dash0 API only retains the label
+// keys it supports for each asset type. The rules of a PrometheusRule are
+// compared with the definition, so nothing is refreshed for them.
+func refreshManagedMetadata(ctx context.Context, m managedMetadata, apiResponse string, labels, annotations, labelsAll *types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.PrometheusRule {
+		return diags
+	}
+
+	for _, section := range []struct {
+		name    string
+		managed types.Map
+		state   *types.Map
+	}{
+		{name: "labels", managed: m.Labels, state: labels},
+		{name: "annotations", managed: m.Annotations, state: annotations},
+		{name: "labels", managed: *labelsAll, state: labelsAll},
+	} {
+		if section.managed.IsNull() || section.managed.IsUnknown() {
+			continue
+		}
+		values := map[string]string{}
+		diags.Append(section.managed.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		changed, err := converter.ChangedMetadata(apiResponse, section.name, values)
+		if err != nil {
+			diags.AddWarning("Metadata Comparison Error", fmt.Sprintf("Error comparing the %s managed by the provider: %s.", section.name, err))
+			continue
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		for k, v := range changed {
+			values[k] = v
+		}
+		refreshed, mapDiags := types.MapValueFrom(ctx, types.StringType, values)
+		diags.Append(mapDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		*section.state = refreshed
+	}
+	return diags
+}
+
+// labelsAllAttribute returns the schema for the computed `labels_all` map of
+// a YAML-based resource, which records the labels the provider merges into
+// its definition.
+func labelsAllAttribute(assetName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("The labels the provider merges into `metadata.labels` of the %[1]s definition: the `default_labels` of the provider that the YAML does not declare, combined with the `labels` attribute. A change to `default_labels` changes this attribute, so Terraform plans an update of the %[1]s that applies it. A retained label whose value is changed outside Terraform is reported as drift and restored on the next apply.", assetName),
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// ruleLabelsAllAttribute returns the schema for the computed `labels_all` map
+// of a resource defined by a PrometheusRule document.
+func ruleLabelsAllAttribute(assetName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("The labels the provider merges into the `labels` of every rule of the %[1]s definition: the `default_labels` of the provider combined with the `labels` attribute. Rules that declare a default label themselves keep their value. A change to `default_labels` changes this attribute, so Terraform plans an update of the %[1]s that applies it.", assetName),
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// labelsAll returns the labels that mergeManagedMetadata merges into the
+// metadata of the given resource YAML: the default labels that the YAML does
+// not declare, overlaid by the labels attribute, or a null map when there are
+// none. The rules of a PrometheusRule decide per rule which default labels
+// they keep, so none is left out for them. The result is unknown while the
+// YAML or the labels are.
+func labelsAll(ctx context.Context, yamlStr types.String, m managedMetadata) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if yamlStr.IsUnknown() || !m.known() {
+		return types.MapUnknown(types.StringType), diags
+	}
+
+	var declared map[string]bool
+	if !m.PrometheusRule {
+		declared = converter.MetadataKeys(yamlStr.ValueString(), "labels")
+	}
+	values := map[string]string{}
+	for k, v := range m.DefaultLabels {
+		if !declared[k] {
+			values[k] = v
+		}
+	}
+	if !m.Labels.IsNull() {
+		labels := map[string]string{}
+		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+		for k, v := range labels {
+			values[k] = v
+		}
+	}
+	if len(values) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+	value, mapDiags := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(mapDiags...)
+	return value, diags
+}
+
+// planLabelsAll sets the planned `labels_all` of a resource from the planned
+// definition and managed metadata, so that a change to the default labels of
+// the provider plans an update even though the configuration of the resource
+// is unchanged.
+func planLabelsAll(ctx context.Context, resp *resource.ModifyPlanResponse, yamlStr types.String, m managedMetadata) {
+	value, diags := labelsAll(ctx, yamlStr, m)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), value)...)
+}
+
+// appliedDefaultLabels returns the default labels that were merged into the
+// definition held in state: the recorded `labels_all`, of which the labels
+// attribute takes precedence again when the definition is rebuilt, or the
+// current default labels of the provider when nothing is recorded yet.
+func appliedDefaultLabels(ctx context.Context, recorded types.Map, defaultLabels map[string]string) map[string]string {
+	if recorded.IsNull() || recorded.IsUnknown() {
+		return defaultLabels
+	}
+	values := map[string]string{}
+	if diags := recorded.ElementsAs(ctx, &values, false); diags.HasError() {
+		return defaultLabels
+	}
+	return values
+}
+
+// notificationChannelIDsAttribute returns the schema for the optional
+// `notification_channel_ids` set of a resource whose alerts can be routed to
+// notification channels. location names where the IDs are written into the
+// definition.
+func notificationChannelIDsAttribute(assetName, location string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Description: fmt.Sprintf("The IDs of the notification channels that the %s notifies, typically references to the `id` attribute of `dash0_notification_channel` resources. The provider writes them into %s before the definition is sent to the API, replacing any channels declared in the YAML, so Terraform orders the %s after the channels it references. When omitted, the channels declared in the YAML are used.", assetName, location, assetName),
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// applyNotificationChannelIDs writes the configured notification channel IDs
+// into the resource YAML using set. A null or unknown set returns the YAML
+// unchanged. The IDs are sorted, so the written definition does not depend on
+// the order in which Terraform returns the elements of the set.
+func applyNotificationChannelIDs(ctx context.Context, yamlStr string, ids types.Set, set func(yamlStr string, channelIDs []string) (string, error)) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if ids.IsNull() || ids.IsUnknown() {
+		return yamlStr, diags
+	}
+
+	channelIDs := []string{}
+	diags.Append(ids.ElementsAs(ctx, &channelIDs, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+	slices.Sort(channelIDs)
+
+	updated, err := set(yamlStr, channelIDs)
+	if err != nil {
+		diags.AddError("Invalid Notification Channels", fmt.Sprintf("Unable to apply the notification channel IDs to the resource definition: %s", err))
+		return "", diags
+	}
+	return updated, diags
+}
+
+// ignoreServerDefaultsAttribute returns the schema for the optional
+// `ignore_server_defaults` attribute of a YAML-based resource.
+func ignoreServerDefaultsAttribute(assetName string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("When `true`, fields that the %[1]s has in Dash0 but that the YAML definition does not declare, for example cosmetic settings changed in the web app, are not reported as drift; Terraform only detects changes to the fields it declares. Every update still replaces the whole %[1]s definition, so such fields are reset whenever Terraform applies a change to the %[1]s. Defaults to `false`.", assetName),
+		Optional:    true,
+	}
+}
+
+// comparedResponse returns the API response to compare with stateYAML during
+// drift detection: with ignore_server_defaults enabled, the fields absent from
+// stateYAML are stripped from it. When stripping fails, the response is
+// returned unchanged and the comparison reports the error.
+func comparedResponse(stateYAML, apiResponse string, ignoreServerDefaults types.Bool) string {
+	if !ignoreServerDefaults.ValueBool() {
+		return apiResponse
+	}
+	stripped, err := converter.StripFieldsAbsentFrom(stateYAML, apiResponse)
+	if err != nil {
+		return apiResponse
+	}
+	return stripped
+}
+
+// Values accepted by the `on_destroy` attribute.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyDisable = "disable"
+)
+
+// onDestroyAttribute returns the schema for the optional `on_destroy`
+// attribute of a resource that can be disabled instead of deleted.
+func onDestroyAttribute(assetName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("What happens to the %[1]s when the resource is destroyed. `delete` (the default) deletes the %[1]s. `disable` keeps the %[1]s, including its history, and only disables it, so it can be re-enabled quickly by importing it again.", assetName),
+		Optional:    true,
+		Validators: []validator.String{
+			oneOf(onDestroyDelete, onDestroyDisable),
+		},
+	}
+}
+
+// oneOfValidator rejects string values that are not in the allowed set. Null
+// and unknown values are accepted so that optional attributes can be omitted.
+type oneOfValidator struct {
+	allowed []string
+}
+
+var _ validator.String = oneOfValidator{}
+
+// oneOf returns a validator that accepts only the given string values.
+func oneOf(allowed ...string) validator.String {
+	return oneOfValidator{allowed: allowed}
+}
+
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(quoted(v.allowed), ", "))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if slices.Contains(v.allowed, req.ConfigValue.ValueString()) {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
+
+// quoted wraps each value in double quotes.
+func quoted(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = fmt.Sprintf("%q", v)
+	}
+	return out
+}
@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStringOrNull(t *testing.T) {
@@ -31,3 +34,184 @@ func TestStringOrNull(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeManagedMetadata(t *testing.T) {
+	ctx := context.Background()
+	yamlStr := "metadata:\n  name: checkout\n"
+
+	t.Run("null maps leave the YAML unchanged", func(t *testing.T) {
+		merged, diags := mergeManagedMetadata(ctx, yamlStr, managedMetadata{})
+		require.False(t, diags.HasError())
+		assert.Equal(t, yamlStr, merged)
+	})
+
+	t.Run("labels and annotations are merged into metadata", func(t *testing.T) {
+		labels := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("checkout"),
+		})
+		annotations := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"dash0.com/folder-path": types.StringValue("/checkout"),
+		})
+
+		merged, diags := mergeManagedMetadata(ctx, yamlStr, managedMetadata{Labels: labels, Annotations: annotations})
+		require.False(t, diags.HasError())
+		assert.Contains(t, merged, "team: checkout")
+		assert.Contains(t, merged, "dash0.com/folder-path: /checkout")
+	})
+
+	t.Run("resource labels take precedence over default labels", func(t *testing.T) {
+		labels := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("checkout"),
+		})
+
+		merged, diags := mergeManagedMetadata(ctx, yamlStr, managedMetadata{
+			DefaultLabels: map[string]string{"team": "platform", "tier": "gold"},
+			Labels:        labels,
+		})
+		require.False(t, diags.HasError())
+		assert.Contains(t, merged, "team: checkout")
+		assert.Contains(t, merged, "tier: gold")
+	})
+
+	t.Run("name prefix and suffix are applied to metadata.name", func(t *testing.T) {
+		merged, diags := mergeManagedMetadata(ctx, yamlStr, managedMetadata{
+			NamePrefix: types.StringValue("pr-42-"),
+			NameSuffix: types.StringValue("-preview"),
+		})
+		require.False(t, diags.HasError())
+		assert.Contains(t, merged, "name: pr-42-checkout-preview")
+	})
+
+	t.Run("name prefix without metadata.name yields an error diagnostic", func(t *testing.T) {
+		_, diags := mergeManagedMetadata(ctx, "spec:\n  enabled: true\n", managedMetadata{
+			NamePrefix: types.StringValue("pr-42-"),
+		})
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("labels of a PrometheusRule are merged into its rules", func(t *testing.T) {
+		labels := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("checkout"),
+		})
+
+		merged, diags := mergeManagedMetadata(ctx, "metadata:\n  name: checkout\nspec:\n  groups:\n    - name: availability\n      rules:\n        - alert: CheckoutDown\n          expr: vector(1)\n", managedMetadata{
+			Labels:         labels,
+			PrometheusRule: true,
+		})
+		require.False(t, diags.HasError())
+		assert.Contains(t, merged, "          labels:\n            team: checkout")
+		assert.NotContains(t, merged, "  labels:\n    team: checkout")
+	})
+
+	t.Run("name affixes of a PrometheusRule are applied to its group and alert names", func(t *testing.T) {
+		merged, diags := mergeManagedMetadata(ctx, "metadata:\n  name: checkout\nspec:\n  groups:\n    - name: availability\n      rules:\n        - alert: CheckoutDown\n          expr: vector(1)\n", managedMetadata{
+			NamePrefix:     types.StringValue("pr-42 "),
+			NameSuffix:     types.StringValue(" (preview)"),
+			PrometheusRule: true,
+		})
+		require.False(t, diags.HasError())
+		assert.Contains(t, merged, "name: pr-42 availability")
+		assert.Contains(t, merged, "alert: CheckoutDown (preview)")
+		assert.Contains(t, merged, "name: checkout\n")
+	})
+
+	t.Run("invalid metadata yields an error diagnostic", func(t *testing.T) {
+		labels := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("checkout"),
+		})
+
+		_, diags := mergeManagedMetadata(ctx, "metadata: checkout\n", managedMetadata{Labels: labels})
+		assert.True(t, diags.HasError())
+	})
+}
+
+func TestLabelsAll(t *testing.T) {
+	ctx := context.Background()
+	definition := types.StringValue("metadata:\n  name: checkout\n  labels:\n    tier: silver\n")
+	defaults := map[string]string{"tier": "gold", "dash0.com/owner": "platform"}
+
+	t.Run("default labels the YAML declares are left out and labels take precedence", func(t *testing.T) {
+		value, diags := labelsAll(ctx, definition, managedMetadata{
+			DefaultLabels: defaults,
+			Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"dash0.com/owner": types.StringValue("checkout"),
+			}),
+		})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+			"dash0.com/owner": types.StringValue("checkout"),
+		}), value)
+	})
+
+	t.Run("PrometheusRule kinds keep every default label", func(t *testing.T) {
+		value, diags := labelsAll(ctx, definition, managedMetadata{DefaultLabels: defaults, PrometheusRule: true})
+		require.False(t, diags.HasError(), diags)
+		assert.Len(t, value.Elements(), 2)
+	})
+
+	t.Run("no labels yield a null map", func(t *testing.T) {
+		value, diags := labelsAll(ctx, definition, managedMetadata{Labels: types.MapNull(types.StringType)})
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, value.IsNull())
+	})
+
+	t.Run("unknown labels yield an unknown map", func(t *testing.T) {
+		value, diags := labelsAll(ctx, definition, managedMetadata{DefaultLabels: defaults, Labels: types.MapUnknown(types.StringType)})
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, value.IsUnknown())
+	})
+}
+
+func TestAppliedDefaultLabels(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"tier": "gold"}
+
+	assert.Equal(t, defaults, appliedDefaultLabels(ctx, types.MapNull(types.StringType), defaults), "state written before labels_all existed uses the current defaults")
+	assert.Equal(t, map[string]string{"tier": "silver"}, appliedDefaultLabels(ctx, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"tier": types.StringValue("silver"),
+	}), defaults), "the labels recorded in state are the ones last applied")
+}
+
+func TestRefreshManagedMetadata(t *testing.T) {
+	ctx := context.Background()
+	managed := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("checkout"),
+		"tier": types.StringValue("gold"),
+	})
+
+	t.Run("a label changed outside Terraform is written into state", func(t *testing.T) {
+		labels, annotations, labelsAll := managed, types.MapNull(types.StringType), types.MapNull(types.StringType)
+		diags := refreshManagedMetadata(ctx, managedMetadata{Labels: managed, Annotations: annotations}, `{"metadata":{"labels":{"team":"payments","tier":"gold"}}}`, &labels, &annotations, &labelsAll)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("payments"),
+			"tier": types.StringValue("gold"),
+		}), labels)
+		assert.True(t, annotations.IsNull())
+	})
+
+	t.Run("labels the API does not retain are not reported", func(t *testing.T) {
+		labels, annotations, labelsAll := managed, types.MapNull(types.StringType), types.MapNull(types.StringType)
+		diags := refreshManagedMetadata(ctx, managedMetadata{Labels: managed, Annotations: annotations}, `{"metadata":{"name":"checkout"}}`, &labels, &annotations, &labelsAll)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, managed, labels)
+	})
+
+	t.Run("a default label changed outside Terraform is written into labels_all", func(t *testing.T) {
+		labels, annotations, labelsAll := types.MapNull(types.StringType), types.MapNull(types.StringType), managed
+		diags := refreshManagedMetadata(ctx, managedMetadata{DefaultLabels: map[string]string{"team": "checkout", "tier": "gold"}}, `{"metadata":{"labels":{"team":"checkout","tier":"silver"}}}`, &labels, &annotations, &labelsAll)
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, labels.IsNull())
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("checkout"),
+			"tier": types.StringValue("silver"),
+		}), labelsAll)
+	})
+
+	t.Run("rules of a PrometheusRule are not refreshed", func(t *testing.T) {
+		labels, annotations, labelsAll := managed, types.MapNull(types.StringType), types.MapNull(types.StringType)
+		diags := refreshManagedMetadata(ctx, managedMetadata{Labels: managed, PrometheusRule: true}, `{"metadata":{"labels":{"team":"payments"}}}`, &labels, &annotations, &labelsAll)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, managed, labels)
+	})
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &MemberResource{}
+	_ resource.ResourceWithConfigure   = &MemberResource{}
+	_ resource.ResourceWithImportState = &MemberResource{}
+)
+
+// NewMemberResource is a helper function to simplify the provider implementation.
+func NewMemberResource() resource.Resource {
+	return &MemberResource{}
+}
+
+// MemberResource is the resource implementation of dash0_member. Members are
+// identified by their email address: the internal id only exists once the
+// invited person shows up in the member list.
+type MemberResource struct {
+	client client.Client
+}
+
+// memberModel is the Terraform state model for a member resource.
+type memberModel struct {
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+	ID     types.String `tfsdk:"id"`
+	Joined types.Bool   `tfsdk:"joined"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member"
+}
+
+func (r *MemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a member of the Dash0 organization. Creating the resource invites the person by email; the member appears in the organization once the invitation is accepted. " +
+			"While the invitation is pending, `id` is unknown to the API and stays empty, and the resource is kept in state without reporting drift. " +
+			"Once the member has joined, removing them in the Dash0 app removes the resource from state on the next refresh.\n\n" +
+			"The Dash0 API neither reports nor changes the role of a member, and cannot revoke a pending invitation. " +
+			"Changing `role` therefore only updates the state, and destroying the resource of a member who has not joined yet leaves the invitation in place.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "The email address the invitation is sent to. Compared case-insensitively with the email addresses of the organization's members. Changing this value forces the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role the member is invited with, for example `admin` or `basic_member`. Only used for the invitation: the Dash0 API cannot change the role of an existing member, so changes are stored in state without effect, and imported members have no role until one is configured.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The internal Dash0 id of the member (the `dash0.com/id` label), for example to reference the member in `spec.members` of a `dash0_team`. Empty while the member is not listed by the API, for example while the invitation is pending.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"joined": schema.BoolAttribute{
+				Description: "Whether the member has accepted the invitation and joined the organization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// refresh populates the id and joined attributes from the member list. It
+// returns false when the member is not listed.
+func (r *MemberResource) refresh(ctx context.Context, model *memberModel) (bool, error) {
+	members, err := r.client.ListMembers(ctx)
+	if err != nil {
+		return false, err
+	}
+	member, ok := client.FindMember(members, model.Email.ValueString())
+	if !ok {
+		model.Joined = types.BoolValue(false)
+		return false, nil
+	}
+	model.ID = stringOrNull(member.ID)
+	model.Joined = types.BoolValue(member.Joined)
+	return true, nil
+}
+
+func (r *MemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan memberModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.ListMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list members, got error: %s", err))
+		return
+	}
+	if member, ok := client.FindMember(members, plan.Email.ValueString()); ok && member.Joined {
+		resp.Diagnostics.AddError(
+			"Member Already Exists",
+			fmt.Sprintf("%s is already a member of the organization. Import the member with `terraform import` instead of creating it.", plan.Email.ValueString()),
+		)
+		return
+	}
+
+	if err := r.client.CreateMember(ctx, plan.Email.ValueString(), plan.Role.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite member, got error: %s", err))
+		return
+	}
+
+	// The invitation was sent, so a failure to read the member list only
+	// leaves the id empty until the next refresh.
+	plan.ID = types.StringNull()
+	if _, err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to resolve member metadata",
+			fmt.Sprintf("The invitation was sent successfully, but the member's id could not be determined: %s", err),
+		)
+		plan.Joined = types.BoolValue(false)
+	}
+
+	tflog.Trace(ctx, "created a member resource")
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state memberModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wasListed := !state.ID.IsNull() && state.ID.ValueString() != ""
+	listed, err := r.refresh(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list members, got error: %s", err))
+		return
+	}
+	if !listed && wasListed {
+		// A member that was listed before is no longer part of the
+		// organization. A member that was never listed may still have a
+		// pending invitation, which the API does not report.
+		tflog.Debug(ctx, fmt.Sprintf("Member %s no longer exists on the server; removing from state", state.Email.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read a member resource")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only stores the new role: the Dash0 API cannot change the role of a
+// member or of a pending invitation.
+func (r *MemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state memberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Role.IsNull() && plan.Role.ValueString() != state.Role.ValueString() {
+		resp.Diagnostics.AddWarning(
+			"Member Role Not Changed",
+			fmt.Sprintf("The Dash0 API cannot change the role of %s. The new role is stored in state only; change the role in the Dash0 app.", plan.Email.ValueString()),
+		)
+	}
+	plan.ID = state.ID
+	plan.Joined = state.Joined
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state memberModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look the member up again: an invitation that was pending at the last
+	// refresh may have been accepted since.
+	if _, err := r.refresh(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list members, got error: %s", err))
+		return
+	}
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Pending Invitation Not Revoked",
+			fmt.Sprintf("%s is not a member of the organization, so there is nothing to remove. If the invitation is still pending, revoke it in the Dash0 app; the Dash0 API cannot revoke invitations.", state.Email.ValueString()),
+		)
+		return
+	}
+
+	if err := r.client.DeleteMember(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete member, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a member resource")
+}
+
+// ImportState imports a member by email address. The role cannot be read
+// from the API and is left empty until it is configured.
+func (r *MemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	model := memberModel{
+		Email: types.StringValue(req.ID),
+		Role:  types.StringNull(),
+		ID:    types.StringNull(),
+	}
+	listed, err := r.refresh(ctx, &model)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Member",
+			fmt.Sprintf("Could not list members: %s", err),
+		)
+		return
+	}
+	if !listed {
+		resp.Diagnostics.AddError(
+			"Error Importing Member",
+			fmt.Sprintf("No member with email address %s exists in the organization.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), model.Email)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), model.Role)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("joined"), model.Joined)...)
+}
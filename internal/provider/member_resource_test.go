@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+func memberState(t *testing.T, r *MemberResource, model memberModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func TestMemberResource_Create(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &MemberResource{client: mockClient}
+
+	mockClient.On("ListMembers", ctx).Return([]client.Member{}, nil).Once()
+	mockClient.On("CreateMember", ctx, "carol@example.com", "basic_member").Return(nil)
+	mockClient.On("ListMembers", ctx).Return([]client.Member{{ID: "user_carol", Email: "Carol@example.com"}}, nil).Once()
+
+	plan := memberState(t, r, memberModel{
+		Email:  types.StringValue("carol@example.com"),
+		Role:   types.StringValue("basic_member"),
+		ID:     types.StringUnknown(),
+		Joined: types.BoolUnknown(),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model memberModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "user_carol", model.ID.ValueString())
+	assert.False(t, model.Joined.ValueBool())
+	mockClient.AssertExpectations(t)
+}
+
+// TestMemberResource_RoleChangeKeepsComputedAttributes checks that the
+// computed id and joined attributes keep their state values when an update,
+// such as a change of role, marks them unknown in the plan.
+func TestMemberResource_RoleChangeKeepsComputedAttributes(t *testing.T) {
+	ctx := context.Background()
+	r := &MemberResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := memberState(t, r, memberModel{
+		Email:  types.StringValue("carol@example.com"),
+		Role:   types.StringValue("basic_member"),
+		ID:     types.StringValue("user_carol"),
+		Joined: types.BoolValue(true),
+	})
+
+	idAttr, ok := schemaResp.Schema.Attributes["id"].(schema.StringAttribute)
+	require.True(t, ok)
+	idReq := planmodifier.StringRequest{
+		State:       state,
+		ConfigValue: types.StringNull(),
+		StateValue:  types.StringValue("user_carol"),
+		PlanValue:   types.StringUnknown(),
+	}
+	idResp := &planmodifier.StringResponse{PlanValue: idReq.PlanValue}
+	for _, modifier := range idAttr.PlanModifiers {
+		modifier.PlanModifyString(ctx, idReq, idResp)
+	}
+	assert.Equal(t, types.StringValue("user_carol"), idResp.PlanValue, "id must not be planned as changed")
+
+	joinedAttr, ok := schemaResp.Schema.Attributes["joined"].(schema.BoolAttribute)
+	require.True(t, ok)
+	joinedReq := planmodifier.BoolRequest{
+		State:       state,
+		ConfigValue: types.BoolNull(),
+		StateValue:  types.BoolValue(true),
+		PlanValue:   types.BoolUnknown(),
+	}
+	joinedResp := &planmodifier.BoolResponse{PlanValue: joinedReq.PlanValue}
+	for _, modifier := range joinedAttr.PlanModifiers {
+		modifier.PlanModifyBool(ctx, joinedReq, joinedResp)
+	}
+	assert.Equal(t, types.BoolValue(true), joinedResp.PlanValue, "joined must not be planned as changed")
+}
+
+func TestMemberResource_CreateExistingMember(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &MemberResource{client: mockClient}
+	mockClient.On("ListMembers", ctx).Return([]client.Member{{ID: "user_alice", Email: "alice@example.com", Joined: true}}, nil)
+
+	plan := memberState(t, r, memberModel{
+		Email:  types.StringValue("alice@example.com"),
+		Role:   types.StringValue("admin"),
+		ID:     types.StringUnknown(),
+		Joined: types.BoolUnknown(),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Member Already Exists", resp.Diagnostics.Errors()[0].Summary())
+	mockClient.AssertNotCalled(t, "CreateMember")
+}
+
+func TestMemberResource_Read(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		stateID      types.String
+		members      []client.Member
+		expectGone   bool
+		expectID     string
+		expectJoined bool
+	}{
+		{name: "pending invitation is kept", stateID: types.StringNull(), members: []client.Member{}},
+		{name: "accepted invitation", stateID: types.StringNull(), members: []client.Member{{ID: "user_carol", Email: "carol@example.com", Joined: true}}, expectID: "user_carol", expectJoined: true},
+		{name: "removed member", stateID: types.StringValue("user_carol"), members: []client.Member{}, expectGone: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			r := &MemberResource{client: mockClient}
+			mockClient.On("ListMembers", ctx).Return(tc.members, nil)
+
+			state := memberState(t, r, memberModel{
+				Email:  types.StringValue("carol@example.com"),
+				Role:   types.StringValue("basic_member"),
+				ID:     tc.stateID,
+				Joined: types.BoolValue(false),
+			})
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			if tc.expectGone {
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+			var model memberModel
+			resp.State.Get(ctx, &model)
+			assert.Equal(t, tc.expectID, model.ID.ValueString())
+			assert.Equal(t, tc.expectJoined, model.Joined.ValueBool())
+			assert.Equal(t, "basic_member", model.Role.ValueString())
+		})
+	}
+}
+
+func TestMemberResource_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &MemberResource{client: mockClient}
+		mockClient.On("ListMembers", ctx).Return([]client.Member{{ID: "user_carol", Email: "carol@example.com", Joined: true}}, nil)
+		mockClient.On("DeleteMember", ctx, "user_carol").Return(nil)
+
+		state := memberState(t, r, memberModel{Email: types.StringValue("carol@example.com"), Role: types.StringValue("basic_member"), ID: types.StringNull(), Joined: types.BoolValue(false)})
+		resp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("pending invitation", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &MemberResource{client: mockClient}
+		mockClient.On("ListMembers", ctx).Return([]client.Member{}, nil)
+
+		state := memberState(t, r, memberModel{Email: types.StringValue("carol@example.com"), Role: types.StringValue("basic_member"), ID: types.StringNull(), Joined: types.BoolValue(false)})
+		resp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+		mockClient.AssertNotCalled(t, "DeleteMember")
+	})
+}
+
+func TestMemberResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &MemberResource{client: mockClient}
+	mockClient.On("ListMembers", ctx).Return([]client.Member{{ID: "user_alice", Email: "Alice@example.com", Joined: true}}, nil)
+
+	state := memberState(t, r, memberModel{})
+	resp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "alice@example.com"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var id types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	assert.Equal(t, "user_alice", id.ValueString())
+
+	resp = resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "carol@example.com"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
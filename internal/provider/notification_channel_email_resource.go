@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// emailNotificationChannel describes dash0_notification_channel_email, which
+// sends notifications by email. It manages channels of type email_v2, which
+// supersedes the single-recipient email type.
+var emailNotificationChannel = typedNotificationChannel{
+	typeName:    "email",
+	channelType: "email_v2",
+	description: "Manages a Dash0 notification channel that sends notifications by email to a list of recipients. " +
+		"Use `dash0_notification_channel` for channels defined in YAML.",
+	fields: []channelConfigField{
+		{
+			attribute:      "recipients",
+			key:            "recipients",
+			description:    "The email addresses that notifications are sent to, for example `oncall@example.com`. Each address is validated at plan time.",
+			required:       true,
+			kind:           channelFieldList,
+			listValidators: []validator.List{emailAddresses{}},
+		},
+		{
+			attribute:   "plaintext",
+			key:         "plaintext",
+			description: "Whether to send plain-text emails instead of HTML emails. When omitted, the value is not compared for drift.",
+			kind:        channelFieldBool,
+		},
+	},
+}
+
+// NewEmailNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewEmailNotificationChannelResource() resource.Resource {
+	return &TypedNotificationChannelResource{channel: emailNotificationChannel}
+}
+
+// emailAddresses rejects lists that are empty or hold a value that is not a
+// plain email address, such as "Alice <alice@example.com>". Unknown elements
+// are accepted.
+type emailAddresses struct{}
+
+var _ validator.List = emailAddresses{}
+
+func (v emailAddresses) Description(_ context.Context) string {
+	return "value must be a non-empty list of email addresses"
+}
+
+func (v emailAddresses) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailAddresses) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	elements := req.ConfigValue.Elements()
+	if len(elements) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s must hold at least one email address.", req.Path),
+		)
+		return
+	}
+	for i, element := range elements {
+		s, ok := element.(types.String)
+		if !ok || s.IsUnknown() {
+			continue
+		}
+		address, err := mail.ParseAddress(s.ValueString())
+		if err == nil && address.Address == s.ValueString() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtListIndex(i),
+			"Invalid Email Address",
+			fmt.Sprintf("Attribute %s must be an email address such as \"oncall@example.com\", got: %q", req.Path.AtListIndex(i), s.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// msTeamsNotificationChannel describes dash0_notification_channel_msteams,
+// which posts notifications to a Microsoft Teams channel through an incoming
+// webhook.
+var msTeamsNotificationChannel = typedNotificationChannel{
+	typeName:    "msteams",
+	channelType: "teams_webhook",
+	description: "Manages a Dash0 notification channel that posts notifications to a Microsoft Teams channel through an incoming webhook. " +
+		"Use `dash0_notification_channel` for channels defined in YAML.",
+	fields: []channelConfigField{
+		{
+			attribute:   "webhook_url",
+			key:         "url",
+			description: "The URL of the Teams incoming webhook, for example `https://example.webhook.office.com/webhookb2/...`. The URL is treated as sensitive, since anyone who knows it can post to the channel.",
+			required:    true,
+			sensitive:   true,
+		},
+	},
+}
+
+// NewMSTeamsNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewMSTeamsNotificationChannelResource() resource.Resource {
+	return &TypedNotificationChannelResource{channel: msTeamsNotificationChannel}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// opsgenieNotificationChannel describes dash0_notification_channel_opsgenie,
+// which creates Opsgenie alerts.
+var opsgenieNotificationChannel = typedNotificationChannel{
+	typeName:    "opsgenie",
+	channelType: "opsgenie",
+	description: "Manages a Dash0 notification channel that creates Opsgenie alerts. " +
+		"The Opsgenie API key can be set as a sensitive attribute or, with Terraform 1.11 or later, as a write-only attribute that is never stored in the plan or state. " +
+		"The Dash0 API does not support mapping check severities to Opsgenie priorities. " +
+		"Use `dash0_notification_channel` for channels defined in YAML.",
+	fields: []channelConfigField{
+		{
+			attribute:   "api_key",
+			key:         "apiKey",
+			description: "The API key of the Opsgenie integration that receives the alerts. Exactly one of `api_key` and `api_key_wo` must be set.",
+			sensitive:   true,
+		},
+		{
+			attribute:   "api_key_wo",
+			key:         "apiKey",
+			description: "The API key of the Opsgenie integration, as a write-only attribute: the value can come from an ephemeral resource and is never stored in the plan or state. Because Terraform cannot detect changes to write-only values, increment `api_key_wo_version` to send a changed key. Requires Terraform 1.11 or later.",
+			writeOnly:   true,
+		},
+		{
+			attribute:    "region",
+			key:          "instance",
+			description:  "The Opsgenie region of the account, `us` or `eu`. When omitted, `us` is used.",
+			defaultValue: "us",
+			validators:   []validator.String{oneOf("us", "eu")},
+		},
+	},
+}
+
+// NewOpsgenieNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewOpsgenieNotificationChannelResource() resource.Resource {
+	return &TypedNotificationChannelResource{channel: opsgenieNotificationChannel}
+}
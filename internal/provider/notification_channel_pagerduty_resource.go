@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// pagerDutyEventsURL is the PagerDuty Events API v2 endpoint that PagerDuty
+// channels send to unless another URL is configured.
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDutyNotificationChannel describes dash0_notification_channel_pagerduty,
+// which triggers PagerDuty incidents through the Events API v2.
+var pagerDutyNotificationChannel = typedNotificationChannel{
+	typeName:    "pagerduty",
+	channelType: "pagerduty",
+	description: "Manages a Dash0 notification channel that triggers PagerDuty incidents through the Events API v2. " +
+		"Use `dash0_notification_channel` for channels defined in YAML.",
+	fields: []channelConfigField{
+		{
+			attribute:   "integration_key",
+			key:         "key",
+			description: "The integration key, also called routing key, of the PagerDuty service or event orchestration that receives the events.",
+			required:    true,
+			sensitive:   true,
+		},
+		{
+			attribute:    "events_url",
+			key:          "url",
+			description:  "The URL of the PagerDuty Events API. When omitted, `" + pagerDutyEventsURL + "` is used; set it for PagerDuty accounts in the EU service region, for example `https://events.eu.pagerduty.com/v2/enqueue`.",
+			defaultValue: pagerDutyEventsURL,
+		},
+	},
+}
+
+// NewPagerDutyNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewPagerDutyNotificationChannelResource() resource.Resource {
+	return &TypedNotificationChannelResource{channel: pagerDutyNotificationChannel}
+}
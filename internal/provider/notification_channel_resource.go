@@ -80,6 +80,7 @@ var (
 	_ resource.ResourceWithConfigure      = &NotificationChannelResource{}
 	_ resource.ResourceWithImportState    = &NotificationChannelResource{}
 	_ resource.ResourceWithValidateConfig = &NotificationChannelResource{}
+	_ resource.ResourceWithModifyPlan     = &NotificationChannelResource{}
 )
 
 // NewNotificationChannelResource is a helper function to simplify the provider implementation.
@@ -89,7 +90,9 @@ func NewNotificationChannelResource() resource.Resource {
 
 // NotificationChannelResource is the resource implementation.
 type NotificationChannelResource struct {
-	client client.Client
+	client        client.Client
+	ignoredFields []string
+	defaultLabels map[string]string
 }
 
 // notificationChannelModel is the Terraform state model for a notification channel resource.
@@ -97,9 +100,22 @@ type notificationChannelModel struct {
 	Origin                  types.String `tfsdk:"origin"`
 	ID                      types.String `tfsdk:"id"`
 	NotificationChannelYaml types.String `tfsdk:"notification_channel_yaml"`
+	Labels                  types.Map    `tfsdk:"labels"`
+	Annotations             types.Map    `tfsdk:"annotations"`
+	LabelsAll               types.Map    `tfsdk:"labels_all"`
 	URL                     types.String `tfsdk:"url"`
 }
 
+// managedMetadata returns the provider-managed metadata attributes of the
+// model.
+func (m notificationChannelModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *NotificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -116,15 +132,20 @@ func (r *NotificationChannelResource) Configure(_ context.Context, req resource.
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_notification_channel")
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
 }
 
 func (r *NotificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_notification_channel"
 }
 
-// ValidateConfig surfaces warnings about config that the Dash0 API will not
-// honor. Currently this is limited to spec.routing.assets, which is discarded
-// on write and reflects only server-maintained back-references on read.
+// ValidateConfig checks the notification channel definition at plan time, so
+// that a definition the API client would reject fails the plan rather than
+// the apply, and surfaces warnings about config that the Dash0 API will not
+// honor. Currently the latter is limited to spec.routing.assets, which is
+// discarded on write and reflects only server-maintained back-references on
+// read.
 func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var model notificationChannelModel
 	diags := req.Config.Get(ctx, &model)
@@ -135,9 +156,31 @@ func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req re
 	if model.NotificationChannelYaml.IsNull() || model.NotificationChannelYaml.IsUnknown() {
 		return
 	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.NotificationChannelYaml, definitionCheck{
+		attribute:    "notification_channel_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateNotificationChannel,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	warnIfRoutingAssetsSet(model.NotificationChannelYaml.ValueString(), &resp.Diagnostics)
 }
 
+// ModifyPlan plans the labels the provider merges into the definition.
+func (r *NotificationChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan notificationChannelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.NotificationChannelYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Dash0 Notification Channel. Notification channels define how alerts are delivered to " +
@@ -179,6 +222,9 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 					customplanmodifier.YAMLSemanticEqualWith(notificationChannelAlwaysIgnoredFields),
 				},
 			},
+			"labels":      labelsAttribute("notification channel"),
+			"annotations": annotationsAttribute("notification channel"),
+			"labels_all":  labelsAllAttribute("notification channel"),
 			"url": schema.StringAttribute{
 				Description: "The URL to open this notification channel in the Dash0 web app, derived from the Dash0 API URL and the channel's server-assigned identifier. Computed by the provider after creation. May be empty if the app URL cannot be derived (e.g. for self-hosted deployments with a custom web app domain).",
 				Computed:    true,
@@ -230,8 +276,15 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, model.NotificationChannelYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.NotificationChannelYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert notification channel YAML to JSON: %s", err))
 		return
@@ -246,6 +299,9 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 	// Resolve the id and web app URL for the newly created channel (best-effort).
 	r.resolveNotificationChannel(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.NotificationChannelYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a notification channel resource")
 
 	// Set state to fully populated data
@@ -272,9 +328,18 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 
 	// Compare the current state with the retrieved notification channel
 	if state.NotificationChannelYaml.ValueString() != "" {
-		stateYAML := state.NotificationChannelYaml.ValueString()
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.NotificationChannelYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, notificationChannelConditionallyIgnoredFields)
 		additionalIgnored = append(additionalIgnored, notificationChannelAlwaysIgnoredFields...)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
 		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
 		if err != nil {
 			resp.Diagnostics.AddWarning(
@@ -292,6 +357,10 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 		state.NotificationChannelYaml = types.StringValue(apiResponseJSON)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -325,8 +394,15 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, plan.NotificationChannelYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.NotificationChannelYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert notification channel YAML to JSON: %s", err))
 		return
@@ -345,6 +421,9 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.NotificationChannelYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a notification channel resource")
 
 	// Set state to fully populated data
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -114,6 +115,18 @@ spec:
 					"notification_channel_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -132,6 +145,9 @@ spec:
 						"origin":                    tftypes.String,
 						"id":                        tftypes.String,
 						"notification_channel_yaml": tftypes.String,
+						"labels":                    tftypes.Map{ElementType: tftypes.String},
+						"annotations":               tftypes.Map{ElementType: tftypes.String},
+						"labels_all":                tftypes.Map{ElementType: tftypes.String},
 						"url":                       tftypes.String,
 					},
 				},
@@ -139,6 +155,9 @@ spec:
 					"origin":                    tftypes.NewValue(tftypes.String, testOrigin),
 					"id":                        tftypes.NewValue(tftypes.String, nil),
 					"notification_channel_yaml": tftypes.NewValue(tftypes.String, originalYaml),
+					"labels":                    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":                tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"url":                       tftypes.NewValue(tftypes.String, nil),
 				},
 			)
@@ -228,6 +247,9 @@ spec:
 			"origin":                    schema.StringAttribute{Computed: true},
 			"id":                        schema.StringAttribute{Computed: true},
 			"notification_channel_yaml": schema.StringAttribute{Required: true},
+			"labels":                    schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations":               schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":                schema.MapAttribute{ElementType: types.StringType, Computed: true},
 			"url":                       schema.StringAttribute{Computed: true},
 		},
 	}
@@ -241,6 +263,9 @@ spec:
 				"origin":                    tftypes.String,
 				"id":                        tftypes.String,
 				"notification_channel_yaml": tftypes.String,
+				"labels":                    tftypes.Map{ElementType: tftypes.String},
+				"annotations":               tftypes.Map{ElementType: tftypes.String},
+				"labels_all":                tftypes.Map{ElementType: tftypes.String},
 				"url":                       tftypes.String,
 			},
 		},
@@ -248,6 +273,9 @@ spec:
 			"origin":                    tftypes.NewValue(tftypes.String, testOrigin),
 			"id":                        tftypes.NewValue(tftypes.String, nil),
 			"notification_channel_yaml": tftypes.NewValue(tftypes.String, stateYaml),
+			"labels":                    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":                tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 			"url":                       tftypes.NewValue(tftypes.String, nil),
 		},
 	)
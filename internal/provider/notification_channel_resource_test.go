@@ -143,6 +143,9 @@ func TestNotificationChannelResource_Create_InvalidYAML(t *testing.T) {
 					"origin":                    tftypes.String,
 					"id":                        tftypes.String,
 					"notification_channel_yaml": tftypes.String,
+					"labels":                    tftypes.Map{ElementType: tftypes.String},
+					"annotations":               tftypes.Map{ElementType: tftypes.String},
+					"labels_all":                tftypes.Map{ElementType: tftypes.String},
 					"url":                       tftypes.String,
 				},
 			},
@@ -150,6 +153,9 @@ func TestNotificationChannelResource_Create_InvalidYAML(t *testing.T) {
 				"origin":                    tftypes.NewValue(tftypes.String, "test-origin"),
 				"id":                        tftypes.NewValue(tftypes.String, nil),
 				"notification_channel_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+				"labels":                    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":                tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				"url":                       tftypes.NewValue(tftypes.String, nil),
 			},
 		),
@@ -164,6 +170,18 @@ func TestNotificationChannelResource_Create_InvalidYAML(t *testing.T) {
 				"notification_channel_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -197,6 +215,9 @@ func TestNotificationChannelResource_ReadError(t *testing.T) {
 					"origin":                    tftypes.String,
 					"id":                        tftypes.String,
 					"notification_channel_yaml": tftypes.String,
+					"labels":                    tftypes.Map{ElementType: tftypes.String},
+					"annotations":               tftypes.Map{ElementType: tftypes.String},
+					"labels_all":                tftypes.Map{ElementType: tftypes.String},
 					"url":                       tftypes.String,
 				},
 			},
@@ -204,6 +225,9 @@ func TestNotificationChannelResource_ReadError(t *testing.T) {
 				"origin":                    tftypes.NewValue(tftypes.String, "test-origin"),
 				"id":                        tftypes.NewValue(tftypes.String, nil),
 				"notification_channel_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
+				"labels":                    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":                tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				"url":                       tftypes.NewValue(tftypes.String, nil),
 			},
 		),
@@ -218,6 +242,18 @@ func TestNotificationChannelResource_ReadError(t *testing.T) {
 				"notification_channel_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// slackNotificationChannel describes dash0_notification_channel_slack, which
+// delivers notifications through a Slack incoming webhook.
+var slackNotificationChannel = typedNotificationChannel{
+	typeName:    "slack",
+	channelType: "slack",
+	description: "Manages a Dash0 notification channel that posts notifications to Slack through an incoming webhook. " +
+		"Use `dash0_notification_channel` for channels defined in YAML.",
+	fields: []channelConfigField{
+		{
+			attribute:   "webhook_url",
+			key:         "webhookURL",
+			description: "The URL of the Slack incoming webhook, for example `https://hooks.slack.com/services/...`.",
+			required:    true,
+			sensitive:   true,
+		},
+		{
+			attribute:   "channel",
+			key:         "channel",
+			description: "The Slack channel to post to, for example `#alerts`. When omitted, the webhook's default channel is used.",
+		},
+	},
+}
+
+// NewSlackNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewSlackNotificationChannelResource() resource.Resource {
+	return &TypedNotificationChannelResource{channel: slackNotificationChannel}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// webhookNotificationChannel describes dash0_notification_channel_webhook,
+// which posts notifications to an arbitrary HTTP endpoint.
+var webhookNotificationChannel = typedNotificationChannel{
+	typeName:    "webhook",
+	channelType: "webhook",
+	description: "Manages a Dash0 notification channel that sends notifications to an HTTP endpoint in the Dash0 webhook payload format. " +
+		"Use it to route failed checks to internal systems that Dash0 has no built-in integration for. " +
+		"The Dash0 API does not support configuring the HTTP method or a custom payload template. " +
+		"Use `dash0_notification_channel` for channels defined in YAML.",
+	fields: []channelConfigField{
+		{
+			attribute:   "url",
+			key:         "url",
+			description: "The URL that notifications are sent to.",
+			required:    true,
+		},
+		{
+			attribute:   "headers",
+			key:         "headers",
+			description: "HTTP headers added to every request, for example an `Authorization` header that the endpoint expects. The headers are treated as sensitive, since they typically carry credentials.",
+			sensitive:   true,
+			kind:        channelFieldMap,
+		},
+		{
+			attribute:   "allow_insecure",
+			key:         "allowInsecure",
+			description: "Whether to accept TLS certificates that cannot be verified, for example self-signed certificates of internal endpoints. When omitted, the value is not compared for drift.",
+			kind:        channelFieldBool,
+		},
+		{
+			attribute:   "follow_redirects",
+			key:         "followRedirects",
+			description: "Whether to follow HTTP redirects returned by the endpoint. When omitted, the value is not compared for drift.",
+			kind:        channelFieldBool,
+		},
+	},
+}
+
+// NewWebhookNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewWebhookNotificationChannelResource() resource.Resource {
+	return &TypedNotificationChannelResource{channel: webhookNotificationChannel}
+}
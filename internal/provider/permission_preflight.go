@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Dataset-level actions checked by the permission preflight. Every resource
+// that lives in a dataset needs datasetReadAction; the others are needed to
+// create assets of the corresponding kind.
+var (
+	datasetReadAction                 = string(dash0.DatasetRead)
+	datasetCreateCheckRuleAction      = string(dash0.DatasetCreateCheckRule)
+	datasetCreateSyntheticCheckAction = string(dash0.DatasetCreateSyntheticCheck)
+	datasetCreateRecordingRuleAction  = string(dash0.DatasetCreateRecordingRuleGroup)
+	datasetCreateSLOAction            = string(dash0.DatasetCreateSLO)
+)
+
+// checkedActions lists the actions checked by the permission preflight, in
+// the order they are reported, along with what needs them.
+var checkedActions = []struct {
+	action   string
+	neededBy string
+}{
+	{datasetReadAction, "every resource in the dataset"},
+	{datasetCreateCheckRuleAction, "check rules and Prometheus rule groups that are created"},
+	{datasetCreateSyntheticCheckAction, "synthetic checks that are created"},
+	{datasetCreateRecordingRuleAction, "recording rules and Prometheus rule groups with recording rules that are created"},
+	{datasetCreateSLOAction, "SLOs that are created"},
+}
+
+// permissionPreflight compares, at plan time, the dataset-level actions the
+// planned resources need with the actions the auth token is permitted to
+// perform, so that a token lacking a permission fails the plan instead of an
+// apply that stops with 403 Forbidden after some assets have been written.
+//
+// The permitted actions are read once per provider process, on the first
+// check. A dataset is reported once, by the first resource that needs an
+// action missing in it, with a single error that lists every checked action
+// the token lacks in the dataset, so the plan holds one error per dataset
+// however many resources are affected. A nil preflight checks nothing.
+type permissionPreflight struct {
+	client client.Client
+
+	once      sync.Once
+	permitted map[string][]string
+	err       error
+
+	mu       sync.Mutex
+	reported map[string]bool
+	warned   bool
+}
+
+func newPermissionPreflight(c client.Client) *permissionPreflight {
+	return &permissionPreflight{client: c, reported: map[string]bool{}}
+}
+
+// check reports the actions that the planned resource of resourceType needs
+// in its dataset and that the auth token is not permitted to perform. Every
+// resource needs dataset:read; a resource that is about to be created also
+// needs createAction, when it is not empty. Updates and deletes are
+// authorized per asset, which the API does not report ahead of time, so they
+// are not checked. Planned deletes and unknown or null datasets are skipped.
+func (p *permissionPreflight) check(ctx context.Context, req resource.ModifyPlanRequest, resourceType, createAction string, diags *diag.Diagnostics) {
+	if p == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var dataset types.String
+	if d := req.Plan.GetAttribute(ctx, path.Root("dataset"), &dataset); d.HasError() || dataset.IsNull() || dataset.IsUnknown() {
+		return
+	}
+
+	needed := []string{datasetReadAction}
+	if createAction != "" && req.State.Raw.IsNull() {
+		needed = append(needed, createAction)
+	}
+
+	p.once.Do(func() {
+		p.permitted, p.err = p.client.PermittedDatasetActions(ctx)
+	})
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		if !p.warned {
+			p.warned = true
+			diags.AddWarning(
+				"Dash0 Permission Preflight Skipped",
+				fmt.Sprintf("The permissions of the auth token could not be read, so they are not checked before apply: %s", p.err),
+			)
+		}
+		return
+	}
+
+	if p.reported[dataset.ValueString()] {
+		return
+	}
+	permitted, visible := p.permitted[dataset.ValueString()]
+	needsMissing := false
+	for _, action := range needed {
+		if !slices.Contains(permitted, action) {
+			needsMissing = true
+		}
+	}
+	if !needsMissing {
+		return
+	}
+	p.reported[dataset.ValueString()] = true
+
+	// The other resources of the plan are checked separately, so the error
+	// lists every checked action missing in the dataset, not only those
+	// needed by this resource.
+	var missing []string
+	for _, checked := range checkedActions {
+		if slices.Contains(permitted, checked.action) {
+			continue
+		}
+		line := fmt.Sprintf("%s, needed by %s", checked.action, checked.neededBy)
+		if slices.Contains(needed, checked.action) {
+			line += fmt.Sprintf(", including this %s resource", resourceType)
+		}
+		missing = append(missing, line)
+	}
+
+	tflog.Debug(ctx, "Auth token lacks permissions needed by the plan", map[string]any{"dataset": dataset.ValueString(), "missing": missing})
+	reason := ""
+	if !visible {
+		reason = " The dataset does not exist, or the token has no access to it."
+	}
+	diags.AddAttributeError(
+		path.Root("dataset"),
+		"Missing Dash0 Permissions",
+		fmt.Sprintf("The auth token is not permitted to perform the following actions in dataset %q:\n\n- %s\n\n"+
+			"Grant them to the token, or the apply fails with 403 Forbidden.%s This is the only error reported for the dataset; other resources that need these actions are not reported again.",
+			dataset.ValueString(), strings.Join(missing, "\n- "), reason),
+	)
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testPermissionPlan returns a plan request for a resource in dataset. The
+// resource is about to be created unless exists is set.
+func testPermissionPlan(dataset string, exists bool) resource.ModifyPlanRequest {
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"dataset": schema.StringAttribute{Required: true},
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"dataset": tftypes.String}}
+	value := tftypes.NewValue(objectType, map[string]tftypes.Value{"dataset": tftypes.NewValue(tftypes.String, dataset)})
+
+	req := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: value},
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	if exists {
+		req.State.Raw = value
+	}
+	return req
+}
+
+func TestPermissionPreflight_Check(t *testing.T) {
+	ctx := context.Background()
+	permitted := map[string][]string{
+		"default": {"dataset:read", "dataset:createCheckRule"},
+		"staging": {},
+	}
+
+	t.Run("permitted actions pass", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("PermittedDatasetActions", mock.Anything).Return(permitted, nil).Once()
+		p := newPermissionPreflight(mockClient)
+
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("default", false), "dash0_check_rule", datasetCreateCheckRuleAction, &diags)
+		p.check(ctx, testPermissionPlan("default", true), "dash0_dashboard", "", &diags)
+		assert.False(t, diags.HasError(), diags)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("missing actions are reported once per dataset", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("PermittedDatasetActions", mock.Anything).Return(permitted, nil).Once()
+		p := newPermissionPreflight(mockClient)
+
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("default", false), "dash0_synthetic_check", datasetCreateSyntheticCheckAction, &diags)
+		p.check(ctx, testPermissionPlan("default", false), "dash0_slo", datasetCreateSLOAction, &diags)
+		p.check(ctx, testPermissionPlan("staging", true), "dash0_view", "", &diags)
+		p.check(ctx, testPermissionPlan("staging", false), "dash0_check_rule", datasetCreateCheckRuleAction, &diags)
+		require.Equal(t, 2, diags.ErrorsCount())
+		assert.Equal(t, "Missing Dash0 Permissions", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "in dataset \"default\":\n\n"+
+			"- dataset:createSyntheticCheck, needed by synthetic checks that are created, including this dash0_synthetic_check resource\n"+
+			"- dataset:createRecordingRuleGroup, needed by recording rules and Prometheus rule groups with recording rules that are created\n"+
+			"- dataset:createSLO, needed by SLOs that are created\n\n")
+		assert.Contains(t, diags.Errors()[1].Detail(), "in dataset \"staging\":\n\n"+
+			"- dataset:read, needed by every resource in the dataset, including this dash0_view resource\n"+
+			"- dataset:createCheckRule, needed by check rules and Prometheus rule groups that are created\n")
+	})
+
+	t.Run("a dataset is reported by the first resource that needs a missing action", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("PermittedDatasetActions", mock.Anything).Return(permitted, nil).Once()
+		p := newPermissionPreflight(mockClient)
+
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("default", true), "dash0_synthetic_check", datasetCreateSyntheticCheckAction, &diags)
+		assert.False(t, diags.HasError(), diags)
+		p.check(ctx, testPermissionPlan("default", false), "dash0_slo", datasetCreateSLOAction, &diags)
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Contains(t, diags.Errors()[0].Detail(), "- dataset:createSLO, needed by SLOs that are created, including this dash0_slo resource\n")
+	})
+
+	t.Run("existing resources only need read access", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("PermittedDatasetActions", mock.Anything).Return(permitted, nil).Once()
+		p := newPermissionPreflight(mockClient)
+
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("default", true), "dash0_synthetic_check", datasetCreateSyntheticCheckAction, &diags)
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("an unknown dataset", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("PermittedDatasetActions", mock.Anything).Return(permitted, nil).Once()
+		p := newPermissionPreflight(mockClient)
+
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("production", true), "dash0_view", "", &diags)
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Contains(t, diags.Errors()[0].Detail(), "The dataset does not exist, or the token has no access to it.")
+	})
+
+	t.Run("unreadable permissions warn once", func(t *testing.T) {
+		mockClient := &MockClient{}
+		mockClient.On("PermittedDatasetActions", mock.Anything).Return(nil, errors.New("403 Forbidden")).Once()
+		p := newPermissionPreflight(mockClient)
+
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("default", false), "dash0_check_rule", datasetCreateCheckRuleAction, &diags)
+		p.check(ctx, testPermissionPlan("staging", false), "dash0_check_rule", datasetCreateCheckRuleAction, &diags)
+		assert.False(t, diags.HasError(), diags)
+		require.Equal(t, 1, diags.WarningsCount())
+		assert.Contains(t, diags.Warnings()[0].Detail(), "403 Forbidden")
+	})
+
+	t.Run("a nil preflight checks nothing", func(t *testing.T) {
+		var p *permissionPreflight
+		var diags diag.Diagnostics
+		p.check(ctx, testPermissionPlan("default", false), "dash0_check_rule", datasetCreateCheckRuleAction, &diags)
+		assert.Empty(t, diags)
+	})
+}
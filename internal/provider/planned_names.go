@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// plannedNames records which resource plans an asset with a given name, so
+// that two resources in the same configuration that declare assets with the
+// same name in the same dataset are reported at plan time.
+//
+// Terraform starts a fresh provider process for every operation, so the
+// registry only holds the names planned within one operation. An instance may
+// be planned more than once within it, as the apply walk plans every instance
+// with changes again; a repeat of the same origin is therefore not a
+// duplicate. Detection only covers the instances that reach ModifyPlan:
+// unchanged instances are not planned again by the apply walk, and instances
+// excluded with -target are not planned at all, so a duplicate of the name of
+// such a resource is only caught by a plan that includes it. A nil registry
+// records nothing.
+type plannedNames struct {
+	mu    sync.Mutex
+	names map[plannedName]types.String
+}
+
+// plannedName identifies an asset by resource type, dataset and name.
+type plannedName struct {
+	resourceType string
+	dataset      string
+	name         string
+}
+
+func newPlannedNames() *plannedNames {
+	return &plannedNames{names: map[plannedName]types.String{}}
+}
+
+// plannedAsset describes the asset a resource plans to write.
+type plannedAsset struct {
+	// resourceType is the Terraform type name, for example "dash0_dashboard".
+	resourceType string
+	// assetName is the human-readable asset type, for example "dashboard".
+	assetName string
+	// attribute is the name of the YAML attribute holding the definition.
+	attribute  string
+	definition types.String
+	metadata   managedMetadata
+	dataset    types.String
+	// priorDataset is the dataset in state, or null for resources that are
+	// about to be created.
+	priorDataset types.String
+	// origin identifies the planned resource; it is unknown for resources that
+	// are about to be created.
+	origin types.String
+	// namePaths are the definition fields the asset name is read from, in the
+	// order used for name-based conflict detection on create.
+	namePaths []string
+}
+
+// check records the name of the planned asset and reports an error when
+// another resource already planned an asset of the same type with the same
+// name in the same dataset. Assets whose name or dataset is not known yet are
+// skipped.
+func (n *plannedNames) check(ctx context.Context, a plannedAsset, diags *diag.Diagnostics) {
+	if n == nil || a.definition.IsNull() || a.definition.IsUnknown() || a.dataset.IsUnknown() || !a.metadata.known() {
+		return
+	}
+	// A dataset change replaces the resource, and Terraform plans the
+	// replacement a second time as a create, so the name is recorded then.
+	if !a.priorDataset.IsNull() && !a.priorDataset.Equal(a.dataset) {
+		return
+	}
+	// Errors in the definition are reported by ValidateConfig.
+	definition, mergeDiags := mergeManagedMetadata(ctx, a.definition.ValueString(), a.metadata)
+	if mergeDiags.HasError() {
+		return
+	}
+	name := converter.DefinitionName(definition, a.namePaths...)
+	if name == "" {
+		return
+	}
+
+	key := plannedName{resourceType: a.resourceType, dataset: a.dataset.ValueString(), name: name}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	previous, exists := n.names[key]
+	if !exists {
+		n.names[key] = a.origin
+		return
+	}
+	// The same existing resource planned again is not a duplicate.
+	if !previous.IsUnknown() && previous.Equal(a.origin) {
+		return
+	}
+
+	other := fmt.Sprintf("Another %s resource", a.resourceType)
+	if !previous.IsUnknown() {
+		other = fmt.Sprintf("Another %s resource, with origin %s,", a.resourceType, previous.ValueString())
+	}
+	diags.AddAttributeError(
+		path.Root(a.attribute),
+		fmt.Sprintf("Duplicate %s name", a.assetName),
+		fmt.Sprintf("%s in this configuration also declares a %s named %q in dataset %q. "+
+			"Each resource must declare a unique name; otherwise the assets are indistinguishable by name, and conflict_strategy would make one resource take over or delete the other's %s. "+
+			"Rename one of them, for example with name_prefix or name_suffix.", other, a.assetName, name, key.dataset, a.assetName),
+	)
+}
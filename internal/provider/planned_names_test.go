@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPlannedDashboard returns the planned asset of a new dashboard named
+// name in dataset.
+func testPlannedDashboard(name, dataset string) plannedAsset {
+	return plannedAsset{
+		resourceType: "dash0_dashboard",
+		assetName:    "dashboard",
+		attribute:    "dashboard_yaml",
+		definition:   types.StringValue("kind: Dashboard\nmetadata:\n  name: " + name + "\nspec: {}\n"),
+		metadata: managedMetadata{
+			Labels:      types.MapNull(types.StringType),
+			Annotations: types.MapNull(types.StringType),
+		},
+		dataset:   types.StringValue(dataset),
+		origin:    types.StringUnknown(),
+		namePaths: []string{"spec.display.name", "metadata.name"},
+	}
+}
+
+func TestPlannedNames_Check(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("two new resources with the same name", func(t *testing.T) {
+		names := newPlannedNames()
+		var diags diag.Diagnostics
+		names.check(ctx, testPlannedDashboard("checkout", "default"), &diags)
+		require.False(t, diags.HasError())
+
+		names.check(ctx, testPlannedDashboard("checkout", "default"), &diags)
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Equal(t, "Duplicate dashboard name", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), `named "checkout" in dataset "default"`)
+	})
+
+	t.Run("the origin of an existing resource is reported", func(t *testing.T) {
+		names := newPlannedNames()
+		existing := testPlannedDashboard("checkout", "default")
+		existing.origin = types.StringValue("tf_existing")
+		var diags diag.Diagnostics
+		names.check(ctx, existing, &diags)
+		names.check(ctx, testPlannedDashboard("checkout", "default"), &diags)
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Contains(t, diags.Errors()[0].Detail(), "with origin tf_existing")
+	})
+
+	t.Run("the same resource planned twice", func(t *testing.T) {
+		names := newPlannedNames()
+		existing := testPlannedDashboard("checkout", "default")
+		existing.origin = types.StringValue("tf_existing")
+		var diags diag.Diagnostics
+		names.check(ctx, existing, &diags)
+		names.check(ctx, existing, &diags)
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("different datasets and name affixes", func(t *testing.T) {
+		names := newPlannedNames()
+		preview := testPlannedDashboard("checkout", "default")
+		preview.metadata.NamePrefix = types.StringValue("preview-")
+		var diags diag.Diagnostics
+		names.check(ctx, testPlannedDashboard("checkout", "default"), &diags)
+		names.check(ctx, testPlannedDashboard("checkout", "staging"), &diags)
+		names.check(ctx, preview, &diags)
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("a dataset change is recorded on the replacement plan", func(t *testing.T) {
+		names := newPlannedNames()
+		moved := testPlannedDashboard("checkout", "staging")
+		moved.origin = types.StringValue("tf_existing")
+		moved.priorDataset = types.StringValue("default")
+		var diags diag.Diagnostics
+		names.check(ctx, moved, &diags)
+		names.check(ctx, testPlannedDashboard("checkout", "staging"), &diags)
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("unknown values and a missing registry", func(t *testing.T) {
+		names := newPlannedNames()
+		unknown := testPlannedDashboard("checkout", "default")
+		unknown.definition = types.StringUnknown()
+		var diags diag.Diagnostics
+		names.check(ctx, unknown, &diags)
+		names.check(ctx, unknown, &diags)
+
+		var missing *plannedNames
+		missing.check(ctx, testPlannedDashboard("checkout", "default"), &diags)
+		missing.check(ctx, testPlannedDashboard("checkout", "default"), &diags)
+		assert.False(t, diags.HasError(), diags)
+	})
+}
+
+func TestPlannedNamesOf(t *testing.T) {
+	names := newPlannedNames()
+	assert.Same(t, names, plannedNamesOf(&providerData{Client: &MockClient{}, plannedNames: names}))
+	assert.Nil(t, plannedNamesOf(&MockClient{}))
+	assert.Nil(t, plannedNamesOf(nil))
+}
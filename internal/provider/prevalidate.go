@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// definitionCheck describes how prevalidateDefinition checks the YAML
+// definition attribute of a resource.
+type definitionCheck struct {
+	// attribute is the name of the YAML attribute, for example "dashboard_yaml".
+	attribute string
+	// kind, when set, is the only `kind` the definition may declare.
+	kind string
+	// metadata holds the provider-managed metadata applied on top of the YAML,
+	// or nil for resources without labels, annotations or name affixes.
+	metadata *managedMetadata
+	// validateYAML or validateJSON decodes the definition the way the client
+	// does on create and update. validateJSON receives the definition after it
+	// has been converted to JSON. When neither is set, only the conversion to
+	// JSON is checked.
+	validateYAML func(yamlStr string) error
+	validateJSON func(jsonStr string) error
+}
+
+// prevalidateDefinition runs the offline part of a resource's write path at
+// plan time: YAML syntax, the document shape, the merge of provider-managed
+// metadata, the conversion to JSON and the decoding into the API type.
+//
+// Terraform validates every resource in the configuration before it starts an
+// apply and fails the run if any of them reports an error, so a definition
+// that would be rejected surfaces together with all other invalid definitions
+// instead of aborting an apply halfway through, after some of the assets have
+// already been written. Checks that need the API, such as whether a referenced
+// notification channel exists, still happen at apply time.
+//
+// Unknown values are skipped; they are validated once they are known.
+func prevalidateDefinition(ctx context.Context, definition types.String, check definitionCheck, diags *diag.Diagnostics) {
+	if definition.IsNull() || definition.IsUnknown() {
+		return
+	}
+	attribute := path.Root(check.attribute)
+	yamlStr := definition.ValueString()
+
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &parsed); err != nil {
+		diags.AddAttributeError(
+			attribute,
+			fmt.Sprintf("Invalid YAML in %s", check.attribute),
+			fmt.Sprintf("%s is not valid YAML: %s", check.attribute, err),
+		)
+		return
+	}
+
+	// yaml.Unmarshal accepts an empty document, which decodes into nil.
+	if parsed == nil {
+		diags.AddAttributeError(
+			attribute,
+			fmt.Sprintf("%s is empty or not a YAML mapping", check.attribute),
+			fmt.Sprintf("%s must be a YAML mapping with kind, metadata and spec.", check.attribute),
+		)
+		return
+	}
+
+	if check.kind != "" {
+		if kind, _ := parsed["kind"].(string); kind != check.kind {
+			diags.AddAttributeError(
+				attribute,
+				fmt.Sprintf("%s is missing or has the wrong kind", check.attribute),
+				fmt.Sprintf("%s must declare `kind: %s`; got %q.", check.attribute, check.kind, kind),
+			)
+			return
+		}
+	}
+
+	if check.metadata != nil {
+		// The merge result depends on every metadata value, so the remaining
+		// checks wait until all of them are known.
+		if !check.metadata.known() {
+			return
+		}
+		merged, mergeDiags := mergeManagedMetadata(ctx, yamlStr, *check.metadata)
+		for _, d := range mergeDiags.Errors() {
+			diags.AddAttributeError(attribute, d.Summary(), d.Detail())
+		}
+		if mergeDiags.HasError() {
+			return
+		}
+		yamlStr = merged
+	}
+
+	var err error
+	if check.validateYAML != nil {
+		err = check.validateYAML(yamlStr)
+	} else {
+		var jsonStr string
+		if jsonStr, err = converter.ConvertYAMLToJSON(yamlStr); err == nil && check.validateJSON != nil {
+			err = check.validateJSON(jsonStr)
+		}
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			fmt.Sprintf("Invalid definition in %s", check.attribute),
+			fmt.Sprintf("%s does not match the format expected by the Dash0 API: %s", check.attribute, err),
+		)
+	}
+}
+
+// known reports whether all provider-managed metadata values, including the
+// elements of the label and annotation maps, are known.
+func (m managedMetadata) known() bool {
+	if m.NamePrefix.IsUnknown() || m.NameSuffix.IsUnknown() {
+		return false
+	}
+	for _, values := range []types.Map{m.Labels, m.Annotations} {
+		if values.IsUnknown() {
+			return false
+		}
+		for _, v := range values.Elements() {
+			if v.IsUnknown() {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validateConfig runs r's ValidateConfig against a config holding the given
+// attribute values; all other attributes are null.
+func validateConfig(t *testing.T, r resource.ResourceWithValidateConfig, values map[string]tftypes.Value) *resource.ValidateConfigResponse {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Raw: tftypes.NewValue(objectType, attrs), Schema: schemaResp.Schema},
+	}, resp)
+	return resp
+}
+
+func TestValidateConfig_ReportsInvalidDefinitions(t *testing.T) {
+	cases := []struct {
+		name          string
+		resource      resource.ResourceWithValidateConfig
+		values        map[string]tftypes.Value
+		expectSummary string
+		expectDetail  string
+	}{
+		{
+			name:     "dashboard with a malformed field",
+			resource: &DashboardResource{},
+			values: map[string]tftypes.Value{
+				"dashboard_yaml": tftypes.NewValue(tftypes.String, "kind: Dashboard\nmetadata: [not, a, mapping]\n"),
+			},
+			expectSummary: "Invalid definition in dashboard_yaml",
+		},
+		{
+			name:     "dashboard name prefix without metadata.name",
+			resource: &DashboardResource{},
+			values: map[string]tftypes.Value{
+				"dashboard_yaml": tftypes.NewValue(tftypes.String, "kind: Dashboard\nspec: {}\n"),
+				"name_prefix":    tftypes.NewValue(tftypes.String, "preview-"),
+			},
+			expectSummary: "Invalid Metadata",
+			expectDetail:  "metadata.name must be set",
+		},
+		{
+			name:     "check rule with two rules in its group",
+			resource: &CheckRuleResource{},
+			values: map[string]tftypes.Value{
+				"check_rule_yaml": tftypes.NewValue(tftypes.String, `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: first
+          expr: up == 0
+        - alert: second
+          expr: up == 0
+`),
+			},
+			expectSummary: "Invalid definition in check_rule_yaml",
+			expectDetail:  "only one rule per group",
+		},
+		{
+			name:     "synthetic check with invalid YAML",
+			resource: &SyntheticCheckResource{},
+			values: map[string]tftypes.Value{
+				"synthetic_check_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: ["),
+			},
+			expectSummary: "Invalid YAML in synthetic_check_yaml",
+		},
+		{
+			name:     "synthetic check permission with two principals",
+			resource: &SyntheticCheckResource{},
+			values: map[string]tftypes.Value{
+				"permissions": tftypes.NewValue(testSyntheticCheckPermissionsType, []tftypes.Value{
+					tftypes.NewValue(testSyntheticCheckPermissionsType.ElementType, map[string]tftypes.Value{
+						"actions": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "synthetic_check:read"),
+						}),
+						"role":    tftypes.NewValue(tftypes.String, "admin"),
+						"team_id": tftypes.NewValue(tftypes.String, "team-1"),
+						"user_id": tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			},
+			expectSummary: "Invalid Permission",
+			expectDetail:  "exactly one of role, team_id and user_id",
+		},
+		{
+			name:     "empty view",
+			resource: &ViewResource{},
+			values: map[string]tftypes.Value{
+				"view_yaml": tftypes.NewValue(tftypes.String, ""),
+			},
+			expectSummary: "view_yaml is empty or not a YAML mapping",
+		},
+		{
+			name:     "spam filter with a malformed spec",
+			resource: &SpamFilterResource{},
+			values: map[string]tftypes.Value{
+				"spam_filter_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0SpamFilter\nspec: not-a-mapping\n"),
+			},
+			expectSummary: "Invalid definition in spam_filter_yaml",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateConfig(t, tc.resource, tc.values)
+
+			require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+			assert.Equal(t, tc.expectSummary, resp.Diagnostics.Errors()[0].Summary())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.expectDetail)
+		})
+	}
+}
+
+func TestValidateConfig_AcceptsValidDefinitions(t *testing.T) {
+	resp := validateConfig(t, &RecordingRuleResource{}, map[string]tftypes.Value{
+		"recording_rule_yaml": tftypes.NewValue(tftypes.String, `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: http-request-rates
+spec:
+  groups:
+    - name: HttpRequestRates
+      interval: 1m0s
+      rules:
+        - record: job:http_requests_total:rate5m
+          expr: sum by (job) (rate(http_requests_total[5m]))
+`),
+	})
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+// TestValidateConfig_SkipsUnknownValues asserts that definitions depending on
+// values only known during apply are not validated prematurely.
+func TestValidateConfig_SkipsUnknownValues(t *testing.T) {
+	t.Run("unknown definition", func(t *testing.T) {
+		resp := validateConfig(t, &DashboardResource{}, map[string]tftypes.Value{
+			"dashboard_yaml": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		resp := validateConfig(t, &DashboardResource{}, map[string]tftypes.Value{
+			"dashboard_yaml": tftypes.NewValue(tftypes.String, "kind: Dashboard\nspec: {}\n"),
+			"name_prefix":    tftypes.NewValue(tftypes.String, "preview-"),
+			"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"team": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		})
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+}
+
+// TestValidateConfig_AllUnknown asserts that no resource reports an error for
+// a configuration whose every attribute is unknown, as happens when
+// definitions and datasets are built from outputs of resources that are
+// created in the same apply.
+func TestValidateConfig_AllUnknown(t *testing.T) {
+	ctx := context.Background()
+	for _, newResource := range (&dash0Provider{}).Resources(ctx) {
+		r, ok := newResource().(resource.ResourceWithValidateConfig)
+		if !ok {
+			continue
+		}
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dash0"}, metadataResp)
+
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			values := map[string]tftypes.Value{}
+			for name, attribute := range schemaResp.Schema.Attributes {
+				values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), tftypes.UnknownValue)
+			}
+
+			resp := validateConfig(t, r, values)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
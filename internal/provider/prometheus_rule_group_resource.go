@@ -0,0 +1,557 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &PrometheusRuleGroupResource{}
+	_ resource.ResourceWithConfigure      = &PrometheusRuleGroupResource{}
+	_ resource.ResourceWithImportState    = &PrometheusRuleGroupResource{}
+	_ resource.ResourceWithValidateConfig = &PrometheusRuleGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &PrometheusRuleGroupResource{}
+)
+
+// NewPrometheusRuleGroupResource is a helper function to simplify the provider implementation.
+func NewPrometheusRuleGroupResource() resource.Resource {
+	return &PrometheusRuleGroupResource{}
+}
+
+// PrometheusRuleGroupResource is the resource implementation of
+// dash0_prometheus_rule_group. It syncs every alerting rule of a
+// PrometheusRule document into a check rule of its own, and the recording
+// rules of every group into a recording rule group, addressed by keys derived
+// from the group and rule names.
+type PrometheusRuleGroupResource struct {
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+}
+
+// prometheusRuleGroupModel is the Terraform state model for a Prometheus rule
+// group resource.
+type prometheusRuleGroupModel struct {
+	Origin               types.String `tfsdk:"origin"`
+	Dataset              types.String `tfsdk:"dataset"`
+	PrometheusRuleYaml   types.String `tfsdk:"prometheus_rule_yaml"`
+	CheckRuleOrigins     types.List   `tfsdk:"check_rule_origins"`
+	RecordingRuleOrigins types.List   `tfsdk:"recording_rule_origins"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+}
+
+// prometheusRuleOrigin returns the origin of the check rule or recording
+// rule group that holds the part of the document with the given key.
+func prometheusRuleOrigin(origin string, key string) string {
+	return origin + "-" + key
+}
+
+// prometheusRuleOrigins holds the origins of the assets a rule group wrote,
+// by asset type.
+type prometheusRuleOrigins struct {
+	checkRules     []string
+	recordingRules []string
+}
+
+// with returns the origins of o followed by those of other that o does not
+// hold.
+func (o prometheusRuleOrigins) with(other prometheusRuleOrigins) prometheusRuleOrigins {
+	union := func(a, b []string) []string {
+		for _, origin := range b {
+			if !slices.Contains(a, origin) {
+				a = append(a, origin)
+			}
+		}
+		return a
+	}
+	return prometheusRuleOrigins{
+		checkRules:     union(slices.Clone(o.checkRules), other.checkRules),
+		recordingRules: union(slices.Clone(o.recordingRules), other.recordingRules),
+	}
+}
+
+// validatePrometheusRuleGroup checks that every alerting rule of the document
+// converts into a check rule, and the recording rules of every group into a
+// recording rule group.
+func validatePrometheusRuleGroup(yamlStr string) error {
+	parts, err := converter.SplitPrometheusRule(yamlStr)
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		if !part.Recording {
+			if err := client.ValidateCheckRule(part.Document); err != nil {
+				return fmt.Errorf("check rule %s: %w", part.Key, err)
+			}
+			continue
+		}
+		jsonBody, err := converter.ConvertYAMLToJSON(part.Document)
+		if err == nil {
+			err = client.ValidateRecordingRule(jsonBody)
+		}
+		if err != nil {
+			return fmt.Errorf("recording rule group %s: %w", part.Key, err)
+		}
+	}
+	return nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PrometheusRuleGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_prometheus_rule_group")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+}
+
+func (r *PrometheusRuleGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_rule_group"
+}
+
+// ValidateConfig checks the PrometheusRule document at plan time, including
+// the conversion of its rules into check rules and recording rule groups, so
+// that colliding rule names or a rule the API client would reject fail the
+// plan rather than the apply.
+func (r *PrometheusRuleGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model prometheusRuleGroupModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prevalidateDefinition(ctx, model.PrometheusRuleYaml, definitionCheck{
+		attribute:    "prometheus_rule_yaml",
+		kind:         "PrometheusRule",
+		validateYAML: validatePrometheusRuleGroup,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan checks the permissions of the auth token when the permission
+// preflight is enabled. Documents with recording rules also need the
+// permission to create recording rule groups.
+func (r *PrometheusRuleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.permissions.check(ctx, req, "dash0_prometheus_rule_group", datasetCreateCheckRuleAction, &resp.Diagnostics)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan prometheusRuleGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.PrometheusRuleYaml.IsUnknown() {
+		return
+	}
+	parts, err := converter.SplitPrometheusRule(plan.PrometheusRuleYaml.ValueString())
+	if err == nil && slices.ContainsFunc(parts, func(part converter.PrometheusRulePart) bool { return part.Recording }) {
+		r.permissions.check(ctx, req, "dash0_prometheus_rule_group", datasetCreateRecordingRuleAction, &resp.Diagnostics)
+	}
+}
+
+func (r *PrometheusRuleGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Syncs the rules of a [PrometheusRule](https://prometheus-operator.dev/docs/api-reference/api/#monitoring.coreos.com/v1.PrometheusRule) document into Dash0: one check rule per alerting rule, and one recording rule group per group with recording rules. " +
+			"Each check rule is named `<group> - <alert>` and evaluated at the interval of its group, the same way `dash0_check_rule` maps a single rule; the recording rules of a group are stored the way `dash0_recording_rule` stores a group.\n\n" +
+			"Rules are addressed by keys derived from their names, not by their position: the check rule of an alerting rule has the key `<group>--<alert>`, and the recording rule group of a group the key `<group>`, with the names lowercased and every run of other characters than letters and digits replaced by a hyphen. " +
+			"Editing a rule updates its asset in place, adding or removing a rule creates or deletes only its own asset, and reordering rules changes nothing. Renaming a group or an alerting rule replaces its assets. " +
+			"Two alerting rules of a group, or two groups, whose names map to the same key are rejected at plan time; give them distinct names.",
+		Attributes: map[string]schema.Attribute{
+			"origin": schema.StringAttribute{
+				Description: "A unique identifier for the rule group, automatically generated on creation. The check rules and recording rule groups of the group use it as the prefix of their origins. Used for imports.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the rules belong to. Provide the dataset's identifier, which is immutable, not the 'name'. Changing this value forces the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prometheus_rule_yaml": schema.StringAttribute{
+				Description: "The PrometheusRule document in YAML format, with any number of groups under `spec.groups` and any number of [alerting rules](https://prometheus.io/docs/prometheus/latest/configuration/alerting_rules/) and [recording rules](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/) per group. Of the group fields, only `name` and `interval` are used.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing),
+				},
+			},
+			"check_rule_origins": schema.ListAttribute{
+				Description: "The origins of the check rules, in the order of the alerting rules in the document. The check rule of an alerting rule with the key `<key>` has the origin `<origin>-<key>`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"recording_rule_origins": schema.ListAttribute{
+				Description: "The origins of the recording rule groups, in the order of the groups in the document. The recording rule group of a group with the key `<key>` has the origin `<origin>-<key>`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("rule"),
+		},
+	}
+}
+
+// sync writes the parts of a document to the check rules and recording rule
+// groups of their keys, and deletes the assets of previous, the origins
+// written before, that no part maps to anymore. sync returns the origins that
+// may hold an asset afterwards, counting writes that failed, so that the state
+// keeps track of every asset to clean up.
+func (r *PrometheusRuleGroupResource) sync(ctx context.Context, origin string, dataset string, parts []converter.PrometheusRulePart, previous prometheusRuleOrigins) (prometheusRuleOrigins, error) {
+	var written prometheusRuleOrigins
+	for _, part := range parts {
+		partOrigin := prometheusRuleOrigin(origin, part.Key)
+		if part.Recording {
+			written.recordingRules = append(written.recordingRules, partOrigin)
+			jsonBody, err := converter.ConvertYAMLToJSON(part.Document)
+			if err == nil {
+				if slices.Contains(previous.recordingRules, partOrigin) {
+					err = r.client.UpdateRecordingRule(ctx, partOrigin, jsonBody, dataset)
+				} else {
+					err = r.client.CreateRecordingRule(ctx, partOrigin, jsonBody, dataset)
+				}
+			}
+			if err != nil {
+				return written.with(previous), fmt.Errorf("unable to write recording rule group %s: %w", partOrigin, err)
+			}
+			continue
+		}
+		written.checkRules = append(written.checkRules, partOrigin)
+		var err error
+		if slices.Contains(previous.checkRules, partOrigin) {
+			err = r.client.UpdateCheckRule(ctx, partOrigin, part.Document, dataset)
+		} else {
+			err = r.client.CreateCheckRule(ctx, partOrigin, part.Document, dataset)
+		}
+		if err != nil {
+			return written.with(previous), fmt.Errorf("unable to write check rule %s: %w", partOrigin, err)
+		}
+	}
+	for _, stale := range previous.checkRules {
+		if slices.Contains(written.checkRules, stale) {
+			continue
+		}
+		if err := r.client.DeleteCheckRule(ctx, stale, dataset); err != nil && !dash0.IsNotFound(err) {
+			return written.with(previous), fmt.Errorf("unable to delete check rule %s: %w", stale, err)
+		}
+	}
+	for _, stale := range previous.recordingRules {
+		if slices.Contains(written.recordingRules, stale) {
+			continue
+		}
+		if err := r.client.DeleteRecordingRule(ctx, stale, dataset); err != nil && !dash0.IsNotFound(err) {
+			return written.with(previous), fmt.Errorf("unable to delete recording rule group %s: %w", stale, err)
+		}
+	}
+	return written, nil
+}
+
+// ruleOrigins returns the origins held by the model.
+func (m prometheusRuleGroupModel) ruleOrigins(ctx context.Context, diags *diag.Diagnostics) prometheusRuleOrigins {
+	var origins prometheusRuleOrigins
+	diags.Append(m.CheckRuleOrigins.ElementsAs(ctx, &origins.checkRules, false)...)
+	diags.Append(m.RecordingRuleOrigins.ElementsAs(ctx, &origins.recordingRules, false)...)
+	return origins
+}
+
+// setRuleOrigins sets the origins on the model.
+func setRuleOrigins(ctx context.Context, model *prometheusRuleGroupModel, origins prometheusRuleOrigins, diags *diag.Diagnostics) {
+	checkRules, d := types.ListValueFrom(ctx, types.StringType, nonNil(origins.checkRules))
+	diags.Append(d...)
+	model.CheckRuleOrigins = checkRules
+	recordingRules, d := types.ListValueFrom(ctx, types.StringType, nonNil(origins.recordingRules))
+	diags.Append(d...)
+	model.RecordingRuleOrigins = recordingRules
+}
+
+// nonNil returns values, or an empty slice when values is nil, so that an
+// empty list of origins is stored as an empty list rather than null.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func (r *PrometheusRuleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model prometheusRuleGroupModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := converter.SplitPrometheusRule(model.PrometheusRuleYaml.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid PrometheusRule", fmt.Sprintf("Unable to split the PrometheusRule document into check rules and recording rule groups: %s", err))
+		return
+	}
+
+	model.Origin = types.StringValue("tf_" + uuid.New().String())
+
+	written, err := r.sync(ctx, model.Origin.ValueString(), model.Dataset.ValueString(), parts, prometheusRuleOrigins{})
+	setRuleOrigins(ctx, &model, written, &resp.Diagnostics)
+	if err != nil {
+		// Keep the assets written so far in state: Terraform marks the
+		// resource as tainted and deletes them on the next apply.
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Prometheus rule group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a Prometheus rule group resource")
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PrometheusRuleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state prometheusRuleGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := converter.SplitPrometheusRule(state.PrometheusRuleYaml.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid PrometheusRule", fmt.Sprintf("Unable to split the PrometheusRule document in state into check rules and recording rule groups: %s", err))
+		return
+	}
+
+	// current holds the parts as they are in Dash0: the part from state when
+	// its asset is equivalent, the API response when it drifted, and an empty
+	// document when the asset no longer exists.
+	current := make([]converter.PrometheusRulePart, len(parts))
+	drifted, missing := false, 0
+	for i, part := range parts {
+		current[i] = part
+		partOrigin := prometheusRuleOrigin(state.Origin.ValueString(), part.Key)
+		assetName := "check rule"
+		if part.Recording {
+			assetName = "recording rule group"
+		}
+
+		var apiResponse string
+		if part.Recording {
+			apiResponse, err = r.client.GetRecordingRule(ctx, partOrigin, state.Dataset.ValueString())
+		} else {
+			apiResponse, err = r.client.GetCheckRule(ctx, partOrigin, state.Dataset.ValueString())
+		}
+		if err != nil {
+			if dash0.IsNotFound(err) {
+				drifted = true
+				missing++
+				current[i].Document = ""
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s %s, got error: %s", assetName, partOrigin, err))
+			return
+		}
+
+		annotationSharing := []string(nil)
+		if !part.Recording {
+			// The API does not preserve metadata.name for check rules.
+			apiResponse = injectMetadataName(part.Document, apiResponse)
+			annotationSharing = []string{converter.AnnotationSharing}
+		}
+		additionalIgnored := converter.FieldsAbsentFromYAML(part.Document, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(part.Document, comparedResponse(part.Document, apiResponse, state.IgnoreServerDefaults), additionalIgnored, annotationSharing)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Prometheus Rule Comparison Error",
+				fmt.Sprintf("Error comparing %s %s: %s. Using API response as source of truth.", assetName, partOrigin, err),
+			)
+		}
+		if err != nil || !equivalent {
+			drifted = true
+			current[i].Document = apiResponse
+		}
+	}
+
+	if missing == len(parts) {
+		tflog.Debug(ctx, fmt.Sprintf("No check rule or recording rule group of Prometheus rule group %s exists on the server; removing from state", state.Origin.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if drifted {
+		tflog.Debug(ctx, "Prometheus rule group has changed, updating state")
+		merged, err := converter.MergePrometheusRule(state.PrometheusRuleYaml.ValueString(), current)
+		if err != nil {
+			resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to merge the check rules and recording rule groups into the PrometheusRule document: %s", err))
+			return
+		}
+		state.PrometheusRuleYaml = types.StringValue(merged)
+	}
+
+	tflog.Trace(ctx, "read a Prometheus rule group resource")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PrometheusRuleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan prometheusRuleGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := converter.SplitPrometheusRule(plan.PrometheusRuleYaml.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid PrometheusRule", fmt.Sprintf("Unable to split the PrometheusRule document into check rules and recording rule groups: %s", err))
+		return
+	}
+
+	plan.Origin = state.Origin
+	previous := state.ruleOrigins(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	written, err := r.sync(ctx, plan.Origin.ValueString(), plan.Dataset.ValueString(), parts, previous)
+	if err != nil {
+		// Keep the previous document so that the next plan retries the
+		// update, and track every asset that may exist by now.
+		setRuleOrigins(ctx, &state, written, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Prometheus rule group, got error: %s", err))
+		return
+	}
+	setRuleOrigins(ctx, &plan, written, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated a Prometheus rule group resource")
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PrometheusRuleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state prometheusRuleGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := state.ruleOrigins(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := r.sync(ctx, state.Origin.ValueString(), state.Dataset.ValueString(), nil, previous); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Prometheus rule group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a Prometheus rule group resource")
+}
+
+// ImportState imports a rule group by 'dataset,origin'. The check rules and
+// recording rule groups whose origins start with '<origin>-' are read and
+// joined into a PrometheusRule document, in the order of their origins, with
+// the check rules first.
+func (r *PrometheusRuleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
+		)
+		return
+	}
+
+	dataset := idParts[0]
+	origin := idParts[1]
+	prefix := prometheusRuleOrigin(origin, "")
+
+	var origins prometheusRuleOrigins
+	var err error
+	origins.checkRules, err = r.client.ListCheckRuleOrigins(ctx, prefix, dataset)
+	if err == nil {
+		origins.recordingRules, err = r.client.ListRecordingRuleOrigins(ctx, prefix, dataset)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Prometheus Rule Group",
+			fmt.Sprintf("Could not list the rules with origin prefix=%s, dataset=%s: %s", prefix, dataset, err),
+		)
+		return
+	}
+	slices.Sort(origins.checkRules)
+	slices.Sort(origins.recordingRules)
+	if len(origins.checkRules) == 0 && len(origins.recordingRules) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Importing Prometheus Rule Group",
+			fmt.Sprintf("No check rule or recording rule group with origin prefix=%s, dataset=%s exists.", prefix, dataset),
+		)
+		return
+	}
+
+	var documents []string
+	for _, checkRule := range origins.checkRules {
+		apiResponseYAML, err := r.client.GetCheckRule(ctx, checkRule, dataset)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Prometheus Rule Group",
+				fmt.Sprintf("Could not get check rule with origin=%s, dataset=%s: %s", checkRule, dataset, err),
+			)
+			return
+		}
+		documents = append(documents, apiResponseYAML)
+	}
+	for _, recordingRule := range origins.recordingRules {
+		apiResponseJSON, err := r.client.GetRecordingRule(ctx, recordingRule, dataset)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Prometheus Rule Group",
+				fmt.Sprintf("Could not get recording rule group with origin=%s, dataset=%s: %s", recordingRule, dataset, err),
+			)
+			return
+		}
+		documents = append(documents, apiResponseJSON)
+	}
+
+	document, err := converter.JoinPrometheusRules(documents)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Prometheus Rule Group", fmt.Sprintf("Could not join the rules into a PrometheusRule document: %s", err))
+		return
+	}
+
+	model := prometheusRuleGroupModel{
+		Origin:               types.StringValue(origin),
+		Dataset:              types.StringValue(dataset),
+		PrometheusRuleYaml:   types.StringValue(document),
+		IgnoreServerDefaults: types.BoolNull(),
+	}
+	setRuleOrigins(ctx, &model, origins, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
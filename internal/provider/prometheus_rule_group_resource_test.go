@@ -0,0 +1,472 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+const testPrometheusRuleGroupYaml = `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: availability
+      interval: 1m
+      rules:
+        - alert: CheckoutDown
+          expr: up{job="checkout"} == 0
+          for: 5m
+        - alert: CartDown
+          expr: up{job="cart"} == 0
+          for: 5m`
+
+// testPrometheusRuleGroupRecordingYaml adds a group with recording rules to
+// testPrometheusRuleGroupYaml.
+const testPrometheusRuleGroupRecordingYaml = testPrometheusRuleGroupYaml + `
+    - name: jobs
+      rules:
+        - record: job:up:sum
+          expr: sum by (job) (up)
+        - record: job:up:avg
+          expr: avg by (job) (up)`
+
+const (
+	testCheckoutDownOrigin = "tf_checkout-availability--checkoutdown"
+	testCartDownOrigin     = "tf_checkout-availability--cartdown"
+	testJobsOrigin         = "tf_checkout-jobs"
+)
+
+func prometheusRuleGroupState(t *testing.T, r *PrometheusRuleGroupResource, model prometheusRuleGroupModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if model.CheckRuleOrigins.ElementType(ctx) == nil {
+		model.CheckRuleOrigins = types.ListNull(types.StringType)
+	}
+	if model.RecordingRuleOrigins.ElementType(ctx) == nil {
+		model.RecordingRuleOrigins = types.ListNull(types.StringType)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func testCheckRuleOrigins(t *testing.T, origins ...string) types.List {
+	t.Helper()
+	list, diags := types.ListValueFrom(context.Background(), types.StringType, nonNil(origins))
+	require.False(t, diags.HasError(), diags)
+	return list
+}
+
+func TestPrometheusRuleGroupResource_Metadata(t *testing.T) {
+	r := &PrometheusRuleGroupResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_prometheus_rule_group", resp.TypeName)
+}
+
+func TestPrometheusRuleGroupResource_Schema(t *testing.T) {
+	r := &PrometheusRuleGroupResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	assert.True(t, resp.Schema.Attributes["origin"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["dataset"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["prometheus_rule_yaml"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["check_rule_origins"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["recording_rule_origins"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["ignore_server_defaults"].IsOptional())
+}
+
+func TestPrometheusRuleGroupResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &PrometheusRuleGroupResource{}
+
+	for name, tc := range map[string]struct {
+		definition string
+		wantError  bool
+	}{
+		"alerting rules":  {definition: testPrometheusRuleGroupYaml},
+		"recording rules": {definition: testPrometheusRuleGroupRecordingYaml},
+		"colliding names": {definition: testPrometheusRuleGroupYaml + "\n        - alert: cartdown\n          expr: up{job=\"cart\"} < 1", wantError: true},
+		"wrong kind":      {definition: "kind: CheckRule\nspec:\n  groups: []", wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+				Origin:             types.StringNull(),
+				Dataset:            types.StringValue("default"),
+				PrometheusRuleYaml: types.StringValue(tc.definition),
+			})
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config(state)}, &resp)
+			assert.Equal(t, tc.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+// TestPrometheusRuleGroupResource_ModifyPlan checks that the permission
+// preflight checks the permission to create recording rule groups for
+// documents with recording rules only.
+func TestPrometheusRuleGroupResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	for name, tc := range map[string]struct {
+		definition string
+		wantError  bool
+	}{
+		"alerting rules":  {definition: testPrometheusRuleGroupYaml},
+		"recording rules": {definition: testPrometheusRuleGroupRecordingYaml, wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			mockClient.On("PermittedDatasetActions", mock.Anything).Return(map[string][]string{"default": {"dataset:read", "dataset:createCheckRule"}}, nil).Once()
+			r := &PrometheusRuleGroupResource{client: mockClient, permissions: newPermissionPreflight(mockClient)}
+
+			plan := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+				Origin:               types.StringUnknown(),
+				Dataset:              types.StringValue("default"),
+				PrometheusRuleYaml:   types.StringValue(tc.definition),
+				CheckRuleOrigins:     types.ListUnknown(types.StringType),
+				RecordingRuleOrigins: types.ListUnknown(types.StringType),
+			})
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan(plan),
+				State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			require.Equal(t, tc.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tc.wantError {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "- dataset:createRecordingRuleGroup, needed by recording rules and Prometheus rule groups with recording rules that are created, including this dash0_prometheus_rule_group resource\n")
+			}
+		})
+	}
+}
+
+func TestPrometheusRuleGroupResource_Create(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+
+	var checkRules, recordingRules []string
+	mockClient.On("CreateCheckRule", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), "default").
+		Run(func(args mock.Arguments) { checkRules = append(checkRules, args.String(1)) }).
+		Return(nil)
+	mockClient.On("CreateRecordingRule", ctx, mock.AnythingOfType("string"), mock.MatchedBy(func(ruleJSON string) bool {
+		return strings.Contains(ruleJSON, `"record":"job:up:sum"`) && strings.Contains(ruleJSON, `"record":"job:up:avg"`)
+	}), "default").
+		Run(func(args mock.Arguments) { recordingRules = append(recordingRules, args.String(1)) }).
+		Return(nil)
+
+	plan := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:               types.StringUnknown(),
+		Dataset:              types.StringValue("default"),
+		PrometheusRuleYaml:   types.StringValue(testPrometheusRuleGroupRecordingYaml),
+		CheckRuleOrigins:     types.ListUnknown(types.StringType),
+		RecordingRuleOrigins: types.ListUnknown(types.StringType),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model prometheusRuleGroupModel
+	resp.State.Get(ctx, &model)
+	origin := model.Origin.ValueString()
+	assert.Equal(t, []string{origin + "-availability--checkoutdown", origin + "-availability--cartdown"}, checkRules)
+	assert.Equal(t, []string{origin + "-jobs"}, recordingRules)
+	assert.Equal(t, testCheckRuleOrigins(t, checkRules...), model.CheckRuleOrigins)
+	assert.Equal(t, testCheckRuleOrigins(t, recordingRules...), model.RecordingRuleOrigins)
+	assert.Equal(t, testPrometheusRuleGroupRecordingYaml, model.PrometheusRuleYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+// TestPrometheusRuleGroupResource_CreatePartialFailure checks that the check
+// rules written before a failure stay in state, so that Terraform deletes
+// them with the tainted resource.
+func TestPrometheusRuleGroupResource_CreatePartialFailure(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("CreateCheckRule", ctx, mock.MatchedBy(func(origin string) bool { return strings.HasSuffix(origin, "-availability--checkoutdown") }), mock.AnythingOfType("string"), "default").Return(nil)
+	mockClient.On("CreateCheckRule", ctx, mock.MatchedBy(func(origin string) bool { return strings.HasSuffix(origin, "-availability--cartdown") }), mock.AnythingOfType("string"), "default").Return(fmt.Errorf("internal server error"))
+
+	plan := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:               types.StringUnknown(),
+		Dataset:              types.StringValue("default"),
+		PrometheusRuleYaml:   types.StringValue(testPrometheusRuleGroupYaml),
+		CheckRuleOrigins:     types.ListUnknown(types.StringType),
+		RecordingRuleOrigins: types.ListUnknown(types.StringType),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	var model prometheusRuleGroupModel
+	resp.State.Get(ctx, &model)
+	origin := model.Origin.ValueString()
+	assert.Equal(t, testCheckRuleOrigins(t, origin+"-availability--checkoutdown", origin+"-availability--cartdown"), model.CheckRuleOrigins)
+	assert.Equal(t, testCheckRuleOrigins(t), model.RecordingRuleOrigins)
+}
+
+// TestPrometheusRuleGroupResource_Read checks that a drifted rule and a
+// deleted check rule are written back into the document in state.
+func TestPrometheusRuleGroupResource_Read(t *testing.T) {
+	ctx := context.Background()
+	parts, err := converter.SplitPrometheusRule(testPrometheusRuleGroupYaml)
+	require.NoError(t, err)
+
+	changed := strings.Replace(parts[1].Document, `up{job="cart"} == 0`, `up{job="cart"} < 1`, 1)
+	for name, tc := range map[string]struct {
+		second    string
+		secondErr error
+		wantRules int
+		wantExpr  string
+	}{
+		"unchanged":          {second: parts[1].Document, wantRules: 2},
+		"rule changed":       {second: changed, wantRules: 2, wantExpr: `up{job="cart"} < 1`},
+		"check rule deleted": {secondErr: &dash0.APIError{StatusCode: 404}, wantRules: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			r := &PrometheusRuleGroupResource{client: mockClient}
+			mockClient.On("GetCheckRule", ctx, testCheckoutDownOrigin, "default").Return(parts[0].Document, nil)
+			mockClient.On("GetCheckRule", ctx, testCartDownOrigin, "default").Return(tc.second, tc.secondErr)
+
+			state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+				Origin:             types.StringValue("tf_checkout"),
+				Dataset:            types.StringValue("default"),
+				PrometheusRuleYaml: types.StringValue(testPrometheusRuleGroupYaml),
+				CheckRuleOrigins:   testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin),
+			})
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var model prometheusRuleGroupModel
+			resp.State.Get(ctx, &model)
+			read, err := converter.SplitPrometheusRule(model.PrometheusRuleYaml.ValueString())
+			require.NoError(t, err)
+			assert.Len(t, read, tc.wantRules)
+			switch {
+			case tc.wantExpr != "":
+				assert.Contains(t, model.PrometheusRuleYaml.ValueString(), tc.wantExpr)
+			case tc.secondErr == nil:
+				assert.Equal(t, testPrometheusRuleGroupYaml, model.PrometheusRuleYaml.ValueString())
+			}
+		})
+	}
+}
+
+func TestPrometheusRuleGroupResource_ReadAllDeleted(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("GetCheckRule", ctx, mock.AnythingOfType("string"), "default").Return("", &dash0.APIError{StatusCode: 404})
+
+	state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:             types.StringValue("tf_checkout"),
+		Dataset:            types.StringValue("default"),
+		PrometheusRuleYaml: types.StringValue(testPrometheusRuleGroupYaml),
+		CheckRuleOrigins:   testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin),
+	})
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+// TestPrometheusRuleGroupResource_ReadRecordingRules checks that recording
+// rules changed in Dash0 are written back into their group.
+func TestPrometheusRuleGroupResource_ReadRecordingRules(t *testing.T) {
+	ctx := context.Background()
+	parts, err := converter.SplitPrometheusRule(testPrometheusRuleGroupRecordingYaml)
+	require.NoError(t, err)
+
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("GetCheckRule", ctx, testCheckoutDownOrigin, "default").Return(parts[0].Document, nil)
+	mockClient.On("GetCheckRule", ctx, testCartDownOrigin, "default").Return(parts[1].Document, nil)
+	mockClient.On("GetRecordingRule", ctx, testJobsOrigin, "default").Return(`{"apiVersion":"monitoring.coreos.com/v1","kind":"PrometheusRule","metadata":{"name":"checkout","labels":{"dash0.com/origin":"tf_checkout-jobs"}},"spec":{"groups":[{"name":"jobs","rules":[{"record":"job:up:sum","expr":"sum by (job) (up)"}]}]}}`, nil)
+
+	state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:               types.StringValue("tf_checkout"),
+		Dataset:              types.StringValue("default"),
+		PrometheusRuleYaml:   types.StringValue(testPrometheusRuleGroupRecordingYaml),
+		CheckRuleOrigins:     testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin),
+		RecordingRuleOrigins: testCheckRuleOrigins(t, testJobsOrigin),
+	})
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model prometheusRuleGroupModel
+	resp.State.Get(ctx, &model)
+	assert.Contains(t, model.PrometheusRuleYaml.ValueString(), "job:up:sum")
+	assert.NotContains(t, model.PrometheusRuleYaml.ValueString(), "job:up:avg")
+	assert.NotContains(t, model.PrometheusRuleYaml.ValueString(), "dash0.com/origin")
+}
+
+// TestPrometheusRuleGroupResource_Update checks that reordering rules,
+// adding one and removing one only writes the assets of those rules: the
+// remaining check rule is updated in place, the new one created, the removed
+// one deleted, and the recording rules of a group moved into the document are
+// created as a recording rule group.
+func TestPrometheusRuleGroupResource_Update(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("CreateCheckRule", ctx, "tf_checkout-availability--paymentdown", mock.AnythingOfType("string"), "default").Return(nil)
+	mockClient.On("UpdateCheckRule", ctx, testCartDownOrigin, mock.AnythingOfType("string"), "default").Return(nil)
+	mockClient.On("DeleteCheckRule", ctx, testCheckoutDownOrigin, "default").Return(nil)
+	mockClient.On("CreateRecordingRule", ctx, testJobsOrigin, mock.AnythingOfType("string"), "default").Return(nil)
+
+	state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:             types.StringValue("tf_checkout"),
+		Dataset:            types.StringValue("default"),
+		PrometheusRuleYaml: types.StringValue(testPrometheusRuleGroupYaml),
+		CheckRuleOrigins:   testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin),
+	})
+	plan := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:  types.StringValue("tf_checkout"),
+		Dataset: types.StringValue("default"),
+		PrometheusRuleYaml: types.StringValue(`apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+spec:
+  groups:
+    - name: availability
+      interval: 1m
+      rules:
+        - alert: PaymentDown
+          expr: up{job="payment"} == 0
+        - alert: CartDown
+          expr: up{job="cart"} == 0
+    - name: jobs
+      rules:
+        - record: job:up:sum
+          expr: sum by (job) (up)`),
+		CheckRuleOrigins:     types.ListUnknown(types.StringType),
+		RecordingRuleOrigins: types.ListUnknown(types.StringType),
+	})
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model prometheusRuleGroupModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, testCheckRuleOrigins(t, "tf_checkout-availability--paymentdown", testCartDownOrigin), model.CheckRuleOrigins)
+	assert.Equal(t, testCheckRuleOrigins(t, testJobsOrigin), model.RecordingRuleOrigins)
+	mockClient.AssertExpectations(t)
+}
+
+// TestPrometheusRuleGroupResource_UpdateFailedDelete checks that a check rule
+// that could not be deleted stays in state, so that the next apply retries.
+func TestPrometheusRuleGroupResource_UpdateFailedDelete(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("UpdateCheckRule", ctx, testCheckoutDownOrigin, mock.AnythingOfType("string"), "default").Return(nil)
+	mockClient.On("DeleteCheckRule", ctx, testCartDownOrigin, "default").Return(fmt.Errorf("internal server error"))
+
+	state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:             types.StringValue("tf_checkout"),
+		Dataset:            types.StringValue("default"),
+		PrometheusRuleYaml: types.StringValue(testPrometheusRuleGroupYaml),
+		CheckRuleOrigins:   testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin),
+	})
+	plan := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:               types.StringValue("tf_checkout"),
+		Dataset:              types.StringValue("default"),
+		PrometheusRuleYaml:   types.StringValue(strings.SplitAfter(testPrometheusRuleGroupYaml, "          for: 5m")[0]),
+		CheckRuleOrigins:     types.ListUnknown(types.StringType),
+		RecordingRuleOrigins: types.ListUnknown(types.StringType),
+	})
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: tfsdk.Plan(plan)}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	var model prometheusRuleGroupModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, testPrometheusRuleGroupYaml, model.PrometheusRuleYaml.ValueString(), "the previous document is kept")
+	assert.Equal(t, testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin), model.CheckRuleOrigins)
+}
+
+func TestPrometheusRuleGroupResource_Delete(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("DeleteCheckRule", ctx, testCartDownOrigin, "default").Return(&dash0.APIError{StatusCode: 404})
+	mockClient.On("DeleteCheckRule", ctx, testCheckoutDownOrigin, "default").Return(nil)
+	mockClient.On("DeleteRecordingRule", ctx, testJobsOrigin, "default").Return(nil)
+
+	state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{
+		Origin:               types.StringValue("tf_checkout"),
+		Dataset:              types.StringValue("default"),
+		PrometheusRuleYaml:   types.StringValue(testPrometheusRuleGroupRecordingYaml),
+		CheckRuleOrigins:     testCheckRuleOrigins(t, testCheckoutDownOrigin, testCartDownOrigin),
+		RecordingRuleOrigins: testCheckRuleOrigins(t, testJobsOrigin),
+	})
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+}
+
+func TestPrometheusRuleGroupResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	parts, err := converter.SplitPrometheusRule(testPrometheusRuleGroupRecordingYaml)
+	require.NoError(t, err)
+	recordingJSON, err := converter.ConvertYAMLToJSON(parts[2].Document)
+	require.NoError(t, err)
+
+	mockClient := &MockClient{}
+	r := &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("ListCheckRuleOrigins", ctx, "tf_checkout-", "default").Return([]string{testCheckoutDownOrigin, testCartDownOrigin}, nil)
+	mockClient.On("ListRecordingRuleOrigins", ctx, "tf_checkout-", "default").Return([]string{testJobsOrigin}, nil)
+	mockClient.On("GetCheckRule", ctx, testCheckoutDownOrigin, "default").Return(parts[0].Document, nil)
+	mockClient.On("GetCheckRule", ctx, testCartDownOrigin, "default").Return(parts[1].Document, nil)
+	mockClient.On("GetRecordingRule", ctx, testJobsOrigin, "default").Return(recordingJSON, nil)
+
+	state := prometheusRuleGroupState(t, r, prometheusRuleGroupModel{})
+	resp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "default,tf_checkout"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model prometheusRuleGroupModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "tf_checkout", model.Origin.ValueString())
+	assert.Equal(t, testCheckRuleOrigins(t, testCartDownOrigin, testCheckoutDownOrigin), model.CheckRuleOrigins, "origins are sorted")
+	assert.Equal(t, testCheckRuleOrigins(t, testJobsOrigin), model.RecordingRuleOrigins)
+	imported, err := converter.SplitPrometheusRule(model.PrometheusRuleYaml.ValueString())
+	require.NoError(t, err)
+	require.Len(t, imported, 3)
+	assert.ElementsMatch(t, []string{parts[0].Key, parts[1].Key, parts[2].Key}, []string{imported[0].Key, imported[1].Key, imported[2].Key})
+
+	resp = resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_checkout"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	mockClient = &MockClient{}
+	r = &PrometheusRuleGroupResource{client: mockClient}
+	mockClient.On("ListCheckRuleOrigins", ctx, "tf_missing-", "default").Return(nil, nil)
+	mockClient.On("ListRecordingRuleOrigins", ctx, "tf_missing-", "default").Return(nil, nil)
+	resp = resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "default,tf_missing"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError(), "a group without rules cannot be imported")
+}
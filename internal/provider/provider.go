@@ -8,8 +8,12 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -41,10 +45,17 @@ type dash0Provider struct {
 
 // provider-level config model
 type providerConfigModel struct {
-	URL        types.String `tfsdk:"url"`
-	AuthToken  types.String `tfsdk:"auth_token"`
-	Profile    types.String `tfsdk:"profile"`
-	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	URL                  types.String `tfsdk:"url"`
+	AuthToken            types.String `tfsdk:"auth_token"`
+	Profile              types.String `tfsdk:"profile"`
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+	OperationBudget      types.String `tfsdk:"operation_budget"`
+	AuditLogPath         types.String `tfsdk:"audit_log_path"`
+	SummaryPath          types.String `tfsdk:"summary_path"`
+	ReadOnly             types.Bool   `tfsdk:"read_only"`
+	PreflightPermissions types.Bool   `tfsdk:"preflight_permissions"`
+	IgnoredFields        types.Map    `tfsdk:"ignored_fields"`
+	DefaultLabels        types.Map    `tfsdk:"default_labels"`
 }
 
 // Metadata returns the provider type name.
@@ -74,6 +85,36 @@ func providerSchema() schema.Schema {
 				Optional:    true,
 				Description: "Maximum number of retries for failed API requests (0–5). If omitted, the DASH0_MAX_RETRIES environment variable is used. Defaults to 3.",
 			},
+			"operation_budget": schema.StringAttribute{
+				Optional:    true,
+				Description: "Total wall-clock time the provider may spend on Dash0 API requests during a single Terraform run, retries and backoff waits included, as a Go duration string (e.g. `\"15m\"`). Once it has elapsed, in-flight requests are cancelled and every remaining operation fails immediately. If omitted, the DASH0_OPERATION_BUDGET environment variable is used. Unlimited by default.",
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file to which the provider appends a JSON line for every create, update and delete it performs (time, operation, kind, dataset, origin and outcome), independently of Terraform's own logs. The file is created if it does not exist. If omitted, the DASH0_AUDIT_LOG_PATH environment variable is used. Disabled by default.",
+			},
+			"summary_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file to which the provider writes a JSON summary of the run: the creates, updates and deletes per kind, failed operations, API requests, the time spent on them, retries and rate-limited responses. The file is rewritten after every request, so it holds the totals when Terraform exits. If omitted, the DASH0_SUMMARY_PATH environment variable is used. Disabled by default.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When `true`, the provider refuses to create, update or delete anything: every mutating operation fails with an error before a request is sent, while plans, refreshes and imports work as usual. Use it for scheduled drift-detection plans that must never write. Read-only mode is enabled when either this attribute or the DASH0_READ_ONLY environment variable is `true`; setting either of them to `false` does not turn off the other. Defaults to `false`.",
+			},
+			"preflight_permissions": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When `true`, the provider reads the permissions of the auth token at plan time and fails the plan with one error per dataset that lists the missing permissions, instead of an apply that stops with 403 Forbidden after some objects have been written. Checks `dataset:read` for every resource in a dataset, and `dataset:createCheckRule`, `dataset:createSyntheticCheck`, `dataset:createRecordingRuleGroup` and `dataset:createSLO` for check rules, synthetic checks, recording rules and SLOs that are about to be created. Updates and deletes are authorized per object and are not checked. If omitted, the DASH0_PREFLIGHT_PERMISSIONS environment variable is used. Defaults to `false`.",
+			},
+			"ignored_fields": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Definition fields to leave out of drift detection, keyed by resource type, for example `{ dash0_dashboard = [\"spec.display.description\"] }`. Fields are dot-separated paths into the YAML definition as returned by the API. Use it for fields that are injected on the server side, for example by custom enrichers, so that they do not show up as changes in every plan. The fields are still sent to the API when they are declared in the YAML.",
+			},
+			"default_labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Labels merged into `metadata.labels` of every definition the provider creates or updates, for example `{ \"dash0.com/owner\" = \"platform\" }` to record ownership across a whole workspace. Applies to every resource with a `labels` attribute; check rules carry them in the labels of every rule. Labels declared in the YAML or in the `labels` attribute of a resource take precedence. The labels a resource ends up with are recorded in its computed `labels_all` attribute, so changing the defaults plans an update of every affected resource.",
+			},
 		},
 	}
 }
@@ -92,6 +133,90 @@ func getEnvURL() string {
 	return os.Getenv("DASH0_URL")
 }
 
+// reportUnknownConfig adds an error for every provider attribute that is
+// unknown and not overridden by its environment variable. Terraform passes
+// unknown values to Configure when the provider block refers to attributes of
+// resources that have not been created yet; the provider cannot build a
+// client from them, and treating them as unset would report them as missing.
+func reportUnknownConfig(cfg *providerConfigModel, diags *diag.Diagnostics) {
+	attributes := []struct {
+		name  string
+		value attr.Value
+		env   string
+	}{
+		{"url", cfg.URL, "DASH0_API_URL"},
+		{"auth_token", cfg.AuthToken, "DASH0_AUTH_TOKEN"},
+		{"profile", cfg.Profile, ""},
+		{"max_retries", cfg.MaxRetries, "DASH0_MAX_RETRIES"},
+		{"operation_budget", cfg.OperationBudget, "DASH0_OPERATION_BUDGET"},
+		{"audit_log_path", cfg.AuditLogPath, "DASH0_AUDIT_LOG_PATH"},
+		{"summary_path", cfg.SummaryPath, "DASH0_SUMMARY_PATH"},
+		{"read_only", cfg.ReadOnly, "DASH0_READ_ONLY"},
+		{"preflight_permissions", cfg.PreflightPermissions, "DASH0_PREFLIGHT_PERMISSIONS"},
+		{"ignored_fields", cfg.IgnoredFields, ""},
+		{"default_labels", cfg.DefaultLabels, ""},
+	}
+	for _, a := range attributes {
+		if !a.value.IsUnknown() {
+			continue
+		}
+		alternative := ""
+		if a.env != "" {
+			if os.Getenv(a.env) != "" || (a.name == "url" && getEnvURL() != "") {
+				continue
+			}
+			alternative = fmt.Sprintf(", set the %s environment variable instead", a.env)
+		}
+		diags.AddAttributeError(
+			path.Root(a.name),
+			"Unknown Dash0 provider attribute",
+			fmt.Sprintf("The provider cannot be configured because `%s` depends on a value that is only known after apply, "+
+				"such as an attribute of a resource that has not been created yet. Set it to a value known at plan time%s, "+
+				"or create the resource it depends on first with `terraform apply -target`.", a.name, alternative),
+		)
+	}
+}
+
+// ignoredFields decodes the `ignored_fields` provider attribute and checks
+// that every key names a resource type of this provider and every field is a
+// non-empty path.
+func (p *dash0Provider) ignoredFields(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string][]string {
+	fields := map[string][]string{}
+	if value.IsNull() {
+		return fields
+	}
+	diags.Append(value.ElementsAs(ctx, &fields, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	resourceTypes := map[string]bool{}
+	for _, newResource := range p.Resources(ctx) {
+		metadataResp := &resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dash0"}, metadataResp)
+		resourceTypes[metadataResp.TypeName] = true
+	}
+	for resourceType, paths := range fields {
+		if !resourceTypes[resourceType] {
+			diags.AddAttributeError(
+				path.Root("ignored_fields").AtMapKey(resourceType),
+				"Invalid ignored_fields",
+				fmt.Sprintf("%q is not a resource type of the Dash0 provider.", resourceType),
+			)
+		}
+		for _, fieldPath := range paths {
+			if fieldPath == "" || strings.HasPrefix(fieldPath, ".") || strings.HasSuffix(fieldPath, ".") || strings.Contains(fieldPath, "..") {
+				diags.AddAttributeError(
+					path.Root("ignored_fields").AtMapKey(resourceType),
+					"Invalid ignored_fields",
+					fmt.Sprintf("%q is not a dot-separated field path such as \"spec.display.description\".", fieldPath),
+				)
+			}
+		}
+	}
+	return fields
+}
+
 // loadProfileConfiguration resolves a dash0 CLI profile to a Configuration.
 // If profileName is empty, the active profile from the CLI config directory is
 // used. Profile lookup is delegated to dash0-api-client-go's profiles package,
@@ -134,6 +259,11 @@ type authInfo struct {
 	url     string
 	token   string
 	isOAuth bool
+	// fromProfile reports whether the token was loaded from the CLI profile
+	// named by profile (empty for the active profile), which can then be
+	// re-read to refresh it.
+	fromProfile bool
+	profile     string
 }
 
 // resolveAuthInfo computes the Dash0 URL and auth token according to the
@@ -181,15 +311,30 @@ func resolveAuthInfo(ctx context.Context, cfg *providerConfigModel) (authInfo, e
 		return authInfo{url: url, token: authToken}, err
 	}
 
-	isOAuth := false
-	if url == "" {
-		url = profileCfg.ApiUrl
+	info := authInfo{url: url, token: authToken}
+	if info.url == "" {
+		info.url = profileCfg.ApiUrl
+	}
+	if info.token == "" {
+		info.token = profileCfg.AuthToken
+		info.isOAuth = profileCfg.OAuth != nil
+		info.fromProfile = true
+		info.profile = profileName
 	}
-	if authToken == "" {
-		authToken = profileCfg.AuthToken
-		isOAuth = profileCfg.OAuth != nil
+	return info, nil
+}
+
+// profileTokenSource returns a client.TokenSource that re-reads the auth token
+// from the dash0 CLI profile, refreshing OAuth tokens on the way (see
+// loadProfileConfiguration).
+func profileTokenSource(profileName string) client.TokenSource {
+	return func(ctx context.Context) (string, error) {
+		cfg, err := loadProfileConfiguration(ctx, profileName)
+		if err != nil {
+			return "", err
+		}
+		return cfg.AuthToken, nil
 	}
-	return authInfo{url: url, token: authToken, isOAuth: isOAuth}, nil
 }
 
 // Configure prepares a Dash0 API client for data sources and resources.
@@ -202,6 +347,11 @@ func (p *dash0Provider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	reportUnknownConfig(&cfg, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if os.Getenv("DASH0_API_URL") == "" && os.Getenv("DASH0_URL") != "" {
 		tflog.Warn(ctx, "DASH0_URL is deprecated; please switch to DASH0_API_URL")
 	}
@@ -278,14 +428,53 @@ func (p *dash0Provider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	// Resolve operation budget: env var > provider attribute > unlimited
+	var operationBudget time.Duration
+	operationBudgetValue, operationBudgetSource := os.Getenv("DASH0_OPERATION_BUDGET"), "DASH0_OPERATION_BUDGET environment variable"
+	if operationBudgetValue == "" && !cfg.OperationBudget.IsNull() && !cfg.OperationBudget.IsUnknown() {
+		operationBudgetValue, operationBudgetSource = cfg.OperationBudget.ValueString(), "operation_budget provider attribute"
+	}
+	if operationBudgetValue != "" {
+		parsed, err := time.ParseDuration(operationBudgetValue)
+		if err != nil || parsed <= 0 {
+			resp.Diagnostics.AddError(
+				"Invalid operation_budget",
+				fmt.Sprintf("operation_budget must be a positive duration such as \"15m\" or \"1h30m\", got: %q (from %s)", operationBudgetValue, operationBudgetSource),
+			)
+			return
+		}
+		operationBudget = parsed
+	}
+
 	ctx = tflog.SetField(ctx, "dash0_url", auth.url)
 	ctx = tflog.SetField(ctx, "dash0_auth_token", auth.token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "dash0_auth_token")
 
 	tflog.Debug(ctx, "Creating Dash0 client")
 
+	var summary *client.OperationSummary
+	if summaryPath := cmp.Or(os.Getenv("DASH0_SUMMARY_PATH"), cfg.SummaryPath.ValueString()); summaryPath != "" {
+		summary, err = client.NewOperationSummary(summaryPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Write Operation Summary",
+				fmt.Sprintf("The operation summary at %q could not be written: %s", summaryPath, err),
+			)
+			return
+		}
+	}
+
 	// Create dash0Client configuration for data sources and resources
-	dash0Client, err := client.NewDash0Client(auth.url, auth.token, p.version, maxRetries)
+	clientOpts := []client.Option{client.WithOperationBudget(operationBudget)}
+	if summary != nil {
+		clientOpts = append(clientOpts, client.WithOperationSummary(summary))
+	}
+	if auth.fromProfile {
+		// Tokens from a CLI profile may be short-lived; re-read the profile
+		// when one expires mid-apply.
+		clientOpts = append(clientOpts, client.WithTokenRefresh(profileTokenSource(auth.profile)))
+	}
+	dash0Client, err := client.NewDash0Client(auth.url, auth.token, p.version, maxRetries, clientOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Dash0 API Client",
@@ -294,15 +483,87 @@ func (p *dash0Provider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	resp.DataSourceData = dash0Client
-	resp.ResourceData = dash0Client
+	// Resolve read-only mode: enabled when either the provider attribute or
+	// the env var is true, so the environment cannot make a workspace that is
+	// configured to never write writable.
+	readOnly := cfg.ReadOnly.ValueBool()
+	if readOnlyStr := os.Getenv("DASH0_READ_ONLY"); readOnlyStr != "" {
+		parsed, err := strconv.ParseBool(readOnlyStr)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid DASH0_READ_ONLY",
+				"The DASH0_READ_ONLY environment variable must be a boolean (true or false): "+err.Error(),
+			)
+			return
+		}
+		readOnly = readOnly || parsed
+	}
+
+	// Resolve the permission preflight: env var > provider attribute > disabled
+	preflight := cfg.PreflightPermissions.ValueBool()
+	if preflightStr := os.Getenv("DASH0_PREFLIGHT_PERMISSIONS"); preflightStr != "" {
+		parsed, err := strconv.ParseBool(preflightStr)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid DASH0_PREFLIGHT_PERMISSIONS",
+				"The DASH0_PREFLIGHT_PERMISSIONS environment variable must be a boolean (true or false): "+err.Error(),
+			)
+			return
+		}
+		preflight = parsed
+	}
+
+	var apiClient client.Client = dash0Client
+	if readOnly {
+		tflog.Info(ctx, "Dash0 provider is read-only; all create, update and delete operations will be rejected")
+		apiClient = client.NewReadOnlyClient(apiClient)
+	}
+	auditLogPath := cmp.Or(os.Getenv("DASH0_AUDIT_LOG_PATH"), cfg.AuditLogPath.ValueString())
+	if auditLogPath != "" {
+		apiClient, err = client.NewAuditingClient(apiClient, auditLogPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Open Audit Log",
+				fmt.Sprintf("The audit log at %q could not be opened for writing: %s", auditLogPath, err),
+			)
+			return
+		}
+	}
+
+	if summary != nil {
+		apiClient = client.NewSummarizingClient(apiClient, summary)
+	}
+
+	ignoredFields := p.ignoredFields(ctx, cfg.IgnoredFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultLabels := map[string]string{}
+	if !cfg.DefaultLabels.IsNull() {
+		resp.Diagnostics.Append(cfg.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := &providerData{Client: apiClient, plannedNames: newPlannedNames(), ignoredFields: ignoredFields, defaultLabels: defaultLabels}
+	if preflight {
+		data.permissions = newPermissionPreflight(apiClient)
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Dash0 client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *dash0Provider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewFailedChecksDataSource,
+		NewCheckRulePreviewDataSource,
+		NewAlertmanagerConversionDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -312,9 +573,21 @@ func (p *dash0Provider) Resources(_ context.Context) []func() resource.Resource
 		NewSyntheticCheckResource,
 		NewViewResource,
 		NewCheckRuleResource,
+		NewPrometheusRuleGroupResource,
 		NewRecordingRuleResource,
+		NewSLOResource,
+		NewTraceSamplingRuleResource,
 		NewNotificationChannelResource,
+		NewSlackNotificationChannelResource,
+		NewPagerDutyNotificationChannelResource,
+		NewWebhookNotificationChannelResource,
+		NewEmailNotificationChannelResource,
+		NewMSTeamsNotificationChannelResource,
+		NewOpsgenieNotificationChannelResource,
 		NewSpamFilterResource,
 		NewTeamResource,
+		NewMemberResource,
+		NewTeamMembershipResource,
+		NewGenericResource,
 	}
 }
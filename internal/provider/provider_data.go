@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// providerData is the data the provider hands to its resources and data
+// sources on configure. It embeds the API client, so resources that only need
+// the client keep asserting client.Client, and carries the provider-level
+// settings and the state that is shared between resources for the duration of
+// one Terraform operation.
+type providerData struct {
+	client.Client
+	plannedNames *plannedNames
+	// ignoredFields holds the `ignored_fields` provider attribute: per
+	// resource type, the definition fields left out of drift detection.
+	ignoredFields map[string][]string
+	// defaultLabels holds the `default_labels` provider attribute.
+	defaultLabels map[string]string
+	// permissions is set when the `preflight_permissions` provider attribute
+	// is enabled.
+	permissions *permissionPreflight
+}
+
+// plannedNamesOf returns the planned-name registry carried by the provider
+// data, or nil when the provider data does not carry one.
+func plannedNamesOf(data any) *plannedNames {
+	if data, ok := data.(*providerData); ok {
+		return data.plannedNames
+	}
+	return nil
+}
+
+// ignoredFieldsOf returns the fields configured to be left out of drift
+// detection for resourceType, or nil when none are configured.
+func ignoredFieldsOf(data any, resourceType string) []string {
+	if data, ok := data.(*providerData); ok {
+		return data.ignoredFields[resourceType]
+	}
+	return nil
+}
+
+// defaultLabelsOf returns the labels configured to be merged into every
+// definition the provider sends to the API, or nil when none are configured.
+func defaultLabelsOf(data any) map[string]string {
+	if data, ok := data.(*providerData); ok {
+		return data.defaultLabels
+	}
+	return nil
+}
+
+// permissionPreflightOf returns the permission preflight carried by the
+// provider data, or nil when the preflight is disabled.
+func permissionPreflightOf(data any) *permissionPreflight {
+	if data, ok := data.(*providerData); ok {
+		return data.permissions
+	}
+	return nil
+}
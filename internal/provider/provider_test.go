@@ -2,17 +2,24 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
 )
 
 // profilesFixture is a small set of profiles used by tests that exercise the
@@ -88,26 +95,36 @@ func providerTestConfig(url, authToken, profile *string, maxRetries *int64) tfsd
 		}
 		return tftypes.NewValue(tftypes.Number, *p)
 	}
+	return providerTestConfigValues(map[string]tftypes.Value{
+		"url":         stringVal(url),
+		"auth_token":  stringVal(authToken),
+		"profile":     stringVal(profile),
+		"max_retries": numberVal(maxRetries),
+	})
+}
+
+// providerTestConfigValues builds a tfsdk.Config for provider tests from raw
+// attribute values. Attributes missing from values are left null.
+func providerTestConfigValues(values map[string]tftypes.Value) tfsdk.Config {
+	s := providerSchema()
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
 	return tfsdk.Config{
-		Raw: tftypes.NewValue(tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"url":         tftypes.String,
-				"auth_token":  tftypes.String,
-				"profile":     tftypes.String,
-				"max_retries": tftypes.Number,
-			},
-		}, map[string]tftypes.Value{
-			"url":         stringVal(url),
-			"auth_token":  stringVal(authToken),
-			"profile":     stringVal(profile),
-			"max_retries": numberVal(maxRetries),
-		}),
-		Schema: providerSchema(),
+		Raw:    tftypes.NewValue(objectType, attrs),
+		Schema: s,
 	}
 }
 
 func strPtr(s string) *string { return &s }
 func int64Ptr(n int64) *int64 { return &n }
+func boolPtr(b bool) *bool    { return &b }
 
 func TestDash0Provider_Metadata(t *testing.T) {
 	p := &dash0Provider{version: "1.0.0"}
@@ -126,7 +143,7 @@ func TestDash0Provider_Schema(t *testing.T) {
 	assert.NotNil(t, resp.Schema)
 	assert.Contains(t, resp.Schema.Description, "observability platform")
 
-	for _, name := range []string{"url", "auth_token", "profile", "max_retries"} {
+	for _, name := range []string{"url", "auth_token", "profile", "max_retries", "operation_budget", "audit_log_path", "summary_path", "read_only", "preflight_permissions", "ignored_fields", "default_labels"} {
 		assert.Contains(t, resp.Schema.Attributes, name)
 	}
 
@@ -427,16 +444,399 @@ func TestDash0Provider_Configure_MaxRetries(t *testing.T) {
 	}
 }
 
+func TestDash0Provider_Configure_OperationBudget(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		attrValue   *string
+		expectError bool
+		errorDetail string
+	}{
+		{name: "env: valid duration", envValue: "15m"},
+		{name: "attr: valid duration", attrValue: strPtr("1h30m")},
+		{
+			name: "env: not a duration", envValue: "fifteen minutes",
+			expectError: true, errorDetail: "DASH0_OPERATION_BUDGET environment variable",
+		},
+		{
+			name: "attr: missing unit", attrValue: strPtr("900"),
+			expectError: true, errorDetail: "operation_budget provider attribute",
+		},
+		{
+			name: "attr: zero", attrValue: strPtr("0s"),
+			expectError: true, errorDetail: "must be a positive duration",
+		},
+		{
+			name: "attr: negative", attrValue: strPtr("-5m"),
+			expectError: true, errorDetail: "must be a positive duration",
+		},
+		{
+			name: "env takes precedence over attr (env invalid)", envValue: "soon", attrValue: strPtr("10m"),
+			expectError: true, errorDetail: "DASH0_OPERATION_BUDGET environment variable",
+		},
+		{name: "unset means unlimited"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearCredentialEnv(t)
+			t.Setenv("DASH0_API_URL", "https://api.example.com")
+			t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+			t.Setenv("DASH0_OPERATION_BUDGET", tt.envValue)
+
+			values := map[string]tftypes.Value{}
+			if tt.attrValue != nil {
+				values["operation_budget"] = tftypes.NewValue(tftypes.String, *tt.attrValue)
+			}
+
+			p := &dash0Provider{}
+			req := provider.ConfigureRequest{Config: providerTestConfigValues(values)}
+			resp := &provider.ConfigureResponse{}
+			p.Configure(context.Background(), req, resp)
+
+			if tt.expectError {
+				require.Len(t, resp.Diagnostics.Errors(), 1)
+				assert.Equal(t, "Invalid operation_budget", resp.Diagnostics.Errors()[0].Summary())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.errorDetail)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.NotNil(t, resp.ResourceData)
+			}
+		})
+	}
+}
+
+func TestDash0Provider_Configure_AuditLogPath(t *testing.T) {
+	t.Run("attr enables the audit log", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("DASH0_API_URL", "https://api.example.com")
+		t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+		t.Setenv("DASH0_AUDIT_LOG_PATH", "")
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{Config: providerTestConfigValues(map[string]tftypes.Value{
+			"audit_log_path": tftypes.NewValue(tftypes.String, path),
+		})}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.False(t, resp.Diagnostics.HasError())
+		assert.FileExists(t, path)
+		assert.Equal(t, resp.ResourceData, resp.DataSourceData)
+	})
+
+	t.Run("env overrides attr", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("DASH0_API_URL", "https://api.example.com")
+		t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+		dir := t.TempDir()
+		t.Setenv("DASH0_AUDIT_LOG_PATH", filepath.Join(dir, "env.jsonl"))
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{Config: providerTestConfigValues(map[string]tftypes.Value{
+			"audit_log_path": tftypes.NewValue(tftypes.String, filepath.Join(dir, "attr.jsonl")),
+		})}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.False(t, resp.Diagnostics.HasError())
+		assert.FileExists(t, filepath.Join(dir, "env.jsonl"))
+		assert.NoFileExists(t, filepath.Join(dir, "attr.jsonl"))
+	})
+
+	t.Run("unwritable path fails configure", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("DASH0_API_URL", "https://api.example.com")
+		t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+		t.Setenv("DASH0_AUDIT_LOG_PATH", filepath.Join(t.TempDir(), "missing", "audit.jsonl"))
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{Config: providerTestConfig(nil, nil, nil, nil)}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		assert.Equal(t, "Unable to Open Audit Log", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
+
+func TestDash0Provider_Configure_SummaryPath(t *testing.T) {
+	t.Run("attr enables the summary", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("DASH0_API_URL", "https://api.example.com")
+		t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+		t.Setenv("DASH0_SUMMARY_PATH", "")
+		path := filepath.Join(t.TempDir(), "summary.json")
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{Config: providerTestConfigValues(map[string]tftypes.Value{
+			"summary_path": tftypes.NewValue(tftypes.String, path),
+		})}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.False(t, resp.Diagnostics.HasError())
+		assert.FileExists(t, path)
+	})
+
+	t.Run("unwritable path fails configure", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("DASH0_API_URL", "https://api.example.com")
+		t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+		t.Setenv("DASH0_SUMMARY_PATH", filepath.Join(t.TempDir(), "missing", "summary.json"))
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{Config: providerTestConfig(nil, nil, nil, nil)}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		assert.Equal(t, "Unable to Write Operation Summary", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
+
+func TestDash0Provider_Configure_ReadOnly(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		attrValue    *bool
+		wantReadOnly bool
+		wantError    string
+	}{
+		{name: "unset is writable"},
+		{name: "attr true", attrValue: boolPtr(true), wantReadOnly: true},
+		{name: "attr false", attrValue: boolPtr(false)},
+		{name: "env true", envValue: "true", wantReadOnly: true},
+		{name: "env false does not override attr true", envValue: "false", attrValue: boolPtr(true), wantReadOnly: true},
+		{name: "env true overrides attr false", envValue: "true", attrValue: boolPtr(false), wantReadOnly: true},
+		{name: "env not a boolean", envValue: "sometimes", wantError: "Invalid DASH0_READ_ONLY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deletes atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					deletes.Add(1)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(server.Close)
+
+			clearCredentialEnv(t)
+			t.Setenv("DASH0_API_URL", server.URL)
+			t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+			t.Setenv("DASH0_READ_ONLY", tt.envValue)
+
+			values := map[string]tftypes.Value{}
+			if tt.attrValue != nil {
+				values["read_only"] = tftypes.NewValue(tftypes.Bool, *tt.attrValue)
+			}
+
+			p := &dash0Provider{}
+			req := provider.ConfigureRequest{Config: providerTestConfigValues(values)}
+			resp := &provider.ConfigureResponse{}
+			p.Configure(context.Background(), req, resp)
+
+			if tt.wantError != "" {
+				require.Len(t, resp.Diagnostics.Errors(), 1)
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError())
+
+			c, ok := resp.ResourceData.(client.Client)
+			require.True(t, ok)
+			err := c.DeleteDashboard(context.Background(), "tf_test", "default")
+			if tt.wantReadOnly {
+				assert.ErrorIs(t, err, client.ErrReadOnly)
+				assert.Equal(t, int32(0), deletes.Load())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(1), deletes.Load())
+			}
+		})
+	}
+}
+
+func TestDash0Provider_Configure_IgnoredFields(t *testing.T) {
+	ignoredFieldsType := tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.String}}
+	ignoredFields := func(resourceType string, paths ...string) tftypes.Value {
+		elements := []tftypes.Value{}
+		for _, p := range paths {
+			elements = append(elements, tftypes.NewValue(tftypes.String, p))
+		}
+		return tftypes.NewValue(ignoredFieldsType, map[string]tftypes.Value{
+			resourceType: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		value     tftypes.Value
+		wantError string
+	}{
+		{name: "valid", value: ignoredFields("dash0_dashboard", "spec.display.description")},
+		{name: "unknown resource type", value: ignoredFields("dash0_dashboards", "spec"), wantError: `"dash0_dashboards" is not a resource type`},
+		{name: "malformed path", value: ignoredFields("dash0_view", "spec..display"), wantError: `"spec..display" is not a dot-separated field path`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearCredentialEnv(t)
+			t.Setenv("DASH0_API_URL", "https://api.example.com")
+			t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+
+			p := &dash0Provider{}
+			req := provider.ConfigureRequest{Config: providerTestConfigValues(map[string]tftypes.Value{"ignored_fields": tt.value})}
+			resp := &provider.ConfigureResponse{}
+			p.Configure(context.Background(), req, resp)
+
+			if tt.wantError != "" {
+				require.Len(t, resp.Diagnostics.Errors(), 1)
+				assert.Equal(t, "Invalid ignored_fields", resp.Diagnostics.Errors()[0].Summary())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, []string{"spec.display.description"}, ignoredFieldsOf(resp.ResourceData, "dash0_dashboard"))
+			assert.Nil(t, ignoredFieldsOf(resp.ResourceData, "dash0_view"))
+		})
+	}
+}
+
+func TestDash0Provider_Configure_DefaultLabels(t *testing.T) {
+	clearCredentialEnv(t)
+	t.Setenv("DASH0_API_URL", "https://api.example.com")
+	t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+
+	p := &dash0Provider{}
+	req := provider.ConfigureRequest{Config: providerTestConfigValues(map[string]tftypes.Value{
+		"default_labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"dash0.com/owner": tftypes.NewValue(tftypes.String, "platform"),
+		}),
+	})}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, map[string]string{"dash0.com/owner": "platform"}, defaultLabelsOf(resp.ResourceData))
+
+	r := NewDashboardResource().(*DashboardResource)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: resp.ResourceData}, &resource.ConfigureResponse{})
+	assert.Equal(t, map[string]string{"dash0.com/owner": "platform"}, r.defaultLabels)
+}
+
+// TestDash0Provider_Configure_UnknownValues covers provider blocks that refer
+// to attributes of resources that have not been created yet: Terraform then
+// passes unknown values to Configure during plan.
+func TestDash0Provider_Configure_UnknownValues(t *testing.T) {
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	t.Run("unknown auth token", func(t *testing.T) {
+		clearCredentialEnv(t)
+		values := map[string]tftypes.Value{
+			"url":        tftypes.NewValue(tftypes.String, "https://api.example.com"),
+			"auth_token": unknown,
+		}
+
+		p := &dash0Provider{}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: providerTestConfigValues(values)}, resp)
+
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		assert.Equal(t, "Unknown Dash0 provider attribute", resp.Diagnostics.Errors()[0].Summary())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "`auth_token` depends on a value that is only known after apply")
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "DASH0_AUTH_TOKEN")
+		assert.Nil(t, resp.ResourceData)
+	})
+
+	t.Run("every unknown attribute is reported", func(t *testing.T) {
+		clearCredentialEnv(t)
+		values := map[string]tftypes.Value{
+			"url":       unknown,
+			"profile":   unknown,
+			"read_only": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+		}
+
+		p := &dash0Provider{}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: providerTestConfigValues(values)}, resp)
+
+		assert.Len(t, resp.Diagnostics.Errors(), 3)
+	})
+
+	t.Run("environment variable overrides unknown attribute", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("DASH0_API_URL", "https://api.example.com")
+		t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+		values := map[string]tftypes.Value{
+			"url":        unknown,
+			"auth_token": unknown,
+		}
+
+		p := &dash0Provider{}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: providerTestConfigValues(values)}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.NotNil(t, resp.ResourceData)
+	})
+}
+
+func TestDash0Provider_Configure_PreflightPermissions(t *testing.T) {
+	tests := []struct {
+		name          string
+		envValue      string
+		attrValue     *bool
+		wantPreflight bool
+		wantError     string
+	}{
+		{name: "unset is disabled"},
+		{name: "attr true", attrValue: boolPtr(true), wantPreflight: true},
+		{name: "env true", envValue: "true", wantPreflight: true},
+		{name: "env false overrides attr true", envValue: "false", attrValue: boolPtr(true)},
+		{name: "env not a boolean", envValue: "sometimes", wantError: "Invalid DASH0_PREFLIGHT_PERMISSIONS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearCredentialEnv(t)
+			t.Setenv("DASH0_API_URL", "https://api.example.com")
+			t.Setenv("DASH0_AUTH_TOKEN", "auth_test_token_123")
+			t.Setenv("DASH0_PREFLIGHT_PERMISSIONS", tt.envValue)
+
+			values := map[string]tftypes.Value{}
+			if tt.attrValue != nil {
+				values["preflight_permissions"] = tftypes.NewValue(tftypes.Bool, *tt.attrValue)
+			}
+
+			p := &dash0Provider{}
+			resp := &provider.ConfigureResponse{}
+			p.Configure(context.Background(), provider.ConfigureRequest{Config: providerTestConfigValues(values)}, resp)
+
+			if tt.wantError != "" {
+				require.Len(t, resp.Diagnostics.Errors(), 1)
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.wantPreflight, permissionPreflightOf(resp.ResourceData) != nil)
+		})
+	}
+}
+
 func TestDash0Provider_DataSources(t *testing.T) {
 	p := &dash0Provider{}
 	dataSources := p.DataSources(context.Background())
-	assert.Empty(t, dataSources)
+	assert.Len(t, dataSources, 3)
 }
 
 func TestDash0Provider_Resources(t *testing.T) {
 	p := &dash0Provider{}
 	resources := p.Resources(context.Background())
-	assert.Len(t, resources, 8)
+	assert.Len(t, resources, 20)
 }
 
 // TestResolveAuthInfo_Precedence pins the precedence order in a single place
@@ -458,6 +858,7 @@ func TestResolveAuthInfo_Precedence(t *testing.T) {
 		assert.Equal(t, "https://env.example.com", auth.url)
 		assert.Equal(t, "auth_env", auth.token)
 		assert.False(t, auth.isOAuth)
+		assert.False(t, auth.fromProfile)
 	})
 
 	t.Run("attr beats profile when env absent", func(t *testing.T) {
@@ -496,9 +897,31 @@ func TestResolveAuthInfo_Precedence(t *testing.T) {
 		assert.Equal(t, "https://api.us-west-1.aws.dash0.com", auth.url)
 		assert.Equal(t, "auth_bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", auth.token)
 		assert.False(t, auth.isOAuth)
+		assert.True(t, auth.fromProfile)
+		assert.Equal(t, "test2", auth.profile)
 	})
 }
 
+// TestProfileTokenSource verifies that the token source re-reads the CLI
+// profile on every call, so a token refreshed on disk is picked up mid-apply.
+func TestProfileTokenSource(t *testing.T) {
+	clearCredentialEnv(t)
+	setupCLIConfigDir(t, "test1", profilesFixture)
+
+	source := profileTokenSource("test2")
+	token, err := source(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "auth_bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", token)
+
+	setupCLIConfigDir(t, "test1", strings.ReplaceAll(profilesFixture, "auth_bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "auth_refreshed"))
+	token, err = source(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "auth_refreshed", token)
+
+	_, err = profileTokenSource("does-not-exist")(context.Background())
+	assert.Error(t, err)
+}
+
 // TestResolveAuthInfo_OAuth tests OAuth-specific behavior.
 func TestResolveAuthInfo_OAuth(t *testing.T) {
 	ctx := context.Background()
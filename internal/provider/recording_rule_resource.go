@@ -24,9 +24,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &RecordingRuleResource{}
-	_ resource.ResourceWithConfigure   = &RecordingRuleResource{}
-	_ resource.ResourceWithImportState = &RecordingRuleResource{}
+	_ resource.Resource                   = &RecordingRuleResource{}
+	_ resource.ResourceWithConfigure      = &RecordingRuleResource{}
+	_ resource.ResourceWithImportState    = &RecordingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &RecordingRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &RecordingRuleResource{}
 )
 
 // NewRecordingRuleResource is a helper function to simplify the provider implementation.
@@ -36,7 +38,10 @@ func NewRecordingRuleResource() resource.Resource {
 
 // RecordingRuleResource is the resource implementation.
 type RecordingRuleResource struct {
-	client client.Client
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
 }
 
 // recordingRuleModel is the Terraform state model for a recording rule resource.
@@ -45,6 +50,23 @@ type recordingRuleModel struct {
 	ID                types.String `tfsdk:"id"`
 	Dataset           types.String `tfsdk:"dataset"`
 	RecordingRuleYaml types.String `tfsdk:"recording_rule_yaml"`
+	Labels            types.Map    `tfsdk:"labels"`
+	Annotations       types.Map    `tfsdk:"annotations"`
+	LabelsAll         types.Map    `tfsdk:"labels_all"`
+	NamePrefix        types.String `tfsdk:"name_prefix"`
+	NameSuffix        types.String `tfsdk:"name_suffix"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model.
+func (m recordingRuleModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+		NamePrefix:    m.NamePrefix,
+		NameSuffix:    m.NameSuffix,
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -63,12 +85,49 @@ func (r *RecordingRuleResource) Configure(_ context.Context, req resource.Config
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_recording_rule")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
 }
 
 func (r *RecordingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_recording_rule"
 }
 
+// ValidateConfig checks the recording rule definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *RecordingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model recordingRuleModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.RecordingRuleYaml, definitionCheck{
+		attribute:    "recording_rule_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateRecordingRule,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the labels the provider merges into the definition, and
+// checks the permissions of the auth token when the permission preflight is
+// enabled.
+func (r *RecordingRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_recording_rule", datasetCreateRecordingRuleAction, &resp.Diagnostics)
+	var plan recordingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.RecordingRuleYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *RecordingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `Manages a Dash0 Recording Rule. Recording rules pre-compute frequently needed or computationally expensive PromQL expressions and save the results as new time series. See [Manage Check Rules as Code](https://dash0.com/docs/dash0/monitoring/alerting/manage-check-rules-as-code) for more details — recording rules share the same Prometheus rule format and management surface as alert check rules. The recording rule definition uses the [Prometheus Rule format](https://prometheus-operator.dev/docs/api-reference/api/#monitoring.coreos.com/v1.PrometheusRule).`,
@@ -102,6 +161,11 @@ func (r *RecordingRuleResource) Schema(_ context.Context, _ resource.SchemaReque
 					customplanmodifier.YAMLSemanticEqual(),
 				},
 			},
+			"labels":      labelsAttribute("recording rule"),
+			"annotations": annotationsAttribute("recording rule"),
+			"labels_all":  labelsAllAttribute("recording rule"),
+			"name_prefix": nameAffixAttribute("recording rule", "prepended to"),
+			"name_suffix": nameAffixAttribute("recording rule", "appended to"),
 		},
 	}
 }
@@ -144,8 +208,15 @@ func (r *RecordingRuleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, model.RecordingRuleYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.RecordingRuleYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert recording rule YAML to JSON: %s", err))
 		return
@@ -160,6 +231,9 @@ func (r *RecordingRuleResource) Create(ctx context.Context, req resource.CreateR
 	// Resolve the id for the newly created recording rule (best-effort).
 	r.resolveRecordingRule(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.RecordingRuleYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a recording rule resource")
 
 	// Set state to fully populated data
@@ -186,8 +260,17 @@ func (r *RecordingRuleResource) Read(ctx context.Context, req resource.ReadReque
 
 	// Compare the current state with the retrieved recording rule
 	if state.RecordingRuleYaml.ValueString() != "" {
-		stateYAML := state.RecordingRuleYaml.ValueString()
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.RecordingRuleYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
 		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
 		if err != nil {
 			resp.Diagnostics.AddWarning(
@@ -205,6 +288,10 @@ func (r *RecordingRuleResource) Read(ctx context.Context, req resource.ReadReque
 		state.RecordingRuleYaml = types.StringValue(apiResponseJSON)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -238,8 +325,15 @@ func (r *RecordingRuleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, plan.RecordingRuleYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.RecordingRuleYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert recording rule YAML to JSON: %s", err))
 		return
@@ -256,6 +350,9 @@ func (r *RecordingRuleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.RecordingRuleYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a recording rule resource")
 
 	// Set state to fully populated data
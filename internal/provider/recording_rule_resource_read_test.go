@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -118,6 +119,24 @@ spec:
 					"recording_rule_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			}
 
@@ -134,6 +153,11 @@ spec:
 						"id":                  tftypes.String,
 						"dataset":             tftypes.String,
 						"recording_rule_yaml": tftypes.String,
+						"labels":              tftypes.Map{ElementType: tftypes.String},
+						"annotations":         tftypes.Map{ElementType: tftypes.String},
+						"labels_all":          tftypes.Map{ElementType: tftypes.String},
+						"name_prefix":         tftypes.String,
+						"name_suffix":         tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
@@ -141,6 +165,11 @@ spec:
 					"id":                  tftypes.NewValue(tftypes.String, nil),
 					"dataset":             tftypes.NewValue(tftypes.String, testDataset),
 					"recording_rule_yaml": tftypes.NewValue(tftypes.String, originalYaml),
+					"labels":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"name_prefix":         tftypes.NewValue(tftypes.String, nil),
+					"name_suffix":         tftypes.NewValue(tftypes.String, nil),
 				},
 			)
 
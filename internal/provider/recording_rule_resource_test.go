@@ -155,6 +155,11 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 					"id":                  tftypes.String,
 					"dataset":             tftypes.String,
 					"recording_rule_yaml": tftypes.String,
+					"labels":              tftypes.Map{ElementType: tftypes.String},
+					"annotations":         tftypes.Map{ElementType: tftypes.String},
+					"labels_all":          tftypes.Map{ElementType: tftypes.String},
+					"name_prefix":         tftypes.String,
+					"name_suffix":         tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
@@ -162,6 +167,11 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 				"id":                  tftypes.NewValue(tftypes.String, nil),
 				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
 				"recording_rule_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+				"labels":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"name_prefix":         tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":         tftypes.NewValue(tftypes.String, nil),
 			},
 		),
 		Schema: schema.Schema{
@@ -178,6 +188,24 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 				"recording_rule_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
 			},
 		},
 	}
@@ -209,6 +237,11 @@ func TestRecordingRuleResource_ReadError(t *testing.T) {
 					"id":                  tftypes.String,
 					"dataset":             tftypes.String,
 					"recording_rule_yaml": tftypes.String,
+					"labels":              tftypes.Map{ElementType: tftypes.String},
+					"annotations":         tftypes.Map{ElementType: tftypes.String},
+					"labels_all":          tftypes.Map{ElementType: tftypes.String},
+					"name_prefix":         tftypes.String,
+					"name_suffix":         tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
@@ -216,6 +249,11 @@ func TestRecordingRuleResource_ReadError(t *testing.T) {
 				"id":                  tftypes.NewValue(tftypes.String, nil),
 				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
 				"recording_rule_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
+				"labels":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"name_prefix":         tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":         tftypes.NewValue(tftypes.String, nil),
 			},
 		),
 		Schema: schema.Schema{
@@ -232,6 +270,24 @@ func TestRecordingRuleResource_ReadError(t *testing.T) {
 				"recording_rule_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &SLOResource{}
+	_ resource.ResourceWithConfigure      = &SLOResource{}
+	_ resource.ResourceWithImportState    = &SLOResource{}
+	_ resource.ResourceWithValidateConfig = &SLOResource{}
+	_ resource.ResourceWithModifyPlan     = &SLOResource{}
+)
+
+// NewSLOResource is a helper function to simplify the provider implementation.
+func NewSLOResource() resource.Resource {
+	return &SLOResource{}
+}
+
+// SLOResource is the resource implementation.
+type SLOResource struct {
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
+}
+
+// sloModel is the Terraform state model for an SLO resource.
+type sloModel struct {
+	Origin               types.String `tfsdk:"origin"`
+	ID                   types.String `tfsdk:"id"`
+	Dataset              types.String `tfsdk:"dataset"`
+	SLOYaml              types.String `tfsdk:"slo_yaml"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model.
+func (m sloModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SLOResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_slo")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
+}
+
+func (r *SLOResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+// ValidateConfig checks the SLO definition at plan time, so that a definition
+// the API client would reject fails the plan rather than the apply.
+func (r *SLOResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model sloModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.SLOYaml, definitionCheck{
+		attribute:    "slo_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateSLO,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the labels the provider merges into the definition, and
+// checks the permissions of the auth token when the permission preflight is
+// enabled.
+func (r *SLOResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_slo", datasetCreateSLOAction, &resp.Diagnostics)
+	var plan sloModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.SLOYaml, plan.managedMetadata(r.defaultLabels))
+}
+
+func (r *SLOResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Dash0 Service Level Objective (SLO). The SLO definition uses the [OpenSLO](https://github.com/OpenSLO/OpenSLO) `SLO` format (`apiVersion: openslo/v1`) with an inline ratio indicator whose good, bad or total events are Prometheus vector selectors, a single objective, and an optional rolling 28 day time window. " +
+			"Burn-rate alerting is not part of the SLO: the Dash0 API ignores `spec.alertPolicies`, so alert on the error budget with a `dash0_check_rule` instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"origin": schema.StringAttribute{
+				Description: "A unique identifier for the SLO, automatically generated on creation. Used to reference the SLO for updates, reads, deletes, and imports.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The server-assigned identifier of the SLO, resolved by the provider after creation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the SLO belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. Changing this value forces the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"slo_yaml": schema.StringAttribute{
+				Description: "The SLO definition in YAML format, following the [OpenSLO v1 SLO specification](https://github.com/OpenSLO/OpenSLO#slo). Set `spec.service` to link the SLO to a service, and the `dash0.com/enabled` annotation to `false` to stop tracking it.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					customplanmodifier.YAMLSemanticEqual(),
+				},
+			},
+			"labels":                 labelsAttribute("SLO"),
+			"annotations":            annotationsAttribute("SLO"),
+			"labels_all":             labelsAllAttribute("SLO"),
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("SLO"),
+		},
+	}
+}
+
+// resolveSLO populates the SLO's server-assigned id on the model. The id is
+// best-effort metadata: failures are surfaced as warnings and leave the
+// attribute null rather than failing the operation.
+func (r *SLOResource) resolveSLO(ctx context.Context, model *sloModel, diags *diag.Diagnostics) {
+	id, err := r.client.ResolveSLO(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"Unable to resolve SLO metadata",
+			fmt.Sprintf("The SLO was saved successfully, but its id could not be determined: %s", err),
+		)
+		model.ID = types.StringNull()
+		return
+	}
+	model.ID = stringOrNull(id)
+}
+
+// sloJSON validates the YAML definition of the SLO, applies the
+// provider-managed metadata and converts it to JSON for the API.
+func sloJSON(ctx context.Context, model sloModel, defaultLabels map[string]string, diags *diag.Diagnostics) (string, bool) {
+	var sloYaml interface{}
+	if err := yaml.Unmarshal([]byte(model.SLOYaml.ValueString()), &sloYaml); err != nil {
+		diags.AddError(
+			"Invalid YAML",
+			fmt.Sprintf("SLO definition is not valid YAML: %s", err),
+		)
+		return "", false
+	}
+
+	definition, mergeDiags := mergeManagedMetadata(ctx, model.SLOYaml.ValueString(), model.managedMetadata(defaultLabels))
+	diags.Append(mergeDiags...)
+	if diags.HasError() {
+		return "", false
+	}
+
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to convert SLO YAML to JSON: %s", err))
+		return "", false
+	}
+	return jsonBody, true
+}
+
+func (r *SLOResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model sloModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Origin = types.StringValue("tf_" + uuid.New().String())
+
+	jsonBody, ok := sloJSON(ctx, model, r.defaultLabels, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	err := r.client.CreateSLO(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SLO, got error: %s", err))
+		return
+	}
+
+	// Resolve the id for the newly created SLO (best-effort).
+	r.resolveSLO(ctx, &model, &resp.Diagnostics)
+
+	model.LabelsAll, diags = labelsAll(ctx, model.SLOYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created an SLO resource")
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLOResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sloModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponseJSON, err := r.client.GetSLO(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SLO, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read an SLO resource")
+
+	// Compare the current state with the retrieved SLO
+	if state.SLOYaml.ValueString() != "" {
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.SLOYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseJSON, state.IgnoreServerDefaults), additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"SLO Comparison Error",
+				fmt.Sprintf("Error comparing SLOs: %s. Using API response as source of truth.", err),
+			)
+			state.SLOYaml = types.StringValue(apiResponseJSON)
+		} else if !equivalent {
+			tflog.Debug(ctx, "SLO has changed, updating state")
+			state.SLOYaml = types.StringValue(apiResponseJSON)
+		} else {
+			tflog.Debug(ctx, "SLO is equivalent, ignoring changes in metadata fields")
+		}
+	} else {
+		state.SLOYaml = types.StringValue(apiResponseJSON)
+	}
+
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLOResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state sloModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan sloModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonBody, ok := sloJSON(ctx, plan, r.defaultLabels, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Update the existing SLO (dataset changes force recreation via RequiresReplace)
+	plan.Origin = state.Origin
+	plan.ID = state.ID
+	err := r.client.UpdateSLO(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SLO, got error: %s", err))
+		return
+	}
+
+	plan.LabelsAll, diags = labelsAll(ctx, plan.SLOYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated an SLO resource")
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLOResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sloModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSLO(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SLO, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an SLO resource")
+}
+
+// ImportState function is required for resources that support import
+func (r *SLOResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
+		)
+		return
+	}
+
+	dataset := idParts[0]
+	origin := idParts[1]
+
+	apiResponseJSON, err := r.client.GetSLO(ctx, origin, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing SLO",
+			fmt.Sprintf("Could not get SLO with origin=%s, dataset=%s: %s", origin, dataset, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), origin)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset"), dataset)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slo_yaml"), apiResponseJSON)...)
+
+	// Resolve the id (best-effort).
+	model := sloModel{Origin: types.StringValue(origin), Dataset: types.StringValue(dataset)}
+	r.resolveSLO(ctx, &model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
+}
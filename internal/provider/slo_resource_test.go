@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSLOYaml = `apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: checkout-availability
+spec:
+  service: checkout
+  budgetingMethod: Occurrences
+  indicator:
+    spec:
+      ratioMetric:
+        counter: true
+        good:
+          metricSource:
+            spec:
+              query: http_requests_total{service="checkout",status!~"5.."}
+        total:
+          metricSource:
+            spec:
+              query: http_requests_total{service="checkout"}
+  objectives:
+    - target: 0.995`
+
+// testSLOResponse is the SLO as returned by the API, with the default time
+// window the server adds when the definition has none.
+const testSLOResponse = `{"apiVersion":"openslo/v1","kind":"SLO","metadata":{"name":"checkout-availability"},"spec":{"service":"checkout","budgetingMethod":"Occurrences","indicator":{"spec":{"ratioMetric":{"counter":true,"good":{"metricSource":{"spec":{"query":"http_requests_total{service=\"checkout\",status!~\"5..\"}"}}},"total":{"metricSource":{"spec":{"query":"http_requests_total{service=\"checkout\"}"}}}}}},"objectives":[{"target":0.995}],"timeWindow":[{"duration":"28d","isRolling":true}]}}`
+
+func sloState(t *testing.T, r *SLOResource, model sloModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	// The zero value of a map has no element type, so unset maps are stored
+	// as null maps.
+	if model.Labels.ElementType(ctx) == nil {
+		model.Labels = types.MapNull(types.StringType)
+	}
+	if model.Annotations.ElementType(ctx) == nil {
+		model.Annotations = types.MapNull(types.StringType)
+	}
+	if model.LabelsAll.ElementType(ctx) == nil {
+		model.LabelsAll = types.MapNull(types.StringType)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func TestSLOResource_Metadata(t *testing.T) {
+	r := &SLOResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_slo", resp.TypeName)
+}
+
+func TestSLOResource_Schema(t *testing.T) {
+	r := &SLOResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	assert.True(t, resp.Schema.Attributes["origin"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["id"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["dataset"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["slo_yaml"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["ignore_server_defaults"].IsOptional())
+}
+
+func TestSLOResource_Create(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &SLOResource{client: mockClient}
+	mockClient.On("CreateSLO", ctx, mock.MatchedBy(func(origin string) bool { return len(origin) > 3 && origin[:3] == "tf_" }), mock.AnythingOfType("string"), "default").Return(nil)
+	mockClient.On("ResolveSLO", ctx, mock.AnythingOfType("string"), "default").Return("slo_01abc", nil)
+
+	plan := sloState(t, r, sloModel{
+		Origin:               types.StringUnknown(),
+		ID:                   types.StringUnknown(),
+		Dataset:              types.StringValue("default"),
+		SLOYaml:              types.StringValue(testSLOYaml),
+		IgnoreServerDefaults: types.BoolNull(),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model sloModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "slo_01abc", model.ID.ValueString())
+	assert.Equal(t, testSLOYaml, model.SLOYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSLOResource_Create_InvalidYAML(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &SLOResource{client: mockClient}
+
+	plan := sloState(t, r, sloModel{
+		Origin:  types.StringUnknown(),
+		ID:      types.StringUnknown(),
+		Dataset: types.StringValue("default"),
+		SLOYaml: types.StringValue("invalid: yaml: content: ["),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid YAML", resp.Diagnostics.Errors()[0].Summary())
+	mockClient.AssertNotCalled(t, "CreateSLO")
+}
+
+// TestSLOResource_Read_ServerDefaults checks that the time window the server
+// adds to an SLO without one is reported as drift unless
+// ignore_server_defaults is set.
+func TestSLOResource_Read_ServerDefaults(t *testing.T) {
+	ctx := context.Background()
+	for _, ignore := range []bool{false, true} {
+		mockClient := &MockClient{}
+		r := &SLOResource{client: mockClient}
+		mockClient.On("GetSLO", ctx, "tf_checkout", "default").Return(testSLOResponse, nil)
+
+		state := sloState(t, r, sloModel{
+			Origin:               types.StringValue("tf_checkout"),
+			ID:                   types.StringValue("slo_01abc"),
+			Dataset:              types.StringValue("default"),
+			SLOYaml:              types.StringValue(testSLOYaml),
+			IgnoreServerDefaults: types.BoolValue(ignore),
+		})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var model sloModel
+		resp.State.Get(ctx, &model)
+		if ignore {
+			assert.Equal(t, testSLOYaml, model.SLOYaml.ValueString())
+		} else {
+			assert.Equal(t, testSLOResponse, model.SLOYaml.ValueString())
+		}
+	}
+}
+
+func TestSLOResource_Update(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &SLOResource{client: mockClient}
+	mockClient.On("UpdateSLO", ctx, "tf_checkout", mock.AnythingOfType("string"), "default").Return(nil)
+
+	state := sloState(t, r, sloModel{
+		Origin:  types.StringValue("tf_checkout"),
+		ID:      types.StringValue("slo_01abc"),
+		Dataset: types.StringValue("default"),
+		SLOYaml: types.StringValue(testSLOYaml),
+	})
+	plan := sloState(t, r, sloModel{
+		Origin:  types.StringUnknown(),
+		ID:      types.StringUnknown(),
+		Dataset: types.StringValue("default"),
+		SLOYaml: types.StringValue(testSLOYaml + "\n    - target: 0.999"),
+	})
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model sloModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "tf_checkout", model.Origin.ValueString())
+	assert.Equal(t, "slo_01abc", model.ID.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSLOResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &SLOResource{client: mockClient}
+	mockClient.On("GetSLO", ctx, "tf_checkout", "default").Return(testSLOResponse, nil)
+	mockClient.On("ResolveSLO", ctx, "tf_checkout", "default").Return("slo_01abc", nil)
+
+	state := sloState(t, r, sloModel{})
+	resp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "default,tf_checkout"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model sloModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "tf_checkout", model.Origin.ValueString())
+	assert.Equal(t, "default", model.Dataset.ValueString())
+	assert.Equal(t, "slo_01abc", model.ID.ValueString())
+	assert.Equal(t, testSLOResponse, model.SLOYaml.ValueString())
+
+	resp = resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_checkout"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -24,9 +24,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &SpamFilterResource{}
-	_ resource.ResourceWithConfigure   = &SpamFilterResource{}
-	_ resource.ResourceWithImportState = &SpamFilterResource{}
+	_ resource.Resource                   = &SpamFilterResource{}
+	_ resource.ResourceWithConfigure      = &SpamFilterResource{}
+	_ resource.ResourceWithImportState    = &SpamFilterResource{}
+	_ resource.ResourceWithValidateConfig = &SpamFilterResource{}
+	_ resource.ResourceWithModifyPlan     = &SpamFilterResource{}
 )
 
 // NewSpamFilterResource is a helper function to simplify the provider implementation.
@@ -36,7 +38,10 @@ func NewSpamFilterResource() resource.Resource {
 
 // SpamFilterResource is the resource implementation.
 type SpamFilterResource struct {
-	client client.Client
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
 }
 
 // spamFilterModel is the Terraform state model for a spam filter resource.
@@ -45,6 +50,19 @@ type spamFilterModel struct {
 	ID             types.String `tfsdk:"id"`
 	Dataset        types.String `tfsdk:"dataset"`
 	SpamFilterYaml types.String `tfsdk:"spam_filter_yaml"`
+	Labels         types.Map    `tfsdk:"labels"`
+	Annotations    types.Map    `tfsdk:"annotations"`
+	LabelsAll      types.Map    `tfsdk:"labels_all"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model.
+func (m spamFilterModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -63,12 +81,49 @@ func (r *SpamFilterResource) Configure(_ context.Context, req resource.Configure
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_spam_filter")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
 }
 
 func (r *SpamFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_spam_filter"
 }
 
+// ValidateConfig checks the spam filter definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *SpamFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model spamFilterModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.SpamFilterYaml, definitionCheck{
+		attribute:    "spam_filter_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateSpamFilter,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the labels the provider merges into the definition, and
+// checks the permissions of the auth token when the permission preflight is
+// enabled.
+func (r *SpamFilterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_spam_filter", "", &resp.Diagnostics)
+	var plan spamFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.SpamFilterYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *SpamFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Dash0 Spam Filter. Spam filters allow you to drop noisy or unwanted telemetry data " +
@@ -109,6 +164,9 @@ func (r *SpamFilterResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					customplanmodifier.YAMLSemanticEqual(),
 				},
 			},
+			"labels":      labelsAttribute("spam filter"),
+			"annotations": annotationsAttribute("spam filter"),
+			"labels_all":  labelsAllAttribute("spam filter"),
 		},
 	}
 }
@@ -151,8 +209,15 @@ func (r *SpamFilterResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, model.SpamFilterYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.SpamFilterYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert spam filter YAML to JSON: %s", err))
 		return
@@ -167,6 +232,9 @@ func (r *SpamFilterResource) Create(ctx context.Context, req resource.CreateRequ
 	// Resolve the id for the newly created spam filter (best-effort).
 	r.resolveSpamFilter(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.SpamFilterYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a spam filter resource")
 
 	// Set state to fully populated data
@@ -193,8 +261,17 @@ func (r *SpamFilterResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Compare the current state with the retrieved spam filter
 	if state.SpamFilterYaml.ValueString() != "" {
-		stateYAML := state.SpamFilterYaml.ValueString()
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.SpamFilterYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
 		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
 		if err != nil {
 			resp.Diagnostics.AddWarning(
@@ -212,6 +289,10 @@ func (r *SpamFilterResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.SpamFilterYaml = types.StringValue(apiResponseJSON)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -245,8 +326,15 @@ func (r *SpamFilterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, plan.SpamFilterYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.SpamFilterYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert spam filter YAML to JSON: %s", err))
 		return
@@ -263,6 +351,9 @@ func (r *SpamFilterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.SpamFilterYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a spam filter resource")
 
 	// Set state to fully populated data
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gopkg.in/yaml.v3"
@@ -24,9 +27,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &SyntheticCheckResource{}
-	_ resource.ResourceWithConfigure   = &SyntheticCheckResource{}
-	_ resource.ResourceWithImportState = &SyntheticCheckResource{}
+	_ resource.Resource                   = &SyntheticCheckResource{}
+	_ resource.ResourceWithConfigure      = &SyntheticCheckResource{}
+	_ resource.ResourceWithImportState    = &SyntheticCheckResource{}
+	_ resource.ResourceWithValidateConfig = &SyntheticCheckResource{}
+	_ resource.ResourceWithModifyPlan     = &SyntheticCheckResource{}
 )
 
 // NewSyntheticCheckResource is a helper function to simplify the provider implementation.
@@ -36,7 +41,11 @@ func NewSyntheticCheckResource() resource.Resource {
 
 // SyntheticCheckResource is the resource implementation.
 type SyntheticCheckResource struct {
-	client client.Client
+	client        client.Client
+	plannedNames  *plannedNames
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
 }
 
 // syntheticCheckModel is the Terraform state model for a synthetic check resource.
@@ -45,7 +54,166 @@ type syntheticCheckModel struct {
 	ID                 types.String `tfsdk:"id"`
 	Dataset            types.String `tfsdk:"dataset"`
 	SyntheticCheckYaml types.String `tfsdk:"synthetic_check_yaml"`
-	URL                types.String `tfsdk:"url"`
+	// NotificationChannelIDs is written into spec.notifications.channels on
+	// every write; it is not part of the YAML stored in state.
+	NotificationChannelIDs types.Set `tfsdk:"notification_channel_ids"`
+	// Permissions is written into spec.permissions on every write; it is not
+	// part of the YAML stored in state.
+	Permissions          types.List   `tfsdk:"permissions"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+	NamePrefix           types.String `tfsdk:"name_prefix"`
+	NameSuffix           types.String `tfsdk:"name_suffix"`
+	ConflictStrategy     types.String `tfsdk:"conflict_strategy"`
+	SecretHeaders        types.Map    `tfsdk:"secret_headers"`
+	SecretHeadersVersion types.Int64  `tfsdk:"secret_headers_version"`
+	OnDestroy            types.String `tfsdk:"on_destroy"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+	URL                  types.String `tfsdk:"url"`
+}
+
+// secretHeaderNamesKey is the private state key under which the names of the
+// configured secret headers are kept. Their values are never stored, but Read
+// needs the names to leave the headers out of the definition it refreshes.
+const secretHeaderNamesKey = "secret_header_names"
+
+// privateState is the subset of the framework's private state data used to
+// keep the secret header names.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// secretHeaders returns the write-only `secret_headers` attribute, which is
+// only present in the configuration. Unknown maps are treated as empty.
+func secretHeaders(ctx context.Context, config tfsdk.Config) (map[string]string, diag.Diagnostics) {
+	headers := map[string]string{}
+	if config.Raw.IsNull() {
+		return headers, nil
+	}
+	var value types.Map
+	diags := config.GetAttribute(ctx, path.Root("secret_headers"), &value)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return headers, diags
+	}
+	diags.Append(value.ElementsAs(ctx, &headers, false)...)
+	return headers, diags
+}
+
+// applySecretHeaders adds the secret headers from the configuration to the
+// definition and records their names in the private state.
+func applySecretHeaders(ctx context.Context, definition string, config tfsdk.Config, private privateState) (string, diag.Diagnostics) {
+	headers, diags := secretHeaders(ctx, config)
+	if diags.HasError() {
+		return "", diags
+	}
+	definition, err := converter.SetSyntheticCheckHeaders(definition, headers)
+	if err != nil {
+		diags.AddAttributeError(path.Root("secret_headers"), "Invalid Secret Headers", fmt.Sprintf("Unable to apply the secret headers to the synthetic check definition: %s", err))
+		return "", diags
+	}
+
+	if len(headers) == 0 {
+		// Clear the names of headers that were removed from the configuration.
+		if recorded, _ := private.GetKey(ctx, secretHeaderNamesKey); len(recorded) > 0 {
+			diags.Append(private.SetKey(ctx, secretHeaderNamesKey, nil)...)
+		}
+		return definition, diags
+	}
+	names, err := json.Marshal(slices.Sorted(maps.Keys(headers)))
+	if err != nil {
+		diags.AddError("Invalid Secret Headers", fmt.Sprintf("Unable to record the secret header names: %s", err))
+		return "", diags
+	}
+	diags.Append(private.SetKey(ctx, secretHeaderNamesKey, names)...)
+	return definition, diags
+}
+
+// removeSecretHeaders removes the headers recorded in the private state from
+// a synthetic check returned by the API, so that their values are neither
+// written to state nor reported as drift.
+func removeSecretHeaders(ctx context.Context, apiResponse string, private privateState) (string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, secretHeaderNamesKey)
+	if diags.HasError() || len(data) == 0 {
+		return apiResponse, diags
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to decode the secret header names: %s", err))
+		return "", diags
+	}
+	stripped, err := converter.RemoveSyntheticCheckHeaders(apiResponse, names)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to remove the secret headers from the synthetic check: %s", err))
+		return "", diags
+	}
+	return stripped, diags
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model, on top of the provider-level default labels.
+func (m syntheticCheckModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+		NamePrefix:    m.NamePrefix,
+		NameSuffix:    m.NameSuffix,
+	}
+}
+
+// syntheticCheckPermissionModel is an element of the `permissions` attribute.
+type syntheticCheckPermissionModel struct {
+	Actions types.Set    `tfsdk:"actions"`
+	Role    types.String `tfsdk:"role"`
+	TeamID  types.String `tfsdk:"team_id"`
+	UserID  types.String `tfsdk:"user_id"`
+}
+
+// definition returns the synthetic check YAML as it is sent to the API: the
+// YAML from the model with the provider-managed metadata, notification
+// channel IDs and permissions applied.
+func (m syntheticCheckModel) definition(ctx context.Context, defaultLabels map[string]string) (string, diag.Diagnostics) {
+	definition, diags := mergeManagedMetadata(ctx, m.SyntheticCheckYaml.ValueString(), m.managedMetadata(defaultLabels))
+	if diags.HasError() {
+		return "", diags
+	}
+	definition, channelDiags := applyNotificationChannelIDs(ctx, definition, m.NotificationChannelIDs, converter.SetSyntheticCheckNotificationChannels)
+	diags.Append(channelDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+	if m.Permissions.IsNull() || m.Permissions.IsUnknown() {
+		return definition, diags
+	}
+
+	var elements []syntheticCheckPermissionModel
+	diags.Append(m.Permissions.ElementsAs(ctx, &elements, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+	permissions := make([]converter.Permission, 0, len(elements))
+	for _, e := range elements {
+		var actions []string
+		diags.Append(e.Actions.ElementsAs(ctx, &actions, false)...)
+		slices.Sort(actions)
+		permissions = append(permissions, converter.Permission{
+			Actions: actions,
+			Role:    e.Role.ValueString(),
+			TeamID:  e.TeamID.ValueString(),
+			UserID:  e.UserID.ValueString(),
+		})
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+	definition, err := converter.SetPermissions(definition, permissions)
+	if err != nil {
+		diags.AddError("Invalid Permissions", fmt.Sprintf("Unable to apply the permissions to the synthetic check definition: %s", err))
+		return "", diags
+	}
+	return definition, diags
 }
 
 // Configure adds the provider configured client to the resource.
@@ -64,12 +232,100 @@ func (r *SyntheticCheckResource) Configure(_ context.Context, req resource.Confi
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_synthetic_check")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
+	r.plannedNames = plannedNamesOf(req.ProviderData)
 }
 
 func (r *SyntheticCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_synthetic_check"
 }
 
+// ValidateConfig checks the synthetic check definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *SyntheticCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model syntheticCheckModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.SyntheticCheckYaml, definitionCheck{
+		attribute:    "synthetic_check_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateSyntheticCheck,
+	}, &resp.Diagnostics)
+
+	if !model.SecretHeaders.IsNull() && !model.SecretHeaders.IsUnknown() && !model.SyntheticCheckYaml.IsNull() && !model.SyntheticCheckYaml.IsUnknown() {
+		headers, diags := secretHeaders(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if _, err := converter.SetSyntheticCheckHeaders(model.SyntheticCheckYaml.ValueString(), headers); err != nil && !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddAttributeError(path.Root("secret_headers"), "Invalid Secret Headers", err.Error())
+		}
+	}
+
+	if model.Permissions.IsNull() || model.Permissions.IsUnknown() {
+		return
+	}
+	var permissions []syntheticCheckPermissionModel
+	resp.Diagnostics.Append(model.Permissions.ElementsAs(ctx, &permissions, false)...)
+	for i, p := range permissions {
+		principals := 0
+		for _, v := range []types.String{p.Role, p.TeamID, p.UserID} {
+			if v.IsUnknown() {
+				principals = 1
+				break
+			}
+			if !v.IsNull() {
+				principals++
+			}
+		}
+		if principals != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions").AtListIndex(i),
+				"Invalid Permission",
+				"Each permission must set exactly one of role, team_id and user_id.",
+			)
+		}
+	}
+}
+
+// ModifyPlan reports a synthetic check whose name is also declared by another
+// dash0_synthetic_check resource in the same dataset, plans the labels the provider
+// merges into the definition, and checks the permissions of the auth token
+// when the permission preflight is enabled.
+func (r *SyntheticCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_synthetic_check", datasetCreateSyntheticCheckAction, &resp.Diagnostics)
+	var plan syntheticCheckModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var priorDataset types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("dataset"), &priorDataset)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.plannedNames.check(ctx, plannedAsset{
+		resourceType: "dash0_synthetic_check",
+		assetName:    "synthetic check",
+		attribute:    "synthetic_check_yaml",
+		definition:   plan.SyntheticCheckYaml,
+		metadata:     plan.managedMetadata(r.defaultLabels),
+		dataset:      plan.Dataset,
+		priorDataset: priorDataset,
+		origin:       plan.Origin,
+		namePaths:    []string{"metadata.name"},
+	}, &resp.Diagnostics)
+	planLabelsAll(ctx, resp, plan.SyntheticCheckYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *SyntheticCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `Manages a Dash0 Synthetic Check. Synthetic checks periodically probe endpoints or URLs from multiple locations to monitor availability, latency, and correctness of your services. See [Synthetic Monitoring](https://dash0.com/docs/dash0/monitoring/synthetics/synthetic-monitoring) and [Manage Synthetic Checks as Code](https://dash0.com/docs/dash0/monitoring/synthetics/manage-synthetic-checks-as-code) for more details.`,
@@ -102,6 +358,51 @@ func (r *SyntheticCheckResource) Schema(_ context.Context, _ resource.SchemaRequ
 					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing),
 				},
 			},
+			"labels":                   labelsAttribute("synthetic check"),
+			"annotations":              annotationsAttribute("synthetic check"),
+			"labels_all":               labelsAllAttribute("synthetic check"),
+			"name_prefix":              nameAffixAttribute("synthetic check", "prepended to"),
+			"name_suffix":              nameAffixAttribute("synthetic check", "appended to"),
+			"conflict_strategy":        conflictStrategyAttribute("synthetic check"),
+			"notification_channel_ids": notificationChannelIDsAttribute("synthetic check", "`spec.notifications.channels`"),
+			"permissions": schema.ListNestedAttribute{
+				Description: "Role-based permissions on the synthetic check, written into `spec.permissions` before the definition is sent to the API. When set, the permissions replace any declared in the YAML and are compared during drift detection. When omitted, the API assigns its default permissions and they are ignored during drift detection.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"actions": schema.SetAttribute{
+							Description: "The actions granted, any of `synthetic_check:read`, `synthetic_check:write` and `synthetic_check:delete`.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role the actions are granted to, for example `admin` or `basic_member`.",
+							Optional:    true,
+						},
+						"team_id": schema.StringAttribute{
+							Description: "The ID of the team the actions are granted to.",
+							Optional:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user the actions are granted to.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"secret_headers": schema.MapAttribute{
+				Description: "HTTP headers added to `spec.plugin.spec.request.headers` before the definition is sent to the API, for example an `Authorization` header carrying a probe credential. The attribute is write-only: its values can come from ephemeral resources and are never stored in the plan or state, and headers with these names are left out of the definition the provider reads back. Because Terraform cannot detect changes to write-only values, increment `secret_headers_version` to send changed values. A header declared in the YAML with the same name is replaced. Requires Terraform 1.11 or later.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"secret_headers_version": schema.Int64Attribute{
+				Description: "A version number for `secret_headers`. Changing it updates the synthetic check with the current values of `secret_headers`, for example after a credential has been rotated.",
+				Optional:    true,
+			},
+			"on_destroy":             onDestroyAttribute("synthetic check"),
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("synthetic check"),
 			"url": schema.StringAttribute{
 				Description: "The URL to open this synthetic check in the Dash0 web app, derived from the Dash0 API URL and the synthetic check's server-assigned identifier. Computed by the provider after creation. May be empty if the app URL cannot be derived (e.g. for self-hosted deployments with a custom web app domain).",
 				Computed:    true,
@@ -140,8 +441,6 @@ func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	model.Origin = types.StringValue("tf_" + uuid.New().String())
-
 	// Validate YAML format
 	var checkYaml interface{}
 	err := yaml.Unmarshal([]byte(model.SyntheticCheckYaml.ValueString()), &checkYaml)
@@ -153,14 +452,46 @@ func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations, name affixes),
+	// notification channels and permissions
+	definition, diags := model.definition(ctx, r.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	definition, diags = applySecretHeaders(ctx, definition, req.Config, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.SyntheticCheckYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert synthetic check YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateSyntheticCheck(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Generate the origin, applying the conflict strategy to an existing
+	// synthetic check with the same name
+	var adopted bool
+	model.Origin, adopted = resolveCreateConflict(ctx, model.ConflictStrategy, createConflict{
+		assetName: "synthetic check",
+		name:      converter.DefinitionName(definition, "metadata.name"),
+		dataset:   model.Dataset.ValueString(),
+		find:      r.client.FindSyntheticCheckByName,
+		delete:    r.client.DeleteSyntheticCheck,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An adopted asset is overwritten with an update, see resolveCreateConflict.
+	create := r.client.CreateSyntheticCheck
+	if adopted {
+		create = r.client.UpdateSyntheticCheck
+	}
+	err = create(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create synthetic check, got error: %s", err))
 		return
@@ -169,6 +500,9 @@ func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.Create
 	// Resolve the id and web app URL for the newly created synthetic check (best-effort).
 	r.resolveSyntheticCheck(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.SyntheticCheckYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a synthetic check resource")
 
 	// Set state to fully populated data
@@ -193,11 +527,29 @@ func (r *SyntheticCheckResource) Read(ctx context.Context, req resource.ReadRequ
 
 	tflog.Trace(ctx, "read a synthetic check resource")
 
+	apiResponseJSON, diags = removeSecretHeaders(ctx, apiResponseJSON, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Compare the current state with the retrieved synthetic check
 	if state.SyntheticCheckYaml.ValueString() != "" {
-		stateYAML := state.SyntheticCheckYaml.ValueString()
+		// Compare including the provider-managed metadata, notification
+		// channel IDs and permissions, which were applied to the definition on
+		// write but are not part of the YAML stored in state. Configured
+		// permissions are thereby compared, while permissions the API adds on
+		// its own stay ignored. The default labels are the ones recorded in
+		// labels_all, which the last write applied, rather than the current
+		// default_labels.
+		stateYAML, diags := state.definition(ctx, appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, []string{converter.AnnotationSharing})
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseJSON, state.IgnoreServerDefaults), additionalIgnored, []string{converter.AnnotationSharing})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Synthetic Check Comparison Error",
@@ -214,6 +566,10 @@ func (r *SyntheticCheckResource) Read(ctx context.Context, req resource.ReadRequ
 		state.SyntheticCheckYaml = types.StringValue(apiResponseJSON)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -247,8 +603,21 @@ func (r *SyntheticCheckResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations, name affixes),
+	// notification channels and permissions
+	definition, diags := plan.definition(ctx, r.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	definition, diags = applySecretHeaders(ctx, definition, req.Config, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.SyntheticCheckYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert synthetic check YAML to JSON: %s", err))
 		return
@@ -267,6 +636,9 @@ func (r *SyntheticCheckResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.SyntheticCheckYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a synthetic check resource")
 
 	// Set state to fully populated data
@@ -282,6 +654,11 @@ func (r *SyntheticCheckResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if state.OnDestroy.ValueString() == onDestroyDisable {
+		r.disable(ctx, state, &resp.Diagnostics)
+		return
+	}
+
 	err := r.client.DeleteSyntheticCheck(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete synthetic check, got error: %s", err))
@@ -324,3 +701,36 @@ func (r *SyntheticCheckResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
 }
+
+// disable keeps the synthetic check on destroy and only sets spec.enabled to
+// false, re-sending the definition last written by the provider.
+func (r *SyntheticCheckResource) disable(ctx context.Context, state syntheticCheckModel, diags *diag.Diagnostics) {
+	// The check is read back instead of rebuilt from state, because state does
+	// not hold the write-only secret headers, and sending the definition
+	// without them would remove them from the check.
+	current, err := r.client.GetSyntheticCheck(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read synthetic check before disabling it, got error: %s", err))
+		return
+	}
+
+	disabled, err := converter.DisableSyntheticCheck(current)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to disable synthetic check definition: %s", err))
+		return
+	}
+
+	jsonBody, err := converter.ConvertYAMLToJSON(disabled)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to convert synthetic check YAML to JSON: %s", err))
+		return
+	}
+
+	err = r.client.UpdateSyntheticCheck(ctx, state.Origin.ValueString(), jsonBody, state.Dataset.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to disable synthetic check, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "disabled a synthetic check resource instead of deleting it")
+}
@@ -74,6 +74,8 @@ spec:
 	tests := []struct {
 		name              string
 		currentState      string
+		channelIDs        []string
+		permissionRole    string
 		apiResponse       string
 		expectStateUpdate bool
 		expectWarning     bool
@@ -92,6 +94,38 @@ spec:
 			expectStateUpdate: false,
 			expectWarning:     false,
 		},
+		{
+			name:              "API returns the channels set by notification_channel_ids - no significant diff",
+			currentState:      baseYAML,
+			channelIDs:        []string{"7f3c1a52-0c4e-4a8e-9d7a-3b5e1f2a6c90"},
+			apiResponse:       baseYAML + "  notifications:\n    channels:\n      - 7f3c1a52-0c4e-4a8e-9d7a-3b5e1f2a6c90\n",
+			expectStateUpdate: false,
+			expectWarning:     false,
+		},
+		{
+			name:              "notification_channel_ids differ from the API - should update state",
+			currentState:      baseYAML,
+			channelIDs:        []string{"7f3c1a52-0c4e-4a8e-9d7a-3b5e1f2a6c90"},
+			apiResponse:       baseYAML,
+			expectStateUpdate: true,
+			expectWarning:     false,
+		},
+		{
+			name:              "API returns the configured permissions - no significant diff",
+			currentState:      baseYAML,
+			permissionRole:    "admin",
+			apiResponse:       apiResponseWithPermissions,
+			expectStateUpdate: false,
+			expectWarning:     false,
+		},
+		{
+			name:              "API permissions differ from the configured ones - should update state",
+			currentState:      baseYAML,
+			permissionRole:    "basic_member",
+			apiResponse:       apiResponseWithPermissions,
+			expectStateUpdate: true,
+			expectWarning:     false,
+		},
 		{
 			name:              "significant changes - should update state",
 			currentState:      baseYAML,
@@ -124,23 +158,81 @@ spec:
 
 			testURL := "https://app.dash0.com/goto/alerting/synthetics?check_id=internal-uuid"
 
+			channelIDs := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+			if tt.channelIDs != nil {
+				elements := []tftypes.Value{}
+				for _, id := range tt.channelIDs {
+					elements = append(elements, tftypes.NewValue(tftypes.String, id))
+				}
+				channelIDs = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+			}
+
+			// The configured permissions match those in
+			// apiResponseWithPermissions when permissionRole is "admin".
+			permissions := tftypes.NewValue(testSyntheticCheckPermissionsType, nil)
+			if tt.permissionRole != "" {
+				permissions = tftypes.NewValue(testSyntheticCheckPermissionsType, []tftypes.Value{
+					tftypes.NewValue(testSyntheticCheckPermissionsType.ElementType, map[string]tftypes.Value{
+						"actions": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "synthetic_check:delete"),
+							tftypes.NewValue(tftypes.String, "synthetic_check:read"),
+						}),
+						"role":    tftypes.NewValue(tftypes.String, tt.permissionRole),
+						"team_id": tftypes.NewValue(tftypes.String, nil),
+						"user_id": tftypes.NewValue(tftypes.String, nil),
+					}),
+					tftypes.NewValue(testSyntheticCheckPermissionsType.ElementType, map[string]tftypes.Value{
+						"actions": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "synthetic_check:read"),
+						}),
+						"role":    tftypes.NewValue(tftypes.String, "basic_member"),
+						"team_id": tftypes.NewValue(tftypes.String, nil),
+						"user_id": tftypes.NewValue(tftypes.String, nil),
+					}),
+				})
+			}
+
 			// Setup request with current state
 			req := resource.ReadRequest{
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
-							"origin":               tftypes.String,
-							"id":                   tftypes.String,
-							"dataset":              tftypes.String,
-							"synthetic_check_yaml": tftypes.String,
-							"url":                  tftypes.String,
+							"origin":                   tftypes.String,
+							"id":                       tftypes.String,
+							"dataset":                  tftypes.String,
+							"synthetic_check_yaml":     tftypes.String,
+							"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+							"permissions":              testSyntheticCheckPermissionsType,
+							"on_destroy":               tftypes.String,
+							"conflict_strategy":        tftypes.String,
+							"name_prefix":              tftypes.String,
+							"name_suffix":              tftypes.String,
+							"labels":                   tftypes.Map{ElementType: tftypes.String},
+							"annotations":              tftypes.Map{ElementType: tftypes.String},
+							"labels_all":               tftypes.Map{ElementType: tftypes.String},
+							"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+							"secret_headers_version":   tftypes.Number,
+							"ignore_server_defaults":   tftypes.Bool,
+							"url":                      tftypes.String,
 						},
 					}, map[string]tftypes.Value{
-						"origin":               tftypes.NewValue(tftypes.String, "test-origin"),
-						"id":                   tftypes.NewValue(tftypes.String, nil),
-						"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
-						"synthetic_check_yaml": tftypes.NewValue(tftypes.String, tt.currentState),
-						"url":                  tftypes.NewValue(tftypes.String, testURL),
+						"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+						"id":                       tftypes.NewValue(tftypes.String, nil),
+						"dataset":                  tftypes.NewValue(tftypes.String, "test-dataset"),
+						"synthetic_check_yaml":     tftypes.NewValue(tftypes.String, tt.currentState),
+						"notification_channel_ids": channelIDs,
+						"permissions":              permissions,
+						"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+						"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+						"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+						"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+						"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+						"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+						"url":                      tftypes.NewValue(tftypes.String, testURL),
 					}),
 					Schema: testSyntheticCheckSchema(),
 				},
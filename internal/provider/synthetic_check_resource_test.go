@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -81,11 +83,23 @@ func TestSyntheticCheckResource_Create(t *testing.T) {
 		Plan: tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":               tftypes.String,
-					"id":                   tftypes.String,
-					"dataset":              tftypes.String,
-					"synthetic_check_yaml": tftypes.String,
-					"url":                  tftypes.String,
+					"origin":                   tftypes.String,
+					"id":                       tftypes.String,
+					"dataset":                  tftypes.String,
+					"synthetic_check_yaml":     tftypes.String,
+					"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+					"permissions":              testSyntheticCheckPermissionsType,
+					"on_destroy":               tftypes.String,
+					"conflict_strategy":        tftypes.String,
+					"name_prefix":              tftypes.String,
+					"name_suffix":              tftypes.String,
+					"labels":                   tftypes.Map{ElementType: tftypes.String},
+					"annotations":              tftypes.Map{ElementType: tftypes.String},
+					"labels_all":               tftypes.Map{ElementType: tftypes.String},
+					"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+					"secret_headers_version":   tftypes.Number,
+					"ignore_server_defaults":   tftypes.Bool,
+					"url":                      tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"origin":  tftypes.NewValue(tftypes.String, nil),
@@ -102,7 +116,19 @@ spec:
     spec:
       request:
         url: https://www.example.com`),
-				"url": tftypes.NewValue(tftypes.String, nil),
+				"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"permissions":              tftypes.NewValue(testSyntheticCheckPermissionsType, nil),
+				"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+				"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+				"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+				"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+				"url":                      tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: testSyntheticCheckSchema(),
 		},
@@ -146,11 +172,23 @@ func TestSyntheticCheckResource_CreateWithError(t *testing.T) {
 		Plan: tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":               tftypes.String,
-					"id":                   tftypes.String,
-					"dataset":              tftypes.String,
-					"synthetic_check_yaml": tftypes.String,
-					"url":                  tftypes.String,
+					"origin":                   tftypes.String,
+					"id":                       tftypes.String,
+					"dataset":                  tftypes.String,
+					"synthetic_check_yaml":     tftypes.String,
+					"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+					"permissions":              testSyntheticCheckPermissionsType,
+					"on_destroy":               tftypes.String,
+					"conflict_strategy":        tftypes.String,
+					"name_prefix":              tftypes.String,
+					"name_suffix":              tftypes.String,
+					"labels":                   tftypes.Map{ElementType: tftypes.String},
+					"annotations":              tftypes.Map{ElementType: tftypes.String},
+					"labels_all":               tftypes.Map{ElementType: tftypes.String},
+					"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+					"secret_headers_version":   tftypes.Number,
+					"ignore_server_defaults":   tftypes.Bool,
+					"url":                      tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"origin":  tftypes.NewValue(tftypes.String, nil),
@@ -160,7 +198,19 @@ func TestSyntheticCheckResource_CreateWithError(t *testing.T) {
 kind: Dash0SyntheticCheck
 metadata:
   name: examplecom`),
-				"url": tftypes.NewValue(tftypes.String, nil),
+				"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"permissions":              tftypes.NewValue(testSyntheticCheckPermissionsType, nil),
+				"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+				"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+				"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+				"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+				"url":                      tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: testSyntheticCheckSchema(),
 		},
@@ -196,18 +246,42 @@ func TestSyntheticCheckResource_Delete(t *testing.T) {
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":               tftypes.String,
-					"id":                   tftypes.String,
-					"dataset":              tftypes.String,
-					"synthetic_check_yaml": tftypes.String,
-					"url":                  tftypes.String,
+					"origin":                   tftypes.String,
+					"id":                       tftypes.String,
+					"dataset":                  tftypes.String,
+					"synthetic_check_yaml":     tftypes.String,
+					"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+					"permissions":              testSyntheticCheckPermissionsType,
+					"on_destroy":               tftypes.String,
+					"conflict_strategy":        tftypes.String,
+					"name_prefix":              tftypes.String,
+					"name_suffix":              tftypes.String,
+					"labels":                   tftypes.Map{ElementType: tftypes.String},
+					"annotations":              tftypes.Map{ElementType: tftypes.String},
+					"labels_all":               tftypes.Map{ElementType: tftypes.String},
+					"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+					"secret_headers_version":   tftypes.Number,
+					"ignore_server_defaults":   tftypes.Bool,
+					"url":                      tftypes.String,
 				},
 			}, map[string]tftypes.Value{
-				"origin":               tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":                   tftypes.NewValue(tftypes.String, nil),
-				"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
-				"synthetic_check_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
-				"url":                  tftypes.NewValue(tftypes.String, nil),
+				"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+				"id":                       tftypes.NewValue(tftypes.String, nil),
+				"dataset":                  tftypes.NewValue(tftypes.String, "test-dataset"),
+				"synthetic_check_yaml":     tftypes.NewValue(tftypes.String, "test-yaml"),
+				"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"permissions":              tftypes.NewValue(testSyntheticCheckPermissionsType, nil),
+				"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+				"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+				"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+				"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+				"url":                      tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: testSyntheticCheckSchema(),
 		},
@@ -226,6 +300,86 @@ func TestSyntheticCheckResource_Delete(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestSyntheticCheckResource_DeleteWithDisable(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockClient)
+
+	r := &SyntheticCheckResource{
+		client: mockClient,
+	}
+
+	checkYaml := "kind: Dash0SyntheticCheck\nmetadata:\n  name: checkout\nspec:\n  enabled: true\n"
+	req := resource.DeleteRequest{
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"origin":                   tftypes.String,
+					"id":                       tftypes.String,
+					"dataset":                  tftypes.String,
+					"synthetic_check_yaml":     tftypes.String,
+					"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+					"permissions":              testSyntheticCheckPermissionsType,
+					"on_destroy":               tftypes.String,
+					"conflict_strategy":        tftypes.String,
+					"name_prefix":              tftypes.String,
+					"name_suffix":              tftypes.String,
+					"labels":                   tftypes.Map{ElementType: tftypes.String},
+					"annotations":              tftypes.Map{ElementType: tftypes.String},
+					"labels_all":               tftypes.Map{ElementType: tftypes.String},
+					"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+					"secret_headers_version":   tftypes.Number,
+					"ignore_server_defaults":   tftypes.Bool,
+					"url":                      tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+				"id":                       tftypes.NewValue(tftypes.String, nil),
+				"dataset":                  tftypes.NewValue(tftypes.String, "test-dataset"),
+				"synthetic_check_yaml":     tftypes.NewValue(tftypes.String, checkYaml),
+				"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"permissions":              tftypes.NewValue(testSyntheticCheckPermissionsType, nil),
+				"on_destroy":               tftypes.NewValue(tftypes.String, onDestroyDisable),
+				"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+				"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+				"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+				"url":                      tftypes.NewValue(tftypes.String, nil),
+			}),
+			Schema: testSyntheticCheckSchema(),
+		},
+	}
+
+	resp := &resource.DeleteResponse{}
+
+	// The check is read back and updated with spec.enabled=false instead of
+	// being deleted, so that headers the state does not hold, such as the
+	// write-only secret headers, are kept.
+	mockClient.On("GetSyntheticCheck", ctx, "test-origin", "test-dataset").Return(`{"kind":"Dash0SyntheticCheck","metadata":{"name":"checkout"},"spec":{"enabled":true,"plugin":{"kind":"http","spec":{"request":{"headers":[{"name":"Authorization","value":"Bearer probe"}]}}}}}`, nil)
+	mockClient.On("UpdateSyntheticCheck", ctx, "test-origin", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, `"enabled":false`) && strings.Contains(body, `"value":"Bearer probe"`)
+	}), "test-dataset").Return(nil)
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "DeleteSyntheticCheck", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// testSyntheticCheckPermissionsType is the Terraform type of the
+// `permissions` attribute.
+var testSyntheticCheckPermissionsType = tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"actions": tftypes.Set{ElementType: tftypes.String},
+	"role":    tftypes.String,
+	"team_id": tftypes.String,
+	"user_id": tftypes.String,
+}}}
+
 // Helper function to create test schema
 func testSyntheticCheckSchema() schema.Schema {
 	return schema.Schema{
@@ -242,6 +396,57 @@ func testSyntheticCheckSchema() schema.Schema {
 			"synthetic_check_yaml": schema.StringAttribute{
 				Required: true,
 			},
+			"notification_channel_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"actions": schema.SetAttribute{ElementType: types.StringType, Required: true},
+						"role":    schema.StringAttribute{Optional: true},
+						"team_id": schema.StringAttribute{Optional: true},
+						"user_id": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+			},
+			"conflict_strategy": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_suffix": schema.StringAttribute{
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"secret_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"secret_headers_version": schema.Int64Attribute{
+				Optional: true,
+			},
+			"ignore_server_defaults": schema.BoolAttribute{
+				Optional: true,
+			},
 			"url": schema.StringAttribute{
 				Computed: true,
 			},
@@ -381,29 +586,65 @@ func TestSyntheticCheckResource_Update(t *testing.T) {
 			State: tfsdk.State{
 				Raw: tftypes.NewValue(tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":               tftypes.String,
-						"id":                   tftypes.String,
-						"dataset":              tftypes.String,
-						"synthetic_check_yaml": tftypes.String,
-						"url":                  tftypes.String,
+						"origin":                   tftypes.String,
+						"id":                       tftypes.String,
+						"dataset":                  tftypes.String,
+						"synthetic_check_yaml":     tftypes.String,
+						"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+						"permissions":              testSyntheticCheckPermissionsType,
+						"on_destroy":               tftypes.String,
+						"conflict_strategy":        tftypes.String,
+						"name_prefix":              tftypes.String,
+						"name_suffix":              tftypes.String,
+						"labels":                   tftypes.Map{ElementType: tftypes.String},
+						"annotations":              tftypes.Map{ElementType: tftypes.String},
+						"labels_all":               tftypes.Map{ElementType: tftypes.String},
+						"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+						"secret_headers_version":   tftypes.Number,
+						"ignore_server_defaults":   tftypes.Bool,
+						"url":                      tftypes.String,
 					},
 				}, map[string]tftypes.Value{
-					"origin":               tftypes.NewValue(tftypes.String, "test-origin"),
-					"id":                   tftypes.NewValue(tftypes.String, nil),
-					"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
-					"synthetic_check_yaml": tftypes.NewValue(tftypes.String, "old-yaml"),
-					"url":                  tftypes.NewValue(tftypes.String, testURL),
+					"origin":                   tftypes.NewValue(tftypes.String, "test-origin"),
+					"id":                       tftypes.NewValue(tftypes.String, nil),
+					"dataset":                  tftypes.NewValue(tftypes.String, "test-dataset"),
+					"synthetic_check_yaml":     tftypes.NewValue(tftypes.String, "old-yaml"),
+					"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"permissions":              tftypes.NewValue(testSyntheticCheckPermissionsType, nil),
+					"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+					"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+					"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+					"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+					"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+					"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+					"url":                      tftypes.NewValue(tftypes.String, testURL),
 				}),
 				Schema: testSyntheticCheckSchema(),
 			},
 			Plan: tfsdk.Plan{
 				Raw: tftypes.NewValue(tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":               tftypes.String,
-						"id":                   tftypes.String,
-						"dataset":              tftypes.String,
-						"synthetic_check_yaml": tftypes.String,
-						"url":                  tftypes.String,
+						"origin":                   tftypes.String,
+						"id":                       tftypes.String,
+						"dataset":                  tftypes.String,
+						"synthetic_check_yaml":     tftypes.String,
+						"notification_channel_ids": tftypes.Set{ElementType: tftypes.String},
+						"permissions":              testSyntheticCheckPermissionsType,
+						"on_destroy":               tftypes.String,
+						"conflict_strategy":        tftypes.String,
+						"name_prefix":              tftypes.String,
+						"name_suffix":              tftypes.String,
+						"labels":                   tftypes.Map{ElementType: tftypes.String},
+						"annotations":              tftypes.Map{ElementType: tftypes.String},
+						"labels_all":               tftypes.Map{ElementType: tftypes.String},
+						"secret_headers":           tftypes.Map{ElementType: tftypes.String},
+						"secret_headers_version":   tftypes.Number,
+						"ignore_server_defaults":   tftypes.Bool,
+						"url":                      tftypes.String,
 					},
 				}, map[string]tftypes.Value{
 					"origin":  tftypes.NewValue(tftypes.String, "test-origin"),
@@ -413,7 +654,19 @@ func TestSyntheticCheckResource_Update(t *testing.T) {
 kind: Dash0SyntheticCheck
 metadata:
   name: updated`),
-					"url": tftypes.NewValue(tftypes.String, testURL),
+					"notification_channel_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"permissions":              tftypes.NewValue(testSyntheticCheckPermissionsType, nil),
+					"on_destroy":               tftypes.NewValue(tftypes.String, nil),
+					"conflict_strategy":        tftypes.NewValue(tftypes.String, nil),
+					"name_prefix":              tftypes.NewValue(tftypes.String, nil),
+					"name_suffix":              tftypes.NewValue(tftypes.String, nil),
+					"labels":                   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"secret_headers":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"secret_headers_version":   tftypes.NewValue(tftypes.Number, nil),
+					"ignore_server_defaults":   tftypes.NewValue(tftypes.Bool, nil),
+					"url":                      tftypes.NewValue(tftypes.String, testURL),
 				}),
 				Schema: testSyntheticCheckSchema(),
 			},
@@ -440,3 +693,85 @@ metadata:
 		assert.Equal(t, testURL, resultState.URL.ValueString())
 	})
 }
+
+// fakePrivateState is an in-memory stand-in for the framework's private state.
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+// syntheticCheckTestConfig returns a configuration of the synthetic check
+// resource in which only the given attributes are set.
+func syntheticCheckTestConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewSyntheticCheckResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)}
+}
+
+func TestSyntheticCheckResource_SecretHeaders(t *testing.T) {
+	ctx := context.Background()
+	const checkYAML = "kind: Dash0SyntheticCheck\nmetadata:\n  name: checkout\nspec:\n  plugin:\n    kind: http\n    spec:\n      request:\n        url: https://checkout.example.com\n"
+	config := syntheticCheckTestConfig(t, map[string]tftypes.Value{
+		"synthetic_check_yaml": tftypes.NewValue(tftypes.String, checkYAML),
+		"secret_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Authorization": tftypes.NewValue(tftypes.String, "Bearer s3cr3t"),
+		}),
+	})
+	private := fakePrivateState{}
+
+	definition, diags := applySecretHeaders(ctx, checkYAML, config, private)
+	require.False(t, diags.HasError(), diags)
+	assert.Contains(t, definition, "value: Bearer s3cr3t")
+	assert.Equal(t, `["Authorization"]`, string(private[secretHeaderNamesKey]))
+
+	// The headers are left out of the definition read back from the API.
+	apiResponse := `{"kind":"Dash0SyntheticCheck","metadata":{"name":"checkout"},"spec":{"plugin":{"kind":"http","spec":{"request":{"url":"https://checkout.example.com","headers":[{"name":"Authorization","value":"Bearer s3cr3t"}]}}}}}`
+	refreshed, diags := removeSecretHeaders(ctx, apiResponse, private)
+	require.False(t, diags.HasError(), diags)
+	assert.NotContains(t, refreshed, "s3cr3t")
+
+	// Removing the attribute clears the recorded names.
+	_, diags = applySecretHeaders(ctx, checkYAML, syntheticCheckTestConfig(t, nil), private)
+	require.False(t, diags.HasError(), diags)
+	assert.Empty(t, private)
+}
+
+func TestSyntheticCheckResource_ValidateConfigSecretHeadersWithoutRequest(t *testing.T) {
+	config := syntheticCheckTestConfig(t, map[string]tftypes.Value{
+		"dataset":              tftypes.NewValue(tftypes.String, "default"),
+		"synthetic_check_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0SyntheticCheck\nmetadata:\n  name: dns\nspec:\n  plugin:\n    kind: dns\n"),
+		"secret_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Authorization": tftypes.NewValue(tftypes.String, "Bearer s3cr3t"),
+		}),
+	})
+
+	resp := &resource.ValidateConfigResponse{}
+	(&SyntheticCheckResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+	var summaries []string
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	assert.Contains(t, summaries, "Invalid Secret Headers")
+}
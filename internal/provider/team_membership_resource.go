@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+)
+
+// NewTeamMembershipResource is a helper function to simplify the provider implementation.
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+// TeamMembershipResource is the resource implementation of
+// dash0_team_membership. It adds a single member to a team through the team
+// members endpoints, so memberships of one team can be managed from several
+// Terraform configurations.
+type TeamMembershipResource struct {
+	client client.Client
+}
+
+// teamMembershipModel is the Terraform state model for a team membership resource.
+type teamMembershipModel struct {
+	Team     types.String `tfsdk:"team"`
+	Member   types.String `tfsdk:"member"`
+	MemberID types.String `tfsdk:"member_id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TeamMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of a single member in a Dash0 team, independently of the other members of the team. " +
+			"Do not list the members of a team in the `spec.members` of its `dash0_team` when using this resource: `dash0_team` replaces the whole member list on every update, " +
+			"removing memberships managed elsewhere, and reports them as drift unless `team_yaml` is listed in `ignore_changes`. Team memberships have no role of their own; the permissions of a member follow from their organization role.",
+		Attributes: map[string]schema.Attribute{
+			"team": schema.StringAttribute{
+				Description: "The origin or id of the team, for example `dash0_team.backend.origin`. Changing this value forces the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member": schema.StringAttribute{
+				Description: "The email address or internal Dash0 id of the member, for example `dash0_member.carol.email`. Email addresses are compared case-insensitively. The member must have joined the organization: pending invitations cannot be added to a team. Changing this value forces the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Description: "The internal Dash0 id of the member (the `dash0.com/id` label).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// isMemberReference reports whether ref identifies the member, either by id
+// or by email address compared case-insensitively.
+func isMemberReference(member client.Member, ref string) bool {
+	return member.ID == ref || strings.EqualFold(member.Email, ref)
+}
+
+// resolveMemberID returns the internal id of the member referenced by email
+// address or id. Ids are used as-is; email addresses are looked up in the
+// organization's member list.
+func (r *TeamMembershipResource) resolveMemberID(ctx context.Context, ref string) (string, error) {
+	if !strings.Contains(ref, "@") {
+		return ref, nil
+	}
+	members, err := r.client.ListMembers(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to list members: %w", err)
+	}
+	member, ok := client.FindMember(members, ref)
+	if !ok || member.ID == "" {
+		return "", fmt.Errorf("no member with email address %s exists in the organization; an invited person can only be added to a team after accepting the invitation", ref)
+	}
+	return member.ID, nil
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberID, err := r.resolveMemberID(ctx, plan.Member.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Member Not Found", fmt.Sprintf("Unable to add %s to team %s: %s", plan.Member.ValueString(), plan.Team.ValueString(), err))
+		return
+	}
+
+	if err := r.client.CreateTeamMembership(ctx, plan.Team.ValueString(), memberID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add member to team, got error: %s", err))
+		return
+	}
+	plan.MemberID = types.StringValue(memberID)
+
+	tflog.Trace(ctx, "created a team membership resource")
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.ListTeamMembers(ctx, state.Team.ValueString())
+	if err != nil {
+		// Deleting the team removes all of its memberships.
+		if dash0.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Team %s no longer exists on the server; removing membership from state", state.Team.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team members, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, member := range members {
+		if member.ID == state.MemberID.ValueString() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("Member %s is no longer part of team %s; removing from state", state.MemberID.ValueString(), state.Team.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read a team membership resource")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only stores the plan: every configurable attribute forces a
+// replacement, so there is nothing to change in place.
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTeamMembership(ctx, state.Team.ValueString(), state.MemberID.ValueString())
+	if err != nil {
+		// The team or the membership was already removed out-of-band.
+		if dash0.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Membership of %s in team %s was already gone at delete time; treating as success", state.MemberID.ValueString(), state.Team.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove member from team, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a team membership resource")
+}
+
+// ImportState imports a team membership by 'team,member', where team is the
+// origin or id of the team and member an email address or member id.
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'team,member'. Got: %s", req.ID),
+		)
+		return
+	}
+
+	team := idParts[0]
+	ref := idParts[1]
+
+	members, err := r.client.ListTeamMembers(ctx, team)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Team Membership",
+			fmt.Sprintf("Could not get members of team %s: %s", team, err),
+		)
+		return
+	}
+
+	memberID := ""
+	for _, member := range members {
+		if isMemberReference(member, ref) {
+			memberID = member.ID
+			break
+		}
+	}
+	if memberID == "" {
+		resp.Diagnostics.AddError(
+			"Error Importing Team Membership",
+			fmt.Sprintf("%s is not a member of team %s.", ref, team),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), ref)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), memberID)...)
+}
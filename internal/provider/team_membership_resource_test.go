@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+func teamMembershipState(t *testing.T, r *TeamMembershipResource, model teamMembershipModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func TestTeamMembershipResource_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("by email", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TeamMembershipResource{client: mockClient}
+		mockClient.On("ListMembers", ctx).Return([]client.Member{{ID: "user_alice", Email: "Alice@example.com", Joined: true}}, nil)
+		mockClient.On("CreateTeamMembership", ctx, "tf_backend", "user_alice").Return(nil)
+
+		plan := teamMembershipState(t, r, teamMembershipModel{
+			Team:     types.StringValue("tf_backend"),
+			Member:   types.StringValue("alice@example.com"),
+			MemberID: types.StringUnknown(),
+		})
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var model teamMembershipModel
+		resp.State.Get(ctx, &model)
+		assert.Equal(t, "user_alice", model.MemberID.ValueString())
+		assert.Equal(t, "alice@example.com", model.Member.ValueString())
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("by id", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TeamMembershipResource{client: mockClient}
+		mockClient.On("CreateTeamMembership", ctx, "tf_backend", "user_alice").Return(nil)
+
+		plan := teamMembershipState(t, r, teamMembershipModel{
+			Team:     types.StringValue("tf_backend"),
+			Member:   types.StringValue("user_alice"),
+			MemberID: types.StringUnknown(),
+		})
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		mockClient.AssertNotCalled(t, "ListMembers", ctx)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TeamMembershipResource{client: mockClient}
+		mockClient.On("ListMembers", ctx).Return([]client.Member{}, nil)
+
+		plan := teamMembershipState(t, r, teamMembershipModel{
+			Team:     types.StringValue("tf_backend"),
+			Member:   types.StringValue("carol@example.com"),
+			MemberID: types.StringUnknown(),
+		})
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Member Not Found", resp.Diagnostics.Errors()[0].Summary())
+		mockClient.AssertNotCalled(t, "CreateTeamMembership")
+	})
+}
+
+func TestTeamMembershipResource_Read(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		members    []client.Member
+		err        error
+		expectGone bool
+	}{
+		{name: "member of the team", members: []client.Member{{ID: "user_alice", Email: "alice@example.com"}}},
+		{name: "removed from the team", members: []client.Member{{ID: "user_bob", Email: "bob@example.com"}}, expectGone: true},
+		{name: "team deleted", err: &dash0.APIError{StatusCode: 404}, expectGone: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			r := &TeamMembershipResource{client: mockClient}
+			mockClient.On("ListTeamMembers", ctx, "tf_backend").Return(tc.members, tc.err)
+
+			state := teamMembershipState(t, r, teamMembershipModel{
+				Team:     types.StringValue("tf_backend"),
+				Member:   types.StringValue("alice@example.com"),
+				MemberID: types.StringValue("user_alice"),
+			})
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tc.expectGone, resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func TestTeamMembershipResource_Delete(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TeamMembershipResource{client: mockClient}
+	mockClient.On("DeleteTeamMembership", ctx, "tf_backend", "user_alice").Return(&dash0.APIError{StatusCode: 404})
+
+	state := teamMembershipState(t, r, teamMembershipModel{
+		Team:     types.StringValue("tf_backend"),
+		Member:   types.StringValue("alice@example.com"),
+		MemberID: types.StringValue("user_alice"),
+	})
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "an already removed membership must not fail the destroy")
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamMembershipResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TeamMembershipResource{client: mockClient}
+	mockClient.On("ListTeamMembers", ctx, "tf_backend").Return([]client.Member{{ID: "user_alice", Email: "Alice@example.com"}}, nil)
+
+	state := teamMembershipState(t, r, teamMembershipModel{})
+	resp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_backend,alice@example.com"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model teamMembershipModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, teamMembershipModel{
+		Team:     types.StringValue("tf_backend"),
+		Member:   types.StringValue("alice@example.com"),
+		MemberID: types.StringValue("user_alice"),
+	}, model)
+
+	for _, id := range []string{"tf_backend", "tf_backend,", "tf_backend,bob@example.com"} {
+		resp := resource.ImportStateResponse{State: state}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+		assert.True(t, resp.Diagnostics.HasError(), id)
+	}
+}
@@ -93,6 +93,7 @@ var (
 	_ resource.ResourceWithConfigure      = &TeamResource{}
 	_ resource.ResourceWithImportState    = &TeamResource{}
 	_ resource.ResourceWithValidateConfig = &TeamResource{}
+	_ resource.ResourceWithModifyPlan     = &TeamResource{}
 )
 
 // NewTeamResource is a helper function to simplify the provider implementation.
@@ -102,14 +103,29 @@ func NewTeamResource() resource.Resource {
 
 // TeamResource is the resource implementation.
 type TeamResource struct {
-	client client.Client
+	client        client.Client
+	ignoredFields []string
+	defaultLabels map[string]string
 }
 
 // teamModel is the Terraform state model for a team resource.
 type teamModel struct {
-	Origin   types.String `tfsdk:"origin"`
-	ID       types.String `tfsdk:"id"`
-	TeamYaml types.String `tfsdk:"team_yaml"`
+	Origin      types.String `tfsdk:"origin"`
+	ID          types.String `tfsdk:"id"`
+	TeamYaml    types.String `tfsdk:"team_yaml"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	LabelsAll   types.Map    `tfsdk:"labels_all"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model.
+func (m teamModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -128,6 +144,8 @@ func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_team")
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
 }
 
 func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -136,10 +154,11 @@ func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 // ValidateConfig runs plan-time validation for team_yaml so users see
 // problems on `terraform plan` rather than on the subsequent `terraform
-// apply`. Three checks fire, all cheap:
+// apply`. The checks are all cheap and offline:
 //   - YAML syntax: catches malformed heredocs and typos before any write
 //     path is exercised.
-//   - Shape: asserts `kind: Dash0Team` (the CRD envelope discriminator).
+//   - Shape: asserts `kind: Dash0Team` (the CRD envelope discriminator) and
+//     that the document decodes into the team definition sent to the API.
 //     `apiVersion` is intentionally not required — the server accepts
 //     omission (defaults to v1alpha1 today); the example pins it for
 //     forward-compat.
@@ -153,44 +172,30 @@ func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.Validate
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if model.TeamYaml.IsNull() || model.TeamYaml.IsUnknown() {
-		return
-	}
-	teamYaml := model.TeamYaml.ValueString()
-
-	var parsed map[string]interface{}
-	if err := yaml.Unmarshal([]byte(teamYaml), &parsed); err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("team_yaml"),
-			"Invalid YAML in team_yaml",
-			fmt.Sprintf("team_yaml is not valid YAML: %s", err),
-		)
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.TeamYaml, definitionCheck{
+		attribute:    "team_yaml",
+		metadata:     &metadata,
+		kind:         "Dash0Team",
+		validateJSON: client.ValidateTeam,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || model.TeamYaml.IsUnknown() {
 		return
 	}
+	warnIfCustomTeamMetadataSet(model.TeamYaml.ValueString(), &resp.Diagnostics)
+}
 
-	// yaml.Unmarshal accepts a bare scalar or a sequence as valid YAML; those
-	// decode into nil or a non-map value. Guard both so the shape check below
-	// does not spuriously trigger on a well-formed but non-CRD document.
-	if parsed == nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("team_yaml"),
-			"team_yaml is empty or not a YAML mapping",
-			"team_yaml must be a YAML mapping following the Dash0Team CRD envelope (kind, metadata, spec).",
-		)
+// ModifyPlan plans the labels the provider merges into the definition.
+func (r *TeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
 		return
 	}
-
-	if kind, _ := parsed["kind"].(string); kind != "Dash0Team" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("team_yaml"),
-			"team_yaml is missing or has the wrong kind",
-			fmt.Sprintf("team_yaml must declare `kind: Dash0Team`; got %q. The dash0_team resource "+
-				"only manages the Dash0Team CRD kind.", kind),
-		)
+	var plan teamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	warnIfCustomTeamMetadataSet(teamYaml, &resp.Diagnostics)
+	planLabelsAll(ctx, resp, plan.TeamYaml, plan.managedMetadata(r.defaultLabels))
 }
 
 func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -235,6 +240,9 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					customplanmodifier.YAMLSemanticEqual(),
 				},
 			},
+			"labels":      labelsAttribute("team"),
+			"annotations": annotationsAttribute("team"),
+			"labels_all":  labelsAllAttribute("team"),
 		},
 	}
 }
@@ -280,8 +288,15 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, model.TeamYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API client.
-	jsonBody, err := converter.ConvertYAMLToJSON(model.TeamYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert team YAML to JSON: %s", err))
 		return
@@ -296,6 +311,9 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Resolve the server-assigned id for the newly created team (best-effort).
 	r.resolveTeamID(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.TeamYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a team resource")
 
 	// Set state to fully populated data.
@@ -337,8 +355,17 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// team-specific list is needed here (see the block-comment above
 	// teamAlwaysIgnoredFields for the rationale).
 	if state.TeamYaml.ValueString() != "" {
-		stateYAML := state.TeamYaml.ValueString()
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.TeamYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
 		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
 		if err != nil {
 			// Comparison failed — most commonly because the API response is
@@ -376,6 +403,10 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		r.resolveTeamID(ctx, &state, &resp.Diagnostics)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -410,7 +441,14 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.TeamYaml.ValueString())
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, plan.TeamYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert team YAML to JSON: %s", err))
 		return
@@ -426,6 +464,9 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.TeamYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a team resource")
 
 	diags = resp.State.Set(ctx, plan)
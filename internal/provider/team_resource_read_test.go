@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -172,6 +173,18 @@ spec:
 					"team_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
 				},
 			}
 
@@ -181,15 +194,21 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"team_yaml": tftypes.String,
+						"origin":      tftypes.String,
+						"id":          tftypes.String,
+						"team_yaml":   tftypes.String,
+						"labels":      tftypes.Map{ElementType: tftypes.String},
+						"annotations": tftypes.Map{ElementType: tftypes.String},
+						"labels_all":  tftypes.Map{ElementType: tftypes.String},
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"team_yaml": tftypes.NewValue(tftypes.String, originalYaml),
+					"origin":      tftypes.NewValue(tftypes.String, testOrigin),
+					"id":          tftypes.NewValue(tftypes.String, nil),
+					"team_yaml":   tftypes.NewValue(tftypes.String, originalYaml),
+					"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				},
 			)
 
@@ -253,9 +272,12 @@ spec:
 
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 	testClient := &testTeamClient{getResponse: apiResponseYaml}
@@ -264,15 +286,21 @@ spec:
 	raw := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
+			"origin":      tftypes.NewValue(tftypes.String, testOrigin),
+			"id":          tftypes.NewValue(tftypes.String, nil),
+			"team_yaml":   tftypes.NewValue(tftypes.String, stateYaml),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 
@@ -297,9 +325,12 @@ spec:
 func TestTeamResource_ReadNotFoundClearsState(t *testing.T) {
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 	testClient := &testTeamClient{getError: &dash0.APIError{StatusCode: 404, Status: "404 Not Found"}}
@@ -308,15 +339,21 @@ func TestTeamResource_ReadNotFoundClearsState(t *testing.T) {
 	raw := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"team_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
+			"origin":      tftypes.NewValue(tftypes.String, "tf_backend"),
+			"id":          tftypes.NewValue(tftypes.String, nil),
+			"team_yaml":   tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 
@@ -336,9 +373,12 @@ func TestTeamResource_ReadNotFoundClearsState(t *testing.T) {
 func TestTeamResource_ReadNonNotFoundStillErrors(t *testing.T) {
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 	cases := []struct {
@@ -357,15 +397,21 @@ func TestTeamResource_ReadNonNotFoundStillErrors(t *testing.T) {
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"team_yaml": tftypes.String,
+						"origin":      tftypes.String,
+						"id":          tftypes.String,
+						"team_yaml":   tftypes.String,
+						"labels":      tftypes.Map{ElementType: tftypes.String},
+						"annotations": tftypes.Map{ElementType: tftypes.String},
+						"labels_all":  tftypes.Map{ElementType: tftypes.String},
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"team_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
+					"origin":      tftypes.NewValue(tftypes.String, "tf_backend"),
+					"id":          tftypes.NewValue(tftypes.String, nil),
+					"team_yaml":   tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
+					"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				},
 			)
 
@@ -407,9 +453,12 @@ spec:
 
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 	testClient := &testTeamClient{
@@ -421,15 +470,21 @@ spec:
 	raw := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, nil), // stuck-null from a prior transient failure
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
+			"origin":      tftypes.NewValue(tftypes.String, "tf_backend"),
+			"id":          tftypes.NewValue(tftypes.String, nil), // stuck-null from a prior transient failure
+			"team_yaml":   tftypes.NewValue(tftypes.String, stateYaml),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 
@@ -468,9 +523,12 @@ spec:
 
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 	testClient := &testTeamClient{getResponse: apiResponseYaml}
@@ -479,15 +537,21 @@ spec:
 	raw := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
+			"origin":      tftypes.NewValue(tftypes.String, "tf_backend"),
+			"id":          tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+			"team_yaml":   tftypes.NewValue(tftypes.String, stateYaml),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 
@@ -547,9 +611,12 @@ spec:
 
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 	testClient := &testTeamClient{getResponse: apiResponseYaml}
@@ -558,15 +625,21 @@ spec:
 	raw := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
+			"origin":      tftypes.NewValue(tftypes.String, "tf_backend"),
+			"id":          tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+			"team_yaml":   tftypes.NewValue(tftypes.String, stateYaml),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	dash0 "github.com/dash0hq/dash0-api-client-go"
 )
@@ -126,22 +128,31 @@ func TestTeamResource_Create_InvalidYAML(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":    tftypes.String,
-					"id":        tftypes.String,
-					"team_yaml": tftypes.String,
+					"origin":      tftypes.String,
+					"id":          tftypes.String,
+					"team_yaml":   tftypes.String,
+					"labels":      tftypes.Map{ElementType: tftypes.String},
+					"annotations": tftypes.Map{ElementType: tftypes.String},
+					"labels_all":  tftypes.Map{ElementType: tftypes.String},
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, "tf_origin"),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"team_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+				"origin":      tftypes.NewValue(tftypes.String, "tf_origin"),
+				"id":          tftypes.NewValue(tftypes.String, nil),
+				"team_yaml":   tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+				"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 			},
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
-				"origin":    schema.StringAttribute{Computed: true},
-				"id":        schema.StringAttribute{Computed: true},
-				"team_yaml": schema.StringAttribute{Required: true},
+				"origin":      schema.StringAttribute{Computed: true},
+				"id":          schema.StringAttribute{Computed: true},
+				"team_yaml":   schema.StringAttribute{Required: true},
+				"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+				"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+				"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 			},
 		},
 	}
@@ -165,22 +176,31 @@ func TestTeamResource_ReadError(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":    tftypes.String,
-					"id":        tftypes.String,
-					"team_yaml": tftypes.String,
+					"origin":      tftypes.String,
+					"id":          tftypes.String,
+					"team_yaml":   tftypes.String,
+					"labels":      tftypes.Map{ElementType: tftypes.String},
+					"annotations": tftypes.Map{ElementType: tftypes.String},
+					"labels_all":  tftypes.Map{ElementType: tftypes.String},
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, "tf_origin"),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"team_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
+				"origin":      tftypes.NewValue(tftypes.String, "tf_origin"),
+				"id":          tftypes.NewValue(tftypes.String, nil),
+				"team_yaml":   tftypes.NewValue(tftypes.String, "test-yaml"),
+				"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 			},
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
-				"origin":    schema.StringAttribute{Computed: true},
-				"id":        schema.StringAttribute{Computed: true},
-				"team_yaml": schema.StringAttribute{Required: true},
+				"origin":      schema.StringAttribute{Computed: true},
+				"id":          schema.StringAttribute{Computed: true},
+				"team_yaml":   schema.StringAttribute{Required: true},
+				"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+				"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+				"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 			},
 		},
 	}
@@ -271,15 +291,21 @@ func teamTftypesValue(origin string, id *string, teamYaml string) tftypes.Value
 	return tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, origin),
-			"id":        idValue,
-			"team_yaml": tftypes.NewValue(tftypes.String, teamYaml),
+			"origin":      tftypes.NewValue(tftypes.String, origin),
+			"id":          idValue,
+			"team_yaml":   tftypes.NewValue(tftypes.String, teamYaml),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 }
@@ -289,9 +315,12 @@ func teamTftypesValue(origin string, id *string, teamYaml string) tftypes.Value
 func teamTestSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
+			"origin":      schema.StringAttribute{Computed: true},
+			"id":          schema.StringAttribute{Computed: true},
+			"team_yaml":   schema.StringAttribute{Required: true},
+			"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"labels_all":  schema.MapAttribute{ElementType: types.StringType, Computed: true},
 		},
 	}
 }
@@ -428,6 +457,44 @@ spec:
 	mockClient.AssertExpectations(t)
 }
 
+// TestTeamResource_Update_DefaultLabels checks that the provider's default
+// labels reach the team definition and are recorded in labels_all, so that a
+// later change to them is planned as an update.
+func TestTeamResource_Update_DefaultLabels(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &TeamResource{client: mockClient, defaultLabels: map[string]string{"dash0.com/owner": "platform"}}
+
+	teamYaml := `kind: Dash0Team
+metadata:
+  name: backend-team
+spec:
+  members: []`
+
+	var sent string
+	mockClient.On("UpdateTeam", mock.Anything, "tf_backend", mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			sent = args.String(2)
+		}).
+		Return(nil)
+
+	stateID := "00000000-0000-0000-0000-000000000001"
+	req := teamUpdateRequest("tf_backend", &stateID, teamYaml, "tf_backend", &stateID, teamYaml)
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Raw: req.State.Raw, Schema: teamTestSchema()},
+	}
+
+	r.Update(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Contains(t, sent, `"dash0.com/owner":"platform"`, "default labels must be merged into the definition")
+	var finalState teamModel
+	resp.State.Get(context.Background(), &finalState)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"dash0.com/owner": types.StringValue("platform"),
+	}), finalState.LabelsAll)
+	mockClient.AssertExpectations(t)
+}
+
 // TestTeamResource_Update_CarriesOverOriginAndIDFromState is the load-bearing
 // invariant of the resource: origin is immutable after Create and id is
 // server-immutable. Even if the plan somehow carries a different origin/id
@@ -545,15 +612,21 @@ func teamImportStateResponse() *resource.ImportStateResponse {
 	nullRaw := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
+				"origin":      tftypes.String,
+				"id":          tftypes.String,
+				"team_yaml":   tftypes.String,
+				"labels":      tftypes.Map{ElementType: tftypes.String},
+				"annotations": tftypes.Map{ElementType: tftypes.String},
+				"labels_all":  tftypes.Map{ElementType: tftypes.String},
 			},
 		},
 		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, nil),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"team_yaml": tftypes.NewValue(tftypes.String, nil),
+			"origin":      tftypes.NewValue(tftypes.String, nil),
+			"id":          tftypes.NewValue(tftypes.String, nil),
+			"team_yaml":   tftypes.NewValue(tftypes.String, nil),
+			"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 	)
 	return &resource.ImportStateResponse{
@@ -929,15 +1002,21 @@ func TestTeamResource_ValidateConfig_UnknownOrNullSkips(t *testing.T) {
 			Raw: tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"team_yaml": tftypes.String,
+						"origin":      tftypes.String,
+						"id":          tftypes.String,
+						"team_yaml":   tftypes.String,
+						"labels":      tftypes.Map{ElementType: tftypes.String},
+						"annotations": tftypes.Map{ElementType: tftypes.String},
+						"labels_all":  tftypes.Map{ElementType: tftypes.String},
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, nil),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"team_yaml": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"origin":      tftypes.NewValue(tftypes.String, nil),
+					"id":          tftypes.NewValue(tftypes.String, nil),
+					"team_yaml":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"labels":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				},
 			),
 			Schema: teamTestSchema(),
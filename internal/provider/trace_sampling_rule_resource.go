@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &TraceSamplingRuleResource{}
+	_ resource.ResourceWithConfigure      = &TraceSamplingRuleResource{}
+	_ resource.ResourceWithImportState    = &TraceSamplingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &TraceSamplingRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &TraceSamplingRuleResource{}
+)
+
+// NewTraceSamplingRuleResource is a helper function to simplify the provider implementation.
+func NewTraceSamplingRuleResource() resource.Resource {
+	return &TraceSamplingRuleResource{}
+}
+
+// TraceSamplingRuleResource is the resource implementation of
+// dash0_trace_sampling_rule, which manages Dash0 sampling rules.
+type TraceSamplingRuleResource struct {
+	client        client.Client
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
+}
+
+// traceSamplingRuleModel is the Terraform state model for a sampling rule resource.
+type traceSamplingRuleModel struct {
+	Origin               types.String `tfsdk:"origin"`
+	ID                   types.String `tfsdk:"id"`
+	Dataset              types.String `tfsdk:"dataset"`
+	SamplingRuleYaml     types.String `tfsdk:"sampling_rule_yaml"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model.
+func (m traceSamplingRuleModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TraceSamplingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_trace_sampling_rule")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
+}
+
+func (r *TraceSamplingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trace_sampling_rule"
+}
+
+// ValidateConfig checks the sampling rule definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *TraceSamplingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model traceSamplingRuleModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.SamplingRuleYaml, definitionCheck{
+		attribute:    "sampling_rule_yaml",
+		metadata:     &metadata,
+		kind:         "Dash0Sampling",
+		validateJSON: client.ValidateSamplingRule,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the labels the provider merges into the definition, and
+// checks the permissions of the auth token when the permission preflight is
+// enabled.
+func (r *TraceSamplingRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_trace_sampling_rule", "", &resp.Diagnostics)
+	var plan traceSamplingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabelsAll(ctx, resp, plan.SamplingRuleYaml, plan.managedMetadata(r.defaultLabels))
+}
+
+func (r *TraceSamplingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Dash0 Sampling Rule. Sampling rules decide which traces Dash0 keeps at ingestion: a rule matches traces by its `spec.conditions` (errors, an [OTTL](https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl) condition on the spans, a probabilistic sample `rate` between 0 and 1, or a combination of them with `and`), and `spec.rateLimit` caps the traces per minute the rule keeps. " +
+			"Sampling rules belong to a dataset; use `for_each` over the datasets to roll out the same rule to several of them.",
+
+		Attributes: map[string]schema.Attribute{
+			"origin": schema.StringAttribute{
+				Description: "A unique identifier for the sampling rule, automatically generated on creation. Used to reference the sampling rule for updates, reads, deletes, and imports.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The server-assigned identifier of the sampling rule, resolved by the provider after creation. Sampling rules are not addressable in the Dash0 web app, so no `url` is exposed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the sampling rule belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. Changing this value forces the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sampling_rule_yaml": schema.StringAttribute{
+				Description: "The sampling rule definition in YAML format, with `kind: Dash0Sampling`, `metadata.name` and a `spec` with `enabled`, `conditions` and, optionally, `rateLimit` and `display`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					customplanmodifier.YAMLSemanticEqual(),
+				},
+			},
+			"labels":                 labelsAttribute("sampling rule"),
+			"annotations":            annotationsAttribute("sampling rule"),
+			"labels_all":             labelsAllAttribute("sampling rule"),
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("sampling rule"),
+		},
+	}
+}
+
+// resolveSamplingRule populates the sampling rule's server-assigned id on
+// the model by looking it up via the list endpoint. The id is best-effort
+// metadata: failures are surfaced as warnings and leave the attribute null
+// rather than failing the operation.
+func (r *TraceSamplingRuleResource) resolveSamplingRule(ctx context.Context, model *traceSamplingRuleModel, diags *diag.Diagnostics) {
+	id, err := r.client.ResolveSamplingRule(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"Unable to resolve sampling rule metadata",
+			fmt.Sprintf("The sampling rule was saved successfully, but its id could not be determined: %s", err),
+		)
+		model.ID = types.StringNull()
+		return
+	}
+	model.ID = stringOrNull(id)
+}
+
+func (r *TraceSamplingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model traceSamplingRuleModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Origin = types.StringValue("tf_" + uuid.New().String())
+
+	// Validate YAML format
+	var samplingRuleYaml interface{}
+	err := yaml.Unmarshal([]byte(model.SamplingRuleYaml.ValueString()), &samplingRuleYaml)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid YAML",
+			fmt.Sprintf("Sampling rule definition is not valid YAML: %s", err),
+		)
+		return
+	}
+
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, model.SamplingRuleYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert YAML to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert sampling rule YAML to JSON: %s", err))
+		return
+	}
+
+	err = r.client.CreateSamplingRule(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sampling rule, got error: %s", err))
+		return
+	}
+
+	// Resolve the id for the newly created sampling rule (best-effort).
+	r.resolveSamplingRule(ctx, &model, &resp.Diagnostics)
+
+	model.LabelsAll, diags = labelsAll(ctx, model.SamplingRuleYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created a sampling rule resource")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraceSamplingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state traceSamplingRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponseJSON, err := r.client.GetSamplingRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sampling rule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a sampling rule resource")
+
+	// Compare the current state with the retrieved sampling rule
+	if state.SamplingRuleYaml.ValueString() != "" {
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.SamplingRuleYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseJSON, state.IgnoreServerDefaults), additionalIgnored, nil)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Sampling Rule Comparison Error",
+				fmt.Sprintf("Error comparing sampling rules: %s. Using API response as source of truth.", err),
+			)
+			state.SamplingRuleYaml = types.StringValue(apiResponseJSON)
+		} else if !equivalent {
+			tflog.Debug(ctx, "Sampling rule has changed, updating state")
+			state.SamplingRuleYaml = types.StringValue(apiResponseJSON)
+		} else {
+			tflog.Debug(ctx, "Sampling rule is equivalent, ignoring changes in metadata fields")
+		}
+	} else {
+		state.SamplingRuleYaml = types.StringValue(apiResponseJSON)
+	}
+
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraceSamplingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get current state
+	var state traceSamplingRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan traceSamplingRuleModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate YAML format
+	var samplingRuleYaml interface{}
+	err := yaml.Unmarshal([]byte(plan.SamplingRuleYaml.ValueString()), &samplingRuleYaml)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid YAML",
+			fmt.Sprintf("Sampling rule definition is not valid YAML: %s", err),
+		)
+		return
+	}
+
+	// Apply provider-managed metadata (labels, annotations)
+	definition, diags := mergeManagedMetadata(ctx, plan.SamplingRuleYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert YAML to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert sampling rule YAML to JSON: %s", err))
+		return
+	}
+
+	// Update the existing sampling rule (dataset changes force recreation via RequiresReplace)
+	plan.Origin = state.Origin
+	// The sampling rule's identifier is immutable, so the id never changes on
+	// update; carry it from state instead of re-resolving it via the API.
+	plan.ID = state.ID
+	err = r.client.UpdateSamplingRule(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sampling rule, got error: %s", err))
+		return
+	}
+
+	plan.LabelsAll, diags = labelsAll(ctx, plan.SamplingRuleYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated a sampling rule resource")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraceSamplingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state traceSamplingRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSamplingRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sampling rule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a sampling rule resource")
+}
+
+// ImportState function is required for resources that support import
+func (r *TraceSamplingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
+		)
+		return
+	}
+
+	dataset := idParts[0]
+	origin := idParts[1]
+
+	apiResponseJSON, err := r.client.GetSamplingRule(ctx, origin, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Sampling Rule",
+			fmt.Sprintf("Could not get sampling rule with origin=%s, dataset=%s: %s", origin, dataset, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), origin)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset"), dataset)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sampling_rule_yaml"), apiResponseJSON)...)
+
+	// Resolve the id (best-effort).
+	model := traceSamplingRuleModel{Origin: types.StringValue(origin), Dataset: types.StringValue(dataset)}
+	r.resolveSamplingRule(ctx, &model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
+}
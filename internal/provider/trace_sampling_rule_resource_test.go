@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSamplingRuleYaml = `kind: Dash0Sampling
+metadata:
+  name: checkout-errors
+spec:
+  enabled: true
+  conditions:
+    kind: and
+    spec:
+      conditions:
+        - kind: error
+          spec: {}
+        - kind: ottl
+          spec:
+            ottl: resource.attributes["service.name"] == "checkout"
+  rateLimit:
+    rate: 120`
+
+// testSamplingRuleResponse is the sampling rule as returned by the API, with
+// the display name the server adds when the definition has none.
+const testSamplingRuleResponse = `{"kind":"Dash0Sampling","metadata":{"name":"checkout-errors"},"spec":{"enabled":true,"conditions":{"kind":"and","spec":{"conditions":[{"kind":"error","spec":{}},{"kind":"ottl","spec":{"ottl":"resource.attributes[\"service.name\"] == \"checkout\""}}]}},"rateLimit":{"rate":120},"display":{"name":"checkout-errors"}}}`
+
+func traceSamplingRuleState(t *testing.T, r *TraceSamplingRuleResource, model traceSamplingRuleModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	// The zero value of a map has no element type, so unset maps are stored
+	// as null maps.
+	if model.Labels.ElementType(ctx) == nil {
+		model.Labels = types.MapNull(types.StringType)
+	}
+	if model.Annotations.ElementType(ctx) == nil {
+		model.Annotations = types.MapNull(types.StringType)
+	}
+	if model.LabelsAll.ElementType(ctx) == nil {
+		model.LabelsAll = types.MapNull(types.StringType)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func TestTraceSamplingRuleResource_Metadata(t *testing.T) {
+	r := &TraceSamplingRuleResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_trace_sampling_rule", resp.TypeName)
+}
+
+func TestTraceSamplingRuleResource_Schema(t *testing.T) {
+	r := &TraceSamplingRuleResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	assert.True(t, resp.Schema.Attributes["origin"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["id"].IsComputed())
+	assert.True(t, resp.Schema.Attributes["dataset"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["sampling_rule_yaml"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["ignore_server_defaults"].IsOptional())
+}
+
+func TestTraceSamplingRuleResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &TraceSamplingRuleResource{}
+
+	for name, tc := range map[string]struct {
+		definition string
+		wantError  bool
+	}{
+		"valid":      {definition: testSamplingRuleYaml},
+		"wrong kind": {definition: "kind: Dash0View\nmetadata:\n  name: checkout\nspec: {}", wantError: true},
+		"wrong type": {definition: "kind: Dash0Sampling\nmetadata:\n  name: checkout\nspec:\n  enabled: sometimes", wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := traceSamplingRuleState(t, r, traceSamplingRuleModel{
+				Dataset:          types.StringValue("default"),
+				SamplingRuleYaml: types.StringValue(tc.definition),
+			})
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config(state)}, &resp)
+			assert.Equal(t, tc.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestTraceSamplingRuleResource_Create(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TraceSamplingRuleResource{client: mockClient}
+	mockClient.On("CreateSamplingRule", ctx, mock.MatchedBy(func(origin string) bool { return len(origin) > 3 && origin[:3] == "tf_" }), mock.AnythingOfType("string"), "default").Return(nil)
+	mockClient.On("ResolveSamplingRule", ctx, mock.AnythingOfType("string"), "default").Return("sampling_01abc", nil)
+
+	plan := traceSamplingRuleState(t, r, traceSamplingRuleModel{
+		Origin:               types.StringUnknown(),
+		ID:                   types.StringUnknown(),
+		Dataset:              types.StringValue("default"),
+		SamplingRuleYaml:     types.StringValue(testSamplingRuleYaml),
+		IgnoreServerDefaults: types.BoolNull(),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model traceSamplingRuleModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "sampling_01abc", model.ID.ValueString())
+	assert.Equal(t, testSamplingRuleYaml, model.SamplingRuleYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+// TestTraceSamplingRuleResource_Read_ServerDefaults checks that the display
+// name the server adds is reported as drift unless ignore_server_defaults is
+// set.
+func TestTraceSamplingRuleResource_Read_ServerDefaults(t *testing.T) {
+	ctx := context.Background()
+	for _, ignore := range []bool{false, true} {
+		mockClient := &MockClient{}
+		r := &TraceSamplingRuleResource{client: mockClient}
+		mockClient.On("GetSamplingRule", ctx, "tf_checkout", "default").Return(testSamplingRuleResponse, nil)
+
+		state := traceSamplingRuleState(t, r, traceSamplingRuleModel{
+			Origin:               types.StringValue("tf_checkout"),
+			ID:                   types.StringValue("sampling_01abc"),
+			Dataset:              types.StringValue("default"),
+			SamplingRuleYaml:     types.StringValue(testSamplingRuleYaml),
+			IgnoreServerDefaults: types.BoolValue(ignore),
+		})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var model traceSamplingRuleModel
+		resp.State.Get(ctx, &model)
+		if ignore {
+			assert.Equal(t, testSamplingRuleYaml, model.SamplingRuleYaml.ValueString())
+		} else {
+			assert.Equal(t, testSamplingRuleResponse, model.SamplingRuleYaml.ValueString())
+		}
+	}
+}
+
+// TestTraceSamplingRuleResource_Read_ManagedLabels checks that a label
+// managed by the labels attribute and changed outside Terraform is written
+// into state, so that the next plan restores it.
+func TestTraceSamplingRuleResource_Read_ManagedLabels(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TraceSamplingRuleResource{client: mockClient}
+	response := strings.Replace(testSamplingRuleResponse, `"metadata":{"name":"checkout-errors"}`, `"metadata":{"name":"checkout-errors","labels":{"custom":{"team":"checkout"},"team":"payments"}}`, 1)
+	mockClient.On("GetSamplingRule", ctx, "tf_checkout", "default").Return(response, nil)
+
+	state := traceSamplingRuleState(t, r, traceSamplingRuleModel{
+		Origin:               types.StringValue("tf_checkout"),
+		ID:                   types.StringValue("sampling_01abc"),
+		Dataset:              types.StringValue("default"),
+		SamplingRuleYaml:     types.StringValue(testSamplingRuleYaml),
+		Labels:               types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("checkout")}),
+		IgnoreServerDefaults: types.BoolValue(true),
+	})
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model traceSamplingRuleModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, testSamplingRuleYaml, model.SamplingRuleYaml.ValueString(), "labels are not compared as part of the definition")
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")}), model.Labels)
+}
+
+func TestTraceSamplingRuleResource_Update(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TraceSamplingRuleResource{client: mockClient}
+	mockClient.On("UpdateSamplingRule", ctx, "tf_checkout", mock.AnythingOfType("string"), "default").Return(nil)
+
+	state := traceSamplingRuleState(t, r, traceSamplingRuleModel{
+		Origin:           types.StringValue("tf_checkout"),
+		ID:               types.StringValue("sampling_01abc"),
+		Dataset:          types.StringValue("default"),
+		SamplingRuleYaml: types.StringValue(testSamplingRuleYaml),
+	})
+	plan := traceSamplingRuleState(t, r, traceSamplingRuleModel{
+		Origin:           types.StringUnknown(),
+		ID:               types.StringUnknown(),
+		Dataset:          types.StringValue("default"),
+		SamplingRuleYaml: types.StringValue(testSamplingRuleYaml[:len(testSamplingRuleYaml)-3] + "600"),
+	})
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: tfsdk.Plan(plan)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model traceSamplingRuleModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "tf_checkout", model.Origin.ValueString())
+	assert.Equal(t, "sampling_01abc", model.ID.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestTraceSamplingRuleResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TraceSamplingRuleResource{client: mockClient}
+	mockClient.On("GetSamplingRule", ctx, "tf_checkout", "default").Return(testSamplingRuleResponse, nil)
+	mockClient.On("ResolveSamplingRule", ctx, "tf_checkout", "default").Return("sampling_01abc", nil)
+
+	state := traceSamplingRuleState(t, r, traceSamplingRuleModel{})
+	resp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "default,tf_checkout"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model traceSamplingRuleModel
+	resp.State.Get(ctx, &model)
+	assert.Equal(t, "tf_checkout", model.Origin.ValueString())
+	assert.Equal(t, "default", model.Dataset.ValueString())
+	assert.Equal(t, "sampling_01abc", model.ID.ValueString())
+	assert.Equal(t, testSamplingRuleResponse, model.SamplingRuleYaml.ValueString())
+
+	resp = resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_checkout"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,761 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// typedNotificationChannel describes a notification channel resource for a
+// single channel type, such as dash0_notification_channel_slack. Instead of a
+// YAML definition, these resources expose the channel's spec.config as typed
+// attributes, so that secrets can be marked sensitive and the configuration is
+// validated by Terraform. They all share TypedNotificationChannelResource.
+type typedNotificationChannel struct {
+	// typeName is the suffix of the resource type name, for example "slack"
+	// for dash0_notification_channel_slack.
+	typeName string
+	// channelType is the spec.type of the channels the resource manages.
+	channelType string
+	description string
+	fields      []channelConfigField
+}
+
+// channelConfigField maps a resource attribute to a key of spec.config.
+type channelConfigField struct {
+	// attribute is the name of the resource attribute, for example
+	// "webhook_url".
+	attribute string
+	// key is the key in spec.config, for example "webhookURL".
+	key         string
+	description string
+	required    bool
+	// sensitive fields are redacted in plans. If the API leaves a sensitive
+	// field out of its response, the value in state is kept.
+	sensitive bool
+	// writeOnly string fields are never stored in the plan or state. Each
+	// comes with an "<attribute>_version" attribute that is changed to send a
+	// new value. A write-only field usually shares its key with a sensitive
+	// field, so that either of them can be used; ValidateConfig requires
+	// exactly one of such fields.
+	writeOnly bool
+	kind      channelFieldKind
+	// defaultValue is sent when a string field is not set. It is not read
+	// back into state, so that leaving the field out of the configuration
+	// shows no drift.
+	defaultValue   string
+	validators     []validator.String
+	listValidators []validator.List
+}
+
+// channelFieldKind is the type of a channelConfigField.
+type channelFieldKind int
+
+const (
+	channelFieldString channelFieldKind = iota
+	// channelFieldList fields hold a list of strings.
+	channelFieldList
+	// channelFieldMap fields hold a map of strings.
+	channelFieldMap
+	// channelFieldBool fields hold a boolean. Like the frequency, they are
+	// only compared for drift when they are set.
+	channelFieldBool
+)
+
+// routingFilterOperators are the operators of Dash0 attribute filters.
+var routingFilterOperators = []string{
+	"is", "is_not", "is_set", "is_not_set", "is_one_of", "is_not_one_of",
+	"gt", "lt", "gte", "lte", "matches", "does_not_match",
+	"contains", "does_not_contain", "starts_with", "does_not_start_with",
+	"ends_with", "does_not_end_with", "is_any",
+}
+
+// routingFilterGroupModel is an element of the routing_filters attribute.
+type routingFilterGroupModel struct {
+	Conditions []routingConditionModel `tfsdk:"conditions"`
+}
+
+// routingConditionModel is a condition of a routing filter group.
+type routingConditionModel struct {
+	Key      types.String `tfsdk:"key"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	Values   types.List   `tfsdk:"values"`
+}
+
+var routingConditionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"key":      types.StringType,
+	"operator": types.StringType,
+	"value":    types.StringType,
+	"values":   types.ListType{ElemType: types.StringType},
+}}
+
+var routingFilterGroupType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"conditions": types.ListType{ElemType: routingConditionType},
+}}
+
+// typedChannelDefinition is the part of a notification channel definition
+// that the typed resources read from the API.
+type typedChannelDefinition struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		Type      string                 `json:"type"`
+		Config    map[string]interface{} `json:"config"`
+		Frequency string                 `json:"frequency"`
+		Routing   struct {
+			Filters [][]struct {
+				Key      string        `json:"key"`
+				Operator string        `json:"operator"`
+				Value    interface{}   `json:"value"`
+				Values   []interface{} `json:"values"`
+			} `json:"filters"`
+		} `json:"routing"`
+	} `json:"spec"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &TypedNotificationChannelResource{}
+	_ resource.ResourceWithConfigure      = &TypedNotificationChannelResource{}
+	_ resource.ResourceWithImportState    = &TypedNotificationChannelResource{}
+	_ resource.ResourceWithValidateConfig = &TypedNotificationChannelResource{}
+)
+
+// TypedNotificationChannelResource is the implementation shared by the
+// notification channel resources of a single channel type. Its state is
+// handled as a generic object, because the attributes depend on the channel.
+type TypedNotificationChannelResource struct {
+	channel typedNotificationChannel
+	client  client.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TypedNotificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TypedNotificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel_" + r.channel.typeName
+}
+
+func (r *TypedNotificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"origin": schema.StringAttribute{
+			Description: "A unique identifier for the notification channel, automatically generated on creation. Used to reference the notification channel for updates, reads, deletes, and imports.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"id": schema.StringAttribute{
+			Description: "The server-assigned UUID of the notification channel, resolved by the provider after creation. Reference this value when wiring the channel into another resource, for example in the `notification_channel_ids` of a `dash0_check_rule`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Description: "The URL to open this notification channel in the Dash0 web app. May be empty if the app URL cannot be derived (e.g. for self-hosted deployments with a custom web app domain).",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The display name of the notification channel.",
+			Required:    true,
+		},
+		"frequency": schema.StringAttribute{
+			Description: "How often notifications are repeated while a check keeps failing, as a duration such as `30m`. When omitted, the Dash0 default of `10m` applies and the frequency is not compared for drift.",
+			Optional:    true,
+		},
+		"routing_filters": schema.ListNestedAttribute{
+			Description: "Restricts the failed checks that are delivered to the channel. Each element is a group of conditions; a failed check is delivered when all conditions of at least one group match its attributes. " +
+				"When omitted, the channel is saved without routing filters, and filters added outside of Terraform are not reported as drift.",
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"conditions": schema.ListNestedAttribute{
+						Description: "The conditions of the group, which must all match.",
+						Required:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"key": schema.StringAttribute{
+									Description: "The attribute to match, for example `deployment.environment.name`.",
+									Required:    true,
+								},
+								"operator": schema.StringAttribute{
+									Description: "The match operation, for example `is`, `is_not`, `is_one_of` or `matches`.",
+									Required:    true,
+									Validators:  []validator.String{oneOf(routingFilterOperators...)},
+								},
+								"value": schema.StringAttribute{
+									Description: "The value to compare with. Not used by `is_set`, `is_not_set`, `is_one_of` and `is_not_one_of`.",
+									Optional:    true,
+								},
+								"values": schema.ListAttribute{
+									Description: "The values to compare with, for `is_one_of` and `is_not_one_of`.",
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, f := range r.channel.fields {
+		switch f.kind {
+		case channelFieldList:
+			attributes[f.attribute] = schema.ListAttribute{
+				Description: f.description,
+				ElementType: types.StringType,
+				Required:    f.required,
+				Optional:    !f.required,
+				Sensitive:   f.sensitive,
+				Validators:  f.listValidators,
+			}
+		case channelFieldMap:
+			attributes[f.attribute] = schema.MapAttribute{
+				Description: f.description,
+				ElementType: types.StringType,
+				Required:    f.required,
+				Optional:    !f.required,
+				Sensitive:   f.sensitive,
+			}
+		case channelFieldBool:
+			attributes[f.attribute] = schema.BoolAttribute{
+				Description: f.description,
+				Required:    f.required,
+				Optional:    !f.required,
+			}
+		default:
+			attributes[f.attribute] = schema.StringAttribute{
+				Description: f.description,
+				Required:    f.required,
+				Optional:    !f.required,
+				Sensitive:   f.sensitive || f.writeOnly,
+				WriteOnly:   f.writeOnly,
+				Validators:  f.validators,
+			}
+			if f.writeOnly {
+				attributes[f.attribute+"_version"] = schema.Int64Attribute{
+					Description: fmt.Sprintf("A version number for `%s`. Changing it updates the notification channel with the current value of `%s`, for example after the secret has been rotated.", f.attribute, f.attribute),
+					Optional:    true,
+				}
+			}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.channel.description,
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig requires exactly one of the attributes that share a key of
+// spec.config, such as a sensitive attribute and its write-only alternative.
+func (r *TypedNotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	attributes := map[string][]string{}
+	var keys []string
+	for _, f := range r.channel.fields {
+		if attributes[f.key] == nil {
+			keys = append(keys, f.key)
+		}
+		attributes[f.key] = append(attributes[f.key], f.attribute)
+	}
+	for _, key := range keys {
+		names := attributes[key]
+		if len(names) < 2 {
+			continue
+		}
+		var set []string
+		unknown := false
+		for _, name := range names {
+			var value types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+			switch {
+			case value.IsUnknown():
+				unknown = true
+			case !value.IsNull():
+				set = append(set, name)
+			}
+		}
+		switch {
+		case len(set) > 1:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(set[1]),
+				"Conflicting Attributes",
+				fmt.Sprintf("Only one of %s can be set.", strings.Join(quoted(names), ", ")),
+			)
+		case len(set) == 0 && !unknown:
+			resp.Diagnostics.AddError(
+				"Missing Attribute",
+				fmt.Sprintf("One of %s must be set.", strings.Join(quoted(names), ", ")),
+			)
+		}
+	}
+}
+
+// definition builds the JSON definition of the notification channel from the
+// values of the resource's attributes.
+func (c typedNotificationChannel) definition(ctx context.Context, values map[string]attr.Value) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := map[string]interface{}{}
+	for _, f := range c.fields {
+		switch v := values[f.attribute].(type) {
+		case types.String:
+			switch {
+			case !v.IsNull():
+				config[f.key] = v.ValueString()
+			case f.defaultValue != "":
+				config[f.key] = f.defaultValue
+			}
+		case types.List:
+			if !v.IsNull() {
+				var items []string
+				diags.Append(v.ElementsAs(ctx, &items, false)...)
+				config[f.key] = items
+			}
+		case types.Map:
+			if !v.IsNull() {
+				entries := map[string]string{}
+				diags.Append(v.ElementsAs(ctx, &entries, false)...)
+				config[f.key] = entries
+			}
+		case types.Bool:
+			if !v.IsNull() {
+				config[f.key] = v.ValueBool()
+			}
+		}
+	}
+
+	spec := map[string]interface{}{
+		"type":   c.channelType,
+		"config": config,
+	}
+	if frequency, _ := values["frequency"].(types.String); !frequency.IsNull() {
+		spec["frequency"] = frequency.ValueString()
+	}
+	if groups, _ := values["routing_filters"].(types.List); !groups.IsNull() {
+		var models []routingFilterGroupModel
+		diags.Append(groups.ElementsAs(ctx, &models, false)...)
+		filters := make([][]map[string]interface{}, 0, len(models))
+		for _, group := range models {
+			conditions := make([]map[string]interface{}, 0, len(group.Conditions))
+			for _, condition := range group.Conditions {
+				filter := map[string]interface{}{
+					"key":      condition.Key.ValueString(),
+					"operator": condition.Operator.ValueString(),
+				}
+				if !condition.Value.IsNull() {
+					filter["value"] = condition.Value.ValueString()
+				}
+				if !condition.Values.IsNull() {
+					var items []string
+					diags.Append(condition.Values.ElementsAs(ctx, &items, false)...)
+					filter["values"] = items
+				}
+				conditions = append(conditions, filter)
+			}
+			filters = append(filters, conditions)
+		}
+		spec["routing"] = map[string]interface{}{"filters": filters}
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	name, _ := values["name"].(types.String)
+	channelJSON, err := json.Marshal(map[string]interface{}{
+		"kind":     "Dash0NotificationChannel",
+		"metadata": map[string]interface{}{"name": name.ValueString()},
+		"spec":     spec,
+	})
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to build the notification channel definition: %s", err))
+	}
+	return string(channelJSON), diags
+}
+
+// applyDefinition sets the values of the resource's attributes from the
+// notification channel definition returned by the API. The frequency, the
+// routing filters and boolean fields are only read back when they are managed,
+// that is when values holds them, or on import.
+func (c typedNotificationChannel) applyDefinition(ctx context.Context, channelJSON string, values map[string]attr.Value, importing bool, diags *diag.Diagnostics) {
+	var def typedChannelDefinition
+	if err := json.Unmarshal([]byte(channelJSON), &def); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to parse notification channel, got error: %s", err))
+		return
+	}
+	if def.Spec.Type != c.channelType {
+		diags.AddError(
+			"Unexpected Notification Channel Type",
+			fmt.Sprintf("The notification channel is of type %q, but dash0_notification_channel_%s manages channels of type %q. Manage it with dash0_notification_channel instead.", def.Spec.Type, c.typeName, c.channelType),
+		)
+		return
+	}
+
+	values["name"] = types.StringValue(def.Metadata.Name)
+
+	for _, f := range c.fields {
+		prior := values[f.attribute]
+		switch f.kind {
+		case channelFieldList:
+			items := configStrings(def.Spec.Config[f.key])
+			if len(items) == 0 {
+				values[f.attribute] = types.ListNull(types.StringType)
+				continue
+			}
+			list, d := types.ListValueFrom(ctx, types.StringType, items)
+			diags.Append(d...)
+			values[f.attribute] = list
+			continue
+		case channelFieldMap:
+			entries, _ := def.Spec.Config[f.key].(map[string]interface{})
+			switch {
+			case len(entries) > 0:
+				m := make(map[string]string, len(entries))
+				for k, v := range entries {
+					if items := configStrings([]interface{}{v}); len(items) == 1 {
+						m[k] = items[0]
+					}
+				}
+				value, d := types.MapValueFrom(ctx, types.StringType, m)
+				diags.Append(d...)
+				values[f.attribute] = value
+			case f.sensitive && prior != nil && !prior.IsNull():
+				// The secrets were left out of the response; keep the value in state.
+			default:
+				values[f.attribute] = types.MapNull(types.StringType)
+			}
+			continue
+		case channelFieldBool:
+			b, ok := def.Spec.Config[f.key].(bool)
+			switch {
+			case prior != nil && !prior.IsNull():
+				values[f.attribute] = types.BoolValue(b)
+			case importing && ok:
+				values[f.attribute] = types.BoolValue(b)
+			default:
+				values[f.attribute] = types.BoolNull()
+			}
+			continue
+		}
+		if f.writeOnly {
+			values[f.attribute] = types.StringNull()
+			continue
+		}
+		s, _ := def.Spec.Config[f.key].(string)
+		switch {
+		case c.writeOnlySibling(f) && (prior == nil || prior.IsNull()):
+			// The value is managed through the write-only attribute.
+			values[f.attribute] = types.StringNull()
+		case s == f.defaultValue && (prior == nil || prior.IsNull()):
+			values[f.attribute] = types.StringNull()
+		case s != "":
+			values[f.attribute] = types.StringValue(s)
+		case f.sensitive && prior != nil && !prior.IsNull():
+			// The secret was left out of the response; keep the value in state.
+		default:
+			values[f.attribute] = types.StringNull()
+		}
+	}
+
+	frequency, _ := values["frequency"].(types.String)
+	switch {
+	case frequency.IsNull() && !importing:
+		values["frequency"] = types.StringNull()
+	case !frequency.IsNull() && sameDuration(frequency.ValueString(), def.Spec.Frequency):
+		// The API normalizes durations, for example "10m" to "10m0s".
+	default:
+		values["frequency"] = stringOrNull(def.Spec.Frequency)
+	}
+
+	filters := def.Spec.Routing.Filters
+	if groups, _ := values["routing_filters"].(types.List); groups.IsNull() && (!importing || len(filters) == 0) {
+		values["routing_filters"] = types.ListNull(routingFilterGroupType)
+		return
+	}
+	groups := make([]routingFilterGroupModel, 0, len(filters))
+	for _, group := range filters {
+		conditions := make([]routingConditionModel, 0, len(group))
+		for _, filter := range group {
+			condition := routingConditionModel{
+				Key:      types.StringValue(filter.Key),
+				Operator: types.StringValue(filter.Operator),
+				Value:    types.StringNull(),
+				Values:   types.ListNull(types.StringType),
+			}
+			if value := configStrings([]interface{}{filter.Value}); len(value) == 1 && value[0] != "" {
+				condition.Value = types.StringValue(value[0])
+			}
+			if len(filter.Values) > 0 {
+				list, d := types.ListValueFrom(ctx, types.StringType, configStrings(filter.Values))
+				diags.Append(d...)
+				condition.Values = list
+			}
+			conditions = append(conditions, condition)
+		}
+		groups = append(groups, routingFilterGroupModel{Conditions: conditions})
+	}
+	list, d := types.ListValueFrom(ctx, routingFilterGroupType, groups)
+	diags.Append(d...)
+	values["routing_filters"] = list
+}
+
+// writeOnlySibling reports whether a write-only field shares the key of f.
+func (c typedNotificationChannel) writeOnlySibling(f channelConfigField) bool {
+	for _, other := range c.fields {
+		if other.attribute != f.attribute && other.key == f.key && other.writeOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// withWriteOnlyValues returns values with the write-only attributes taken
+// from config, where Terraform provides them.
+func (c typedNotificationChannel) withWriteOnlyValues(ctx context.Context, values map[string]attr.Value, config tfsdk.Config, diags *diag.Diagnostics) map[string]attr.Value {
+	withSecrets := maps.Clone(values)
+	for _, f := range c.fields {
+		if !f.writeOnly {
+			continue
+		}
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(f.attribute), &value)...)
+		withSecrets[f.attribute] = value
+	}
+	return withSecrets
+}
+
+// configStrings returns the elements of a JSON array as strings. Non-string
+// elements are encoded as JSON; null elements are skipped.
+func configStrings(value interface{}) []string {
+	items, _ := value.([]interface{})
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case nil:
+		case string:
+			out = append(out, v)
+		default:
+			encoded, _ := json.Marshal(v)
+			out = append(out, string(encoded))
+		}
+	}
+	return out
+}
+
+// sameDuration reports whether a and b are the same duration, for example
+// "10m" and "10m0s". Values that are not durations are compared as strings.
+func sameDuration(a, b string) bool {
+	da, errA := time.ParseDuration(a)
+	db, errB := time.ParseDuration(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return da == db
+}
+
+// resolve populates the channel's server-assigned id and web app URL. Both are
+// best-effort metadata: failures are surfaced as warnings and leave the
+// attributes null rather than failing the operation.
+func (r *TypedNotificationChannelResource) resolve(ctx context.Context, values map[string]attr.Value, diags *diag.Diagnostics) {
+	origin, _ := values["origin"].(types.String)
+	id, channelURL, err := r.client.ResolveNotificationChannel(ctx, origin.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"Unable to resolve notification channel metadata",
+			fmt.Sprintf("The notification channel was saved successfully, but its id and URL could not be determined: %s", err),
+		)
+		values["id"] = types.StringNull()
+		values["url"] = types.StringNull()
+		return
+	}
+	values["id"] = stringOrNull(id)
+	values["url"] = stringOrNull(channelURL)
+}
+
+// setState stores values, which hold every attribute of the resource, in
+// state.
+func setState(ctx context.Context, object types.Object, values map[string]attr.Value, state *tfsdk.State, diags *diag.Diagnostics) {
+	value, d := types.ObjectValue(object.AttributeTypes(ctx), values)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	diags.Append(state.Set(ctx, value)...)
+}
+
+func (r *TypedNotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan types.Object
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values := plan.Attributes()
+	origin := "tf_" + uuid.New().String()
+	values["origin"] = types.StringValue(origin)
+
+	channelJSON, diags := r.channel.definition(ctx, r.channel.withWriteOnlyValues(ctx, values, req.Config, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateNotificationChannel(ctx, origin, channelJSON); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification channel, got error: %s", err))
+		return
+	}
+
+	// Resolve the id and web app URL for the newly created channel (best-effort).
+	r.resolve(ctx, values, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a "+r.channel.typeName+" notification channel resource")
+
+	setState(ctx, plan, values, &resp.State, &resp.Diagnostics)
+}
+
+func (r *TypedNotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state types.Object
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values := state.Attributes()
+	origin, _ := values["origin"].(types.String)
+
+	channelJSON, err := r.client.GetNotificationChannel(ctx, origin.ValueString())
+	if err != nil {
+		if dash0.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Notification channel %s no longer exists on the server; removing from state", origin.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification channel, got error: %s", err))
+		return
+	}
+
+	r.channel.applyDefinition(ctx, channelJSON, values, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read a "+r.channel.typeName+" notification channel resource")
+
+	setState(ctx, state, values, &resp.State, &resp.Diagnostics)
+}
+
+func (r *TypedNotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state types.Object
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values := plan.Attributes()
+	// The origin and the server-assigned identifier are immutable, so carry
+	// them, and the URL derived from the identifier, from state.
+	prior := state.Attributes()
+	for _, name := range []string{"origin", "id", "url"} {
+		values[name] = prior[name]
+	}
+	origin, _ := values["origin"].(types.String)
+
+	channelJSON, diags := r.channel.definition(ctx, r.channel.withWriteOnlyValues(ctx, values, req.Config, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateNotificationChannel(ctx, origin.ValueString(), channelJSON); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a "+r.channel.typeName+" notification channel resource")
+
+	setState(ctx, plan, values, &resp.State, &resp.Diagnostics)
+}
+
+func (r *TypedNotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var origin types.String
+	diags := req.State.GetAttribute(ctx, path.Root("origin"), &origin)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteNotificationChannel(ctx, origin.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a "+r.channel.typeName+" notification channel resource")
+}
+
+// ImportState imports a notification channel by origin. Channels of another
+// type are rejected.
+func (r *TypedNotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	origin := req.ID
+
+	channelJSON, err := r.client.GetNotificationChannel(ctx, origin)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Notification Channel",
+			fmt.Sprintf("Could not get notification channel with origin=%s: %s", origin, err),
+		)
+		return
+	}
+
+	values := map[string]attr.Value{"origin": types.StringValue(origin)}
+	r.channel.applyDefinition(ctx, channelJSON, values, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.resolve(ctx, values, &resp.Diagnostics)
+
+	for name, value := range values {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
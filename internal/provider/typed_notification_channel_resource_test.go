@@ -0,0 +1,504 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// typedChannelValues returns the values of all attributes of r, with the
+// given overrides; every other attribute is null.
+func typedChannelValues(t *testing.T, r resource.Resource, overrides map[string]attr.Value) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().(types.ObjectType)
+
+	values := map[string]attr.Value{}
+	for name, attrType := range objectType.AttrTypes {
+		if v, ok := overrides[name]; ok {
+			values[name] = v
+			continue
+		}
+		raw, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		require.NoError(t, err)
+		values[name] = raw
+	}
+	object, diags := types.ObjectValue(objectType.AttrTypes, values)
+	require.False(t, diags.HasError(), diags)
+	raw, err := object.ToTerraformValue(ctx)
+	require.NoError(t, err)
+
+	return tfsdk.State{Raw: raw, Schema: schemaResp.Schema}, tfsdk.Plan{Raw: raw, Schema: schemaResp.Schema}
+}
+
+func slackRoutingFilters(t *testing.T) types.List {
+	t.Helper()
+	groups := []routingFilterGroupModel{{Conditions: []routingConditionModel{
+		{Key: types.StringValue("team.name"), Operator: types.StringValue("is"), Value: types.StringValue("sre"), Values: types.ListNull(types.StringType)},
+		{
+			Key:      types.StringValue("deployment.environment.name"),
+			Operator: types.StringValue("is_one_of"),
+			Value:    types.StringNull(),
+			Values:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("production"), types.StringValue("staging")}),
+		},
+	}}}
+	list, diags := types.ListValueFrom(context.Background(), routingFilterGroupType, groups)
+	require.False(t, diags.HasError(), diags)
+	return list
+}
+
+func TestSlackNotificationChannelResource_Create(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TypedNotificationChannelResource{channel: slackNotificationChannel, client: mockClient}
+
+	var sent string
+	mockClient.On("CreateNotificationChannel", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { sent = args.String(2) }).
+		Return(nil)
+	mockClient.On("ResolveNotificationChannel", ctx, mock.AnythingOfType("string")).
+		Return("8c1f0b1e-1111-2222-3333-444455556666", "https://app.dash0.com/channel", nil)
+
+	_, plan := typedChannelValues(t, r, map[string]attr.Value{
+		"name":            types.StringValue("Slack Alerts"),
+		"webhook_url":     types.StringValue("https://hooks.slack.com/services/T/B/X"),
+		"channel":         types.StringValue("#alerts"),
+		"frequency":       types.StringValue("30m"),
+		"routing_filters": slackRoutingFilters(t),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	require.NoError(t, client.ValidateNotificationChannel(sent))
+	var def map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(sent), &def))
+	assert.Equal(t, map[string]interface{}{
+		"type":      "slack",
+		"frequency": "30m",
+		"config": map[string]interface{}{
+			"webhookURL": "https://hooks.slack.com/services/T/B/X",
+			"channel":    "#alerts",
+		},
+		"routing": map[string]interface{}{
+			"filters": []interface{}{[]interface{}{
+				map[string]interface{}{"key": "team.name", "operator": "is", "value": "sre"},
+				map[string]interface{}{"key": "deployment.environment.name", "operator": "is_one_of", "values": []interface{}{"production", "staging"}},
+			}},
+		},
+	}, def["spec"])
+
+	var origin, id types.String
+	resp.State.GetAttribute(ctx, path.Root("origin"), &origin)
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	assert.Contains(t, origin.ValueString(), "tf_")
+	assert.Equal(t, "8c1f0b1e-1111-2222-3333-444455556666", id.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSlackNotificationChannelResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unmanaged fields are not read back", func(t *testing.T) {
+		testClient := &testNotificationChannelClient{getResponse: `{
+			"kind": "Dash0NotificationChannel",
+			"metadata": {"name": "Renamed"},
+			"spec": {
+				"type": "slack",
+				"frequency": "10m0s",
+				"config": {"channel": "#other"},
+				"routing": {"filters": [[{"key": "team.name", "operator": "is", "value": "sre"}]]}
+			}
+		}`}
+		r := &TypedNotificationChannelResource{channel: slackNotificationChannel, client: testClient}
+		state, _ := typedChannelValues(t, r, map[string]attr.Value{
+			"origin":      types.StringValue("tf_origin"),
+			"name":        types.StringValue("Slack Alerts"),
+			"webhook_url": types.StringValue("https://hooks.slack.com/services/T/B/X"),
+		})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var name, webhookURL, channel, frequency types.String
+		var filters types.List
+		resp.State.GetAttribute(ctx, path.Root("name"), &name)
+		resp.State.GetAttribute(ctx, path.Root("webhook_url"), &webhookURL)
+		resp.State.GetAttribute(ctx, path.Root("channel"), &channel)
+		resp.State.GetAttribute(ctx, path.Root("frequency"), &frequency)
+		resp.State.GetAttribute(ctx, path.Root("routing_filters"), &filters)
+		assert.Equal(t, "Renamed", name.ValueString())
+		// The webhook URL is left out of the response, so the value in state is kept.
+		assert.Equal(t, "https://hooks.slack.com/services/T/B/X", webhookURL.ValueString())
+		assert.Equal(t, "#other", channel.ValueString())
+		assert.True(t, frequency.IsNull())
+		assert.True(t, filters.IsNull())
+	})
+
+	t.Run("normalized frequency is not drift", func(t *testing.T) {
+		testClient := &testNotificationChannelClient{getResponse: `{
+			"metadata": {"name": "Slack Alerts"},
+			"spec": {"type": "slack", "frequency": "30m0s", "config": {"webhookURL": "https://hooks.slack.com/services/T/B/X"}}
+		}`}
+		r := &TypedNotificationChannelResource{channel: slackNotificationChannel, client: testClient}
+		state, _ := typedChannelValues(t, r, map[string]attr.Value{
+			"origin":    types.StringValue("tf_origin"),
+			"frequency": types.StringValue("30m"),
+		})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var frequency types.String
+		resp.State.GetAttribute(ctx, path.Root("frequency"), &frequency)
+		assert.Equal(t, "30m", frequency.ValueString())
+	})
+
+	t.Run("other channel type", func(t *testing.T) {
+		testClient := &testNotificationChannelClient{getResponse: `{"spec": {"type": "webhook", "config": {"url": "https://example.com"}}}`}
+		r := &TypedNotificationChannelResource{channel: slackNotificationChannel, client: testClient}
+		state, _ := typedChannelValues(t, r, map[string]attr.Value{"origin": types.StringValue("tf_origin")})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Unexpected Notification Channel Type", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("deleted outside of Terraform", func(t *testing.T) {
+		testClient := &testNotificationChannelClient{getError: &dash0.APIError{StatusCode: 404, Status: "404 Not Found"}}
+		r := &TypedNotificationChannelResource{channel: slackNotificationChannel, client: testClient}
+		state, _ := typedChannelValues(t, r, map[string]attr.Value{"origin": types.StringValue("tf_origin")})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull())
+	})
+}
+
+func TestSlackNotificationChannelResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TypedNotificationChannelResource{channel: slackNotificationChannel, client: mockClient}
+
+	mockClient.On("GetNotificationChannel", ctx, "tf_origin").Return(`{
+		"metadata": {"name": "Slack Alerts"},
+		"spec": {
+			"type": "slack",
+			"frequency": "10m0s",
+			"config": {"webhookURL": "https://hooks.slack.com/services/T/B/X"},
+			"routing": {"filters": [[{"key": "team.name", "operator": "is", "value": "sre"}]]}
+		}
+	}`, nil)
+	mockClient.On("ResolveNotificationChannel", ctx, "tf_origin").Return("channel-id", "", nil)
+
+	state, _ := typedChannelValues(t, r, nil)
+	resp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_origin"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var frequency, url types.String
+	var filters []routingFilterGroupModel
+	resp.State.GetAttribute(ctx, path.Root("frequency"), &frequency)
+	resp.State.GetAttribute(ctx, path.Root("url"), &url)
+	resp.State.GetAttribute(ctx, path.Root("routing_filters"), &filters)
+	assert.Equal(t, "10m0s", frequency.ValueString())
+	assert.True(t, url.IsNull())
+	require.Len(t, filters, 1)
+	assert.Equal(t, "sre", filters[0].Conditions[0].Value.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestPagerDutyNotificationChannelResource_DefaultEventsURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create sends the default", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TypedNotificationChannelResource{channel: pagerDutyNotificationChannel, client: mockClient}
+		var sent string
+		mockClient.On("CreateNotificationChannel", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Run(func(args mock.Arguments) { sent = args.String(2) }).
+			Return(nil)
+		mockClient.On("ResolveNotificationChannel", ctx, mock.AnythingOfType("string")).Return("channel-id", "", nil)
+
+		_, plan := typedChannelValues(t, r, map[string]attr.Value{
+			"name":            types.StringValue("PagerDuty Incidents"),
+			"integration_key": types.StringValue("R0UT1NGK3Y"),
+		})
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		require.NoError(t, client.ValidateNotificationChannel(sent))
+		var def typedChannelDefinition
+		require.NoError(t, json.Unmarshal([]byte(sent), &def))
+		assert.Equal(t, map[string]interface{}{"key": "R0UT1NGK3Y", "url": pagerDutyEventsURL}, def.Spec.Config)
+	})
+
+	t.Run("read leaves the default out of state", func(t *testing.T) {
+		testClient := &testNotificationChannelClient{getResponse: `{
+			"metadata": {"name": "PagerDuty Incidents"},
+			"spec": {"type": "pagerduty", "config": {"key": "R0UT1NGK3Y", "url": "` + pagerDutyEventsURL + `"}}
+		}`}
+		r := &TypedNotificationChannelResource{channel: pagerDutyNotificationChannel, client: testClient}
+		state, _ := typedChannelValues(t, r, map[string]attr.Value{"origin": types.StringValue("tf_origin")})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var eventsURL, key types.String
+		resp.State.GetAttribute(ctx, path.Root("events_url"), &eventsURL)
+		resp.State.GetAttribute(ctx, path.Root("integration_key"), &key)
+		assert.True(t, eventsURL.IsNull())
+		assert.Equal(t, "R0UT1NGK3Y", key.ValueString())
+	})
+}
+
+func TestWebhookNotificationChannelResource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TypedNotificationChannelResource{channel: webhookNotificationChannel, client: mockClient}
+		var sent string
+		mockClient.On("CreateNotificationChannel", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Run(func(args mock.Arguments) { sent = args.String(2) }).
+			Return(nil)
+		mockClient.On("ResolveNotificationChannel", ctx, mock.AnythingOfType("string")).Return("channel-id", "", nil)
+
+		_, plan := typedChannelValues(t, r, map[string]attr.Value{
+			"name":           types.StringValue("Incident Bridge"),
+			"url":            types.StringValue("https://incidents.internal.example.com/hooks/dash0"),
+			"headers":        types.MapValueMust(types.StringType, map[string]attr.Value{"Authorization": types.StringValue("Bearer token")}),
+			"allow_insecure": types.BoolValue(true),
+		})
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		require.NoError(t, client.ValidateNotificationChannel(sent))
+		var def typedChannelDefinition
+		require.NoError(t, json.Unmarshal([]byte(sent), &def))
+		assert.Equal(t, map[string]interface{}{
+			"url":           "https://incidents.internal.example.com/hooks/dash0",
+			"headers":       map[string]interface{}{"Authorization": "Bearer token"},
+			"allowInsecure": true,
+		}, def.Spec.Config)
+	})
+
+	t.Run("read", func(t *testing.T) {
+		testClient := &testNotificationChannelClient{getResponse: `{
+			"metadata": {"name": "Incident Bridge"},
+			"spec": {"type": "webhook", "config": {"url": "https://incidents.internal.example.com/hooks/dash0", "followRedirects": true}}
+		}`}
+		r := &TypedNotificationChannelResource{channel: webhookNotificationChannel, client: testClient}
+		state, _ := typedChannelValues(t, r, map[string]attr.Value{
+			"origin":         types.StringValue("tf_origin"),
+			"headers":        types.MapValueMust(types.StringType, map[string]attr.Value{"Authorization": types.StringValue("Bearer token")}),
+			"allow_insecure": types.BoolValue(true),
+		})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var headers types.Map
+		var allowInsecure, followRedirects types.Bool
+		resp.State.GetAttribute(ctx, path.Root("headers"), &headers)
+		resp.State.GetAttribute(ctx, path.Root("allow_insecure"), &allowInsecure)
+		resp.State.GetAttribute(ctx, path.Root("follow_redirects"), &followRedirects)
+		// The headers are left out of the response, so the value in state is kept.
+		assert.Len(t, headers.Elements(), 1)
+		// allow_insecure is managed and reported as drift; follow_redirects is not.
+		assert.False(t, allowInsecure.ValueBool())
+		assert.True(t, followRedirects.IsNull())
+	})
+}
+
+func TestEmailNotificationChannelResource_Create(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TypedNotificationChannelResource{channel: emailNotificationChannel, client: mockClient}
+	var sent string
+	mockClient.On("CreateNotificationChannel", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { sent = args.String(2) }).
+		Return(nil)
+	mockClient.On("ResolveNotificationChannel", ctx, mock.AnythingOfType("string")).Return("channel-id", "", nil)
+
+	_, plan := typedChannelValues(t, r, map[string]attr.Value{
+		"name":       types.StringValue("Email Alerts"),
+		"recipients": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("oncall@example.com"), types.StringValue("sre-team@example.com")}),
+		"plaintext":  types.BoolValue(false),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	require.NoError(t, client.ValidateNotificationChannel(sent))
+	var def typedChannelDefinition
+	require.NoError(t, json.Unmarshal([]byte(sent), &def))
+	assert.Equal(t, "email_v2", def.Spec.Type)
+	assert.Equal(t, map[string]interface{}{
+		"recipients": []interface{}{"oncall@example.com", "sre-team@example.com"},
+		"plaintext":  false,
+	}, def.Spec.Config)
+}
+
+func TestEmailAddresses(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		recipients types.List
+		errors     int
+	}{
+		{name: "valid", recipients: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("oncall@example.com"), types.StringUnknown()})},
+		{name: "null", recipients: types.ListNull(types.StringType)},
+		{name: "empty", recipients: types.ListValueMust(types.StringType, []attr.Value{}), errors: 1},
+		{
+			name: "invalid addresses",
+			recipients: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("oncall"),
+				types.StringValue("Alice <alice@example.com>"),
+				types.StringValue("sre-team@example.com"),
+			}),
+			errors: 2,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.ListResponse{}
+			emailAddresses{}.ValidateList(ctx, validator.ListRequest{Path: path.Root("recipients"), ConfigValue: tc.recipients}, resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestMSTeamsNotificationChannelResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("teams channel", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TypedNotificationChannelResource{channel: msTeamsNotificationChannel, client: mockClient}
+		mockClient.On("GetNotificationChannel", ctx, "tf_origin").Return(`{
+			"metadata": {"name": "Microsoft Teams Alerts"},
+			"spec": {"type": "teams_webhook", "frequency": "10m0s", "config": {"url": "https://example.webhook.office.com/webhookb2/abc"}}
+		}`, nil)
+		mockClient.On("ResolveNotificationChannel", ctx, "tf_origin").Return("channel-id", "", nil)
+
+		state, _ := typedChannelValues(t, r, nil)
+		resp := resource.ImportStateResponse{State: state}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_origin"}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var webhookURL types.String
+		resp.State.GetAttribute(ctx, path.Root("webhook_url"), &webhookURL)
+		assert.Equal(t, "https://example.webhook.office.com/webhookb2/abc", webhookURL.ValueString())
+	})
+
+	t.Run("channel of another type", func(t *testing.T) {
+		mockClient := &MockClient{}
+		r := &TypedNotificationChannelResource{channel: msTeamsNotificationChannel, client: mockClient}
+		mockClient.On("GetNotificationChannel", ctx, "tf_origin").Return(`{"spec": {"type": "slack", "config": {}}}`, nil)
+
+		state, _ := typedChannelValues(t, r, nil)
+		resp := resource.ImportStateResponse{State: state}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_origin"}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"teams_webhook"`)
+	})
+}
+
+func TestOpsgenieNotificationChannelResource_WriteOnlyAPIKey(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &TypedNotificationChannelResource{channel: opsgenieNotificationChannel, client: mockClient}
+	var sent string
+	mockClient.On("CreateNotificationChannel", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { sent = args.String(2) }).
+		Return(nil)
+	mockClient.On("ResolveNotificationChannel", ctx, mock.AnythingOfType("string")).Return("channel-id", "", nil)
+
+	_, plan := typedChannelValues(t, r, map[string]attr.Value{
+		"name":   types.StringValue("Opsgenie Alerts"),
+		"region": types.StringValue("eu"),
+	})
+	_, config := typedChannelValues(t, r, map[string]attr.Value{
+		"name":       types.StringValue("Opsgenie Alerts"),
+		"region":     types.StringValue("eu"),
+		"api_key_wo": types.StringValue("0p5g3n13"),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Raw: config.Raw, Schema: config.Schema}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	require.NoError(t, client.ValidateNotificationChannel(sent))
+	var def typedChannelDefinition
+	require.NoError(t, json.Unmarshal([]byte(sent), &def))
+	assert.Equal(t, map[string]interface{}{"apiKey": "0p5g3n13", "instance": "eu"}, def.Spec.Config)
+
+	var apiKey, apiKeyWO types.String
+	resp.State.GetAttribute(ctx, path.Root("api_key"), &apiKey)
+	resp.State.GetAttribute(ctx, path.Root("api_key_wo"), &apiKeyWO)
+	assert.True(t, apiKey.IsNull())
+	assert.True(t, apiKeyWO.IsNull())
+
+	// A key returned by the API is not read into api_key while the write-only
+	// attribute manages it.
+	readClient := &testNotificationChannelClient{getResponse: `{
+		"metadata": {"name": "Opsgenie Alerts"},
+		"spec": {"type": "opsgenie", "config": {"apiKey": "0p5g3n13", "instance": "eu"}}
+	}`}
+	r.client = readClient
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	readResp.State.GetAttribute(ctx, path.Root("api_key"), &apiKey)
+	assert.True(t, apiKey.IsNull())
+}
+
+func TestOpsgenieNotificationChannelResource_ValidateConfig(t *testing.T) {
+	r := &TypedNotificationChannelResource{channel: opsgenieNotificationChannel}
+	tests := []struct {
+		name   string
+		values map[string]tftypes.Value
+		error  string
+	}{
+		{name: "api_key", values: map[string]tftypes.Value{"api_key": tftypes.NewValue(tftypes.String, "key")}},
+		{name: "api_key_wo", values: map[string]tftypes.Value{"api_key_wo": tftypes.NewValue(tftypes.String, "key")}},
+		{name: "unknown", values: map[string]tftypes.Value{"api_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}},
+		{name: "neither", error: "Missing Attribute"},
+		{
+			name: "both",
+			values: map[string]tftypes.Value{
+				"api_key":    tftypes.NewValue(tftypes.String, "key"),
+				"api_key_wo": tftypes.NewValue(tftypes.String, "key"),
+			},
+			error: "Conflicting Attributes",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateConfig(t, r, tc.values)
+			if tc.error == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			assert.Equal(t, tc.error, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
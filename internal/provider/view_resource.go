@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
@@ -24,9 +23,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ViewResource{}
-	_ resource.ResourceWithConfigure   = &ViewResource{}
-	_ resource.ResourceWithImportState = &ViewResource{}
+	_ resource.Resource                   = &ViewResource{}
+	_ resource.ResourceWithConfigure      = &ViewResource{}
+	_ resource.ResourceWithImportState    = &ViewResource{}
+	_ resource.ResourceWithValidateConfig = &ViewResource{}
+	_ resource.ResourceWithModifyPlan     = &ViewResource{}
 )
 
 // NewViewResource is a helper function to simplify the provider implementation.
@@ -36,16 +37,39 @@ func NewViewResource() resource.Resource {
 
 // ViewResource is the resource implementation.
 type ViewResource struct {
-	client client.Client
+	client        client.Client
+	plannedNames  *plannedNames
+	ignoredFields []string
+	permissions   *permissionPreflight
+	defaultLabels map[string]string
 }
 
 // viewModel is the Terraform state model for a view resource.
 type viewModel struct {
-	Origin   types.String `tfsdk:"origin"`
-	ID       types.String `tfsdk:"id"`
-	Dataset  types.String `tfsdk:"dataset"`
-	ViewYaml types.String `tfsdk:"view_yaml"`
-	URL      types.String `tfsdk:"url"`
+	Origin               types.String `tfsdk:"origin"`
+	ID                   types.String `tfsdk:"id"`
+	Dataset              types.String `tfsdk:"dataset"`
+	ViewYaml             types.String `tfsdk:"view_yaml"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+	NamePrefix           types.String `tfsdk:"name_prefix"`
+	NameSuffix           types.String `tfsdk:"name_suffix"`
+	ConflictStrategy     types.String `tfsdk:"conflict_strategy"`
+	IgnoreServerDefaults types.Bool   `tfsdk:"ignore_server_defaults"`
+	URL                  types.String `tfsdk:"url"`
+}
+
+// managedMetadata returns the provider-managed metadata attributes of the
+// model, on top of the provider-level default labels.
+func (m viewModel) managedMetadata(defaultLabels map[string]string) managedMetadata {
+	return managedMetadata{
+		DefaultLabels: defaultLabels,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+		NamePrefix:    m.NamePrefix,
+		NameSuffix:    m.NameSuffix,
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -64,12 +88,67 @@ func (r *ViewResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 
 	r.client = client
+	r.ignoredFields = ignoredFieldsOf(req.ProviderData, "dash0_view")
+	r.permissions = permissionPreflightOf(req.ProviderData)
+	r.defaultLabels = defaultLabelsOf(req.ProviderData)
+	r.plannedNames = plannedNamesOf(req.ProviderData)
 }
 
 func (r *ViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_view"
 }
 
+// ValidateConfig checks the view definition at plan time, so that a
+// definition the API client would reject fails the plan rather than the
+// apply.
+func (r *ViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model viewModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	metadata := model.managedMetadata(r.defaultLabels)
+	prevalidateDefinition(ctx, model.ViewYaml, definitionCheck{
+		attribute:    "view_yaml",
+		metadata:     &metadata,
+		validateJSON: client.ValidateView,
+	}, &resp.Diagnostics)
+}
+
+// ModifyPlan reports a view whose name is also declared by another
+// dash0_view resource in the same dataset, plans the labels the provider
+// merges into the definition, and checks the permissions of the auth token
+// when the permission preflight is enabled.
+func (r *ViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.permissions.check(ctx, req, "dash0_view", "", &resp.Diagnostics)
+	var plan viewModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var priorDataset types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("dataset"), &priorDataset)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.plannedNames.check(ctx, plannedAsset{
+		resourceType: "dash0_view",
+		assetName:    "view",
+		attribute:    "view_yaml",
+		definition:   plan.ViewYaml,
+		metadata:     plan.managedMetadata(r.defaultLabels),
+		dataset:      plan.Dataset,
+		priorDataset: priorDataset,
+		origin:       plan.Origin,
+		namePaths:    []string{"spec.display.name", "metadata.name"},
+	}, &resp.Diagnostics)
+	planLabelsAll(ctx, resp, plan.ViewYaml, plan.managedMetadata(r.defaultLabels))
+}
+
 func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `Manages a Dash0 View. Views are saved configurations of filters, queries, and display settings that let you quickly navigate to a specific perspective on your telemetry data.`,
@@ -102,6 +181,13 @@ func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing, converter.AnnotationFolderPath),
 				},
 			},
+			"labels":                 labelsAttribute("view"),
+			"annotations":            annotationsAttribute("view"),
+			"labels_all":             labelsAllAttribute("view"),
+			"name_prefix":            nameAffixAttribute("view", "prepended to"),
+			"name_suffix":            nameAffixAttribute("view", "appended to"),
+			"conflict_strategy":      conflictStrategyAttribute("view"),
+			"ignore_server_defaults": ignoreServerDefaultsAttribute("view"),
 			"url": schema.StringAttribute{
 				Description: "The URL to open this view in the Dash0 web app, derived from the Dash0 API URL and the view's server-assigned identifier. The page is selected based on the view's type (for example the traces explorer for span views). Computed by the provider after creation. May be empty if the app URL cannot be derived (e.g. for self-hosted deployments with a custom web app domain) or the view type has no associated page.",
 				Computed:    true,
@@ -140,8 +226,6 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	model.Origin = types.StringValue("tf_" + uuid.New().String())
-
 	// Validate YAML format
 	var viewYaml interface{}
 	err := yaml.Unmarshal([]byte(model.ViewYaml.ValueString()), &viewYaml)
@@ -153,14 +237,40 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations, name affixes)
+	definition, diags := mergeManagedMetadata(ctx, model.ViewYaml.ValueString(), model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.ViewYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert view YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateView(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Generate the origin, applying the conflict strategy to an existing
+	// view with the same name
+	var adopted bool
+	model.Origin, adopted = resolveCreateConflict(ctx, model.ConflictStrategy, createConflict{
+		assetName: "view",
+		name:      converter.DefinitionName(definition, "spec.display.name", "metadata.name"),
+		dataset:   model.Dataset.ValueString(),
+		find:      r.client.FindViewByName,
+		delete:    r.client.DeleteView,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An adopted asset is overwritten with an update, see resolveCreateConflict.
+	create := r.client.CreateView
+	if adopted {
+		create = r.client.UpdateView
+	}
+	err = create(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create view, got error: %s", err))
 		return
@@ -169,6 +279,9 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Resolve the id and web app URL for the newly created view (best-effort).
 	r.resolveView(ctx, &model, &resp.Diagnostics)
 
+	model.LabelsAll, diags = labelsAll(ctx, model.ViewYaml, model.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "created a view resource")
 
 	// Set state to fully populated data
@@ -195,9 +308,18 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Compare the current state with the retrieved view
 	if state.ViewYaml.ValueString() != "" {
-		stateYAML := state.ViewYaml.ValueString()
+		// Compare including the provider-managed metadata, which was applied to
+		// the definition on write but is not part of the YAML stored in state.
+		// The default labels are the ones recorded in labels_all, which the
+		// last write applied, rather than the current default_labels.
+		stateYAML, diags := mergeManagedMetadata(ctx, state.ViewYaml.ValueString(), state.managedMetadata(appliedDefaultLabels(ctx, state.LabelsAll, r.defaultLabels)))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
+		additionalIgnored = append(additionalIgnored, r.ignoredFields...)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, comparedResponse(stateYAML, apiResponseJSON, state.IgnoreServerDefaults), additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"View Comparison Error",
@@ -214,6 +336,10 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.ViewYaml = types.StringValue(apiResponseJSON)
 	}
 
+	// Report changes to the provider-managed labels and annotations, which
+	// the comparison above ignores.
+	resp.Diagnostics.Append(refreshManagedMetadata(ctx, state.managedMetadata(r.defaultLabels), apiResponseJSON, &state.Labels, &state.Annotations, &state.LabelsAll)...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -247,8 +373,15 @@ func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Apply provider-managed metadata (labels, annotations, name affixes)
+	definition, diags := mergeManagedMetadata(ctx, plan.ViewYaml.ValueString(), plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.ViewYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(definition)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert view YAML to JSON: %s", err))
 		return
@@ -266,6 +399,9 @@ func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	plan.LabelsAll, diags = labelsAll(ctx, plan.ViewYaml, plan.managedMetadata(r.defaultLabels))
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Trace(ctx, "updated a view resource")
 
 	// Set state to fully populated data
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -107,6 +108,30 @@ spec:
 					"view_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"conflict_strategy": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -127,19 +152,33 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"dataset":   tftypes.String,
-						"view_yaml": tftypes.String,
-						"url":       tftypes.String,
+						"origin":                 tftypes.String,
+						"id":                     tftypes.String,
+						"dataset":                tftypes.String,
+						"view_yaml":              tftypes.String,
+						"conflict_strategy":      tftypes.String,
+						"name_prefix":            tftypes.String,
+						"name_suffix":            tftypes.String,
+						"labels":                 tftypes.Map{ElementType: tftypes.String},
+						"annotations":            tftypes.Map{ElementType: tftypes.String},
+						"labels_all":             tftypes.Map{ElementType: tftypes.String},
+						"ignore_server_defaults": tftypes.Bool,
+						"url":                    tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-					"view_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-					"url":       tftypes.NewValue(tftypes.String, testURL),
+					"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+					"id":                     tftypes.NewValue(tftypes.String, nil),
+					"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+					"view_yaml":              tftypes.NewValue(tftypes.String, originalYaml),
+					"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+					"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+					"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+					"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+					"url":                    tftypes.NewValue(tftypes.String, testURL),
 				},
 			)
 
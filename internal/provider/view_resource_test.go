@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 )
@@ -82,11 +85,18 @@ func TestViewResource_Create(t *testing.T) {
 	// Setup plan
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, ""),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-			"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":       tftypes.NewValue(tftypes.String, nil),
+			"origin":                 tftypes.NewValue(tftypes.String, ""),
+			"id":                     tftypes.NewValue(tftypes.String, nil),
+			"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+			"view_yaml":              tftypes.NewValue(tftypes.String, testYaml),
+			"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+			"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, nil),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
@@ -102,6 +112,30 @@ func TestViewResource_Create(t *testing.T) {
 				"view_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"conflict_strategy": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"ignore_server_defaults": schema.BoolAttribute{
+					Optional: true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -141,6 +175,41 @@ func TestViewResource_Create(t *testing.T) {
 	assert.Equal(t, testURL, resultState.URL.ValueString())
 }
 
+// TestViewResource_Create_AdoptServerError verifies that an adopted view is
+// written with an update, so that a failed write is reported even though a
+// view exists at the adopted origin.
+func TestViewResource_Create_AdoptServerError(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockClient)
+	r := &ViewResource{client: mockClient}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	plan := tfsdk.State{Schema: schemaResp.Schema}
+	diags := plan.Set(ctx, &viewModel{
+		Origin:           types.StringUnknown(),
+		ID:               types.StringUnknown(),
+		Dataset:          types.StringValue("default"),
+		ViewYaml:         types.StringValue("kind: Dash0View\nmetadata:\n  name: checkout\nspec:\n  type: spans"),
+		Labels:           types.MapNull(types.StringType),
+		Annotations:      types.MapNull(types.StringType),
+		LabelsAll:        types.MapUnknown(types.StringType),
+		ConflictStrategy: types.StringValue(conflictStrategyAdopt),
+		URL:              types.StringUnknown(),
+	})
+	require.False(t, diags.HasError(), diags)
+
+	mockClient.On("FindViewByName", mock.Anything, "checkout", "default").Return("tf_existing", nil)
+	mockClient.On("UpdateView", mock.Anything, "tf_existing", mock.Anything, "default").Return(&dash0.APIError{StatusCode: http.StatusBadGateway})
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "CreateView", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestViewResource_Read(t *testing.T) {
 	mockClient := new(MockClient)
 	r := &ViewResource{client: mockClient}
@@ -167,6 +236,30 @@ func TestViewResource_Read(t *testing.T) {
 			"view_yaml": schema.StringAttribute{
 				Required: true,
 			},
+			"conflict_strategy": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"name_suffix": schema.StringAttribute{
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ignore_server_defaults": schema.BoolAttribute{
+				Optional: true,
+			},
 			"url": schema.StringAttribute{
 				Computed: true,
 			},
@@ -176,11 +269,18 @@ func TestViewResource_Read(t *testing.T) {
 	// Setup state
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-			"view_yaml": tftypes.NewValue(tftypes.String, "old yaml"),
-			"url":       tftypes.NewValue(tftypes.String, testURL),
+			"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                     tftypes.NewValue(tftypes.String, nil),
+			"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+			"view_yaml":              tftypes.NewValue(tftypes.String, "old yaml"),
+			"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+			"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: stateSchema,
 	}
@@ -437,11 +537,18 @@ func TestViewResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":       tftypes.NewValue(tftypes.String, testURL),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":              tftypes.NewValue(tftypes.String, testYaml),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -457,6 +564,30 @@ func TestViewResource_Update(t *testing.T) {
 					"view_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"conflict_strategy": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -469,11 +600,18 @@ func TestViewResource_Update(t *testing.T) {
 		// Create plan with updated YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, updatedYaml),
-				"url":       tftypes.NewValue(tftypes.String, testURL),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":              tftypes.NewValue(tftypes.String, updatedYaml),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: state.Schema,
 		}
@@ -514,11 +652,18 @@ func TestViewResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":       tftypes.NewValue(tftypes.String, nil),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":              tftypes.NewValue(tftypes.String, testYaml),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -534,6 +679,30 @@ func TestViewResource_Update(t *testing.T) {
 					"view_yaml": schema.StringAttribute{
 						Required: true,
 					},
+					"conflict_strategy": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"name_suffix": schema.StringAttribute{
+						Optional: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"annotations": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels_all": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ignore_server_defaults": schema.BoolAttribute{
+						Optional: true,
+					},
 					"url": schema.StringAttribute{
 						Computed: true,
 					},
@@ -544,11 +713,18 @@ func TestViewResource_Update(t *testing.T) {
 		// Create plan with invalid YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
-				"url":       tftypes.NewValue(tftypes.String, nil),
+				"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                     tftypes.NewValue(tftypes.String, nil),
+				"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":              tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
+				"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+				"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+				"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+				"url":                    tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: state.Schema,
 		}
@@ -582,11 +758,18 @@ func TestViewResource_Delete(t *testing.T) {
 	// Create a state with test data
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-			"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":       tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/traces/explorer?view_id=internal-uuid"),
+			"origin":                 tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                     tftypes.NewValue(tftypes.String, nil),
+			"dataset":                tftypes.NewValue(tftypes.String, testDataset),
+			"view_yaml":              tftypes.NewValue(tftypes.String, testYaml),
+			"conflict_strategy":      tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":            tftypes.NewValue(tftypes.String, nil),
+			"name_suffix":            tftypes.NewValue(tftypes.String, nil),
+			"labels":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"annotations":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"labels_all":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"ignore_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"url":                    tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/traces/explorer?view_id=internal-uuid"),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
@@ -602,6 +785,30 @@ func TestViewResource_Delete(t *testing.T) {
 				"view_yaml": schema.StringAttribute{
 					Required: true,
 				},
+				"conflict_strategy": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"name_suffix": schema.StringAttribute{
+					Optional: true,
+				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"annotations": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"labels_all": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+				"ignore_server_defaults": schema.BoolAttribute{
+					Optional: true,
+				},
 				"url": schema.StringAttribute{
 					Computed: true,
 				},
@@ -110,11 +110,52 @@ tf_state_show() {
   (cd "$dir" && TF_CLI_CONFIG_FILE="${dir}/.terraformrc" $TF state show "$address")
 }
 
+tf_state_rm() {
+  local dir="$1" address="$2"
+  (cd "$dir" && TF_CLI_CONFIG_FILE="${dir}/.terraformrc" $TF state rm "$address")
+}
+
 tf_plan_detailed_exitcode() {
   local dir="$1"
   (cd "$dir" && TF_CLI_CONFIG_FILE="${dir}/.terraformrc" $TF plan -detailed-exitcode -input=false)
 }
 
+# ---------------------------------------------------------------------------
+# Direct API access, for kinds the dash0 CLI does not manage.
+# ---------------------------------------------------------------------------
+
+# has_api_token
+#
+# Succeeds if DASH0_AUTH_TOKEN is set. OAuth-enabled profiles leave it empty
+# (the provider refreshes the token from the mounted CLI config directory), so
+# tests skip the steps that call dash0_api in that case.
+has_api_token() {
+  [[ -n "${DASH0_AUTH_TOKEN}" ]]
+}
+
+# dash0_api <method> <path> [yaml_file]
+# e.g. dash0_api GET "/api/slos/${ORIGIN}?dataset=${DATASET}"
+#
+# Sends a request to the Dash0 API and prints the response body. A YAML file
+# is converted to JSON and sent as the request body. Returns non-zero if the
+# response status is not 2xx.
+dash0_api() {
+  local method="$1" path="$2" body_file="${3:-}"
+  local args=(-sS -X "$method" -H "Authorization: Bearer ${DASH0_AUTH_TOKEN}" -w '\n%{http_code}')
+  if [[ -n "$body_file" ]]; then
+    args+=(-H "Content-Type: application/json" --data-binary "$(python3 -c "
+import json, sys, yaml
+print(json.dumps(yaml.safe_load(open(sys.argv[1]))))
+" "$body_file")")
+  fi
+
+  local output status
+  output="$(curl "${args[@]}" "${DASH0_API_URL%/}${path}")" || return 1
+  status="${output##*$'\n'}"
+  printf '%s\n' "${output%$'\n'*}"
+  [[ "$status" == 2* ]]
+}
+
 # ---------------------------------------------------------------------------
 # YAML semantic equivalence check.
 # ---------------------------------------------------------------------------
@@ -99,6 +99,18 @@ else
     test_spam_filter_v1alpha1.sh
     test_spam_filter_v1alpha2.sh
     test_team.sh
+    test_resource.sh
+    test_notification_channel_slack.sh
+    test_notification_channel_pagerduty.sh
+    test_notification_channel_webhook.sh
+    test_notification_channel_email.sh
+    test_notification_channel_msteams.sh
+    test_notification_channel_opsgenie.sh
+    test_member.sh
+    test_team_membership.sh
+    test_slo.sh
+    test_prometheus_rule_group.sh
+    test_trace_sampling_rule.sh
     test_import_check_rule.sh
     test_import_dashboard.sh
     test_import_notification_channel.sh
@@ -107,6 +119,18 @@ else
     test_import_synthetic_check.sh
     test_import_team.sh
     test_import_view.sh
+    test_import_resource.sh
+    test_import_notification_channel_slack.sh
+    test_import_notification_channel_pagerduty.sh
+    test_import_notification_channel_webhook.sh
+    test_import_notification_channel_email.sh
+    test_import_notification_channel_msteams.sh
+    test_import_notification_channel_opsgenie.sh
+    test_import_member.sh
+    test_import_team_membership.sh
+    test_import_slo.sh
+    test_import_prometheus_rule_group.sh
+    test_import_trace_sampling_rule.sh
   )
 fi
 
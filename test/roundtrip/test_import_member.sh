@@ -0,0 +1,112 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on dash0_member, which is imported by
+# email address. The test imports an existing member of the organization, so
+# it never destroys the resource: the member is removed from state instead,
+# leaving the organization unchanged.
+#
+# Steps:
+#   1. Discover a joined organization member via `dash0 -X members list`
+#   2. Write a resource shell for the member
+#   3. `terraform import` with the email address
+#   4. Apply the configured role, which the API cannot report
+#   5. Assert plan reports no changes
+#   6. Verify the member id in state
+#   7. Remove the member from state (no server-side change)
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_member) ==="
+info "Working directory: ${WORK_DIR}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Discover a joined member via CLI.
+# ---------------------------------------------------------------------------
+info "Step 1: Discovering an organization member via dash0 CLI..."
+
+MEMBERS_JSON="$(dash0 -X members list -o json 2>&1)" \
+  || fail "dash0 CLI could not list members"
+
+read -r MEMBER_EMAIL MEMBER_ID < <(python3 - "$MEMBERS_JSON" <<'PYEOF'
+import json, sys
+for m in json.loads(sys.argv[1]):
+    labels = m.get("metadata", {}).get("labels", {})
+    email = m.get("email") or m.get("spec", {}).get("display", {}).get("email")
+    member_id = labels.get("dash0.com/id") or m.get("id")
+    if email and member_id and "dash0.com/joinedAt" in labels:
+        print(email, member_id)
+        break
+PYEOF
+)
+[[ -n "${MEMBER_EMAIL:-}" ]] || fail "Could not discover an organization member with an email address"
+info "Member: ${MEMBER_EMAIL} (${MEMBER_ID})"
+
+# ---------------------------------------------------------------------------
+# Step 2: Write resource shell.
+# ---------------------------------------------------------------------------
+info "Step 2: Writing Terraform config..."
+
+cat > "${WORK_DIR}/main.tf" <<EOF
+resource "dash0_member" "imported" {
+  email = "${MEMBER_EMAIL}"
+  role  = "basic_member"
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "id" {
+  value = dash0_member.imported.id
+}
+EOF
+
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 3: terraform import with the email address.
+# ---------------------------------------------------------------------------
+info "Step 3: Importing via terraform import (email address)..."
+
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_member.imported" "$MEMBER_EMAIL" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 4: Apply the role. It cannot be read from the API, so the imported
+# member has none; the apply only records it in state.
+# ---------------------------------------------------------------------------
+info "Step 4: Applying the configured role..."
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Assert plan reports no changes.
+# ---------------------------------------------------------------------------
+info "Step 5: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 6: Member id.
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying the member id in state..."
+STATE_ID="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" id)"
+if [[ "$STATE_ID" != "$MEMBER_ID" ]]; then
+  fail "Expected member id '${MEMBER_ID}' in state, got '${STATE_ID}'"
+fi
+info "Member id check PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 7: Remove from state — destroying would remove a real member.
+# ---------------------------------------------------------------------------
+info "Step 7: Removing the member from state..."
+tf_state_rm "$WORK_DIR" "dash0_member.imported"
+
+info "=== dash0_member import roundtrip test PASSED ==="
@@ -0,0 +1,150 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on dash0_notification_channel_slack, an
+# organization-scoped resource imported by `<identifier>` alone, like
+# dash0_notification_channel (see test_import_notification_channel.sh).
+#
+# Steps:
+#   1. Create a channel of type slack via dash0 CLI (out-of-band, no Terraform)
+#   2. Discover its identifier via `dash0 -X notification-channels list`
+#   3. Write a resource shell matching the channel
+#   4. `terraform import` with `<identifier>` (no dataset prefix)
+#   5. Assert plan reports no changes
+#   6. Verify identifier preservation in state
+#   7. Rename + apply — prove the imported resource is manageable
+#   8. Destroy + verify server-side deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_notification_channel_slack) ==="
+info "Working directory: ${WORK_DIR}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create notification channel via dash0 CLI (out-of-band).
+# ---------------------------------------------------------------------------
+info "Step 1: Creating Slack notification channel via dash0 CLI..."
+
+cat > "${WORK_DIR}/notification_channel.yaml" <<'YAMLEOF'
+kind: Dash0NotificationChannel
+metadata:
+  name: roundtrip-import-slack-channel
+spec:
+  type: slack
+  config:
+    webhookURL: https://hooks.slack.com/services/T0000/B0000/roundtrip-import
+    channel: "#roundtrip-import"
+YAMLEOF
+
+dash0 -X notification-channels create -f "${WORK_DIR}/notification_channel.yaml" >/dev/null \
+  || fail "Failed to create notification channel via dash0 CLI"
+info "Notification channel created via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 2: Discover the identifier from the CLI listing.
+# ---------------------------------------------------------------------------
+info "Step 2: Discovering identifier via dash0 CLI..."
+
+IDENTIFIER="$(dash0 -X notification-channels list -o json \
+  | python3 -c "
+import json, sys
+items = json.load(sys.stdin)
+for it in items:
+    if it.get('metadata', {}).get('name') == 'roundtrip-import-slack-channel':
+        print(it['metadata']['labels']['dash0.com/id'])
+        break
+")"
+[[ -n "$IDENTIFIER" ]] || fail "Could not discover identifier for roundtrip-import-slack-channel"
+info "Identifier: ${IDENTIFIER}"
+
+if [[ "$IDENTIFIER" == tf_* ]]; then
+  fail "Expected a non-Terraform identifier from a CLI-created channel, got: ${IDENTIFIER}"
+fi
+
+# ---------------------------------------------------------------------------
+# Step 3: Write a resource shell that matches the channel.
+# ---------------------------------------------------------------------------
+info "Step 3: Writing Terraform config..."
+
+write_main_tf() {
+  local name="$1"
+  cat > "${WORK_DIR}/main.tf" <<EOF
+resource "dash0_notification_channel_slack" "imported" {
+  name        = "${name}"
+  webhook_url = "https://hooks.slack.com/services/T0000/B0000/roundtrip-import"
+  channel     = "#roundtrip-import"
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_notification_channel_slack.imported.origin
+}
+EOF
+}
+
+write_main_tf "roundtrip-import-slack-channel"
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 4: terraform import with `<identifier>` only — no dataset prefix.
+# ---------------------------------------------------------------------------
+info "Step 4: Importing via terraform import (identifier only, no dataset)..."
+
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_notification_channel_slack.imported" "$IDENTIFIER" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 5: Assert plan reports no changes.
+# ---------------------------------------------------------------------------
+info "Step 5: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 6: Identifier preservation.
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying identifier preservation in state..."
+STATE_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+if [[ "$STATE_ORIGIN" != "$IDENTIFIER" ]]; then
+  fail "Expected imported origin '${IDENTIFIER}' in state, got '${STATE_ORIGIN}'"
+fi
+info "Identifier preservation check PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 7: Rename + apply.
+# ---------------------------------------------------------------------------
+info "Step 7: Renaming + applying to prove imported resource is manageable..."
+
+write_main_tf "roundtrip-import-slack-channel-updated-after-import"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+CLI_OUTPUT="$(dash0 -X notification-channels get "$IDENTIFIER" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "updated-after-import" \
+  || fail "CLI output does not reflect the post-import update"
+info "Update-after-import verified via CLI."
+
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 8: Destroy + verify server-side deletion.
+# ---------------------------------------------------------------------------
+info "Step 8: Destroying imported channel via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+
+info "Step 8b: Verifying server-side deletion..."
+if dash0 -X notification-channels get "$IDENTIFIER" -o yaml >/dev/null 2>&1; then
+  fail "Notification channel '${IDENTIFIER}' still exists after terraform destroy"
+fi
+info "Server-side deletion confirmed."
+
+info "=== dash0_notification_channel_slack import roundtrip test PASSED ==="
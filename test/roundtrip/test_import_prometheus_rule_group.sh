@@ -0,0 +1,208 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on dash0_prometheus_rule_group, which
+# is imported by `<dataset>,<origin>`: the check rules and recording rule
+# groups whose origins start with `<origin>-` are joined into one document.
+# Such origins are only written by the resource itself, so the rule group is
+# created by a first Terraform configuration, removed from its state, and
+# adopted by a second one.
+#
+# Steps:
+#   1. Create the rule group via Terraform, then remove it from state
+#   2. Write a resource shell in a fresh configuration
+#   3. `terraform import` with `<dataset>,<origin>`
+#   4. Sync the imported document to the local file, assert plan reports no
+#      changes
+#   5. Verify origin preservation in state
+#   6. Modify + apply — prove the imported resource is manageable
+#   7. Destroy + verify server-side deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+SOURCE_DIR="$(mktemp -d)"
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$SOURCE_DIR" "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_prometheus_rule_group) ==="
+info "Working directory: ${WORK_DIR}"
+info "Dataset: ${DATASET}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$SOURCE_DIR"
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create the rule group in a first configuration, then remove it from
+# that configuration's state so that it exists only server-side.
+# ---------------------------------------------------------------------------
+info "Step 1: Creating Prometheus rule group via Terraform..."
+
+cat > "${SOURCE_DIR}/prometheus_rule.yaml" <<'YAMLEOF'
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: roundtrip-import-rule-group
+spec:
+  groups:
+    - name: RoundtripImportRuleGroup
+      interval: 1m0s
+      rules:
+        - alert: roundtrip-import-alert
+          expr: vector(1) > 0
+          for: 0s
+          keep_firing_for: 0s
+          annotations:
+            summary: "Roundtrip import test alert"
+            dash0-enabled: true
+          labels: {}
+        - record: job:roundtrip_import:sum
+          expr: sum by (job) (up)
+YAMLEOF
+
+cat > "${SOURCE_DIR}/main.tf" <<'EOF'
+resource "dash0_prometheus_rule_group" "source" {
+  dataset              = var.dataset
+  prometheus_rule_yaml = file("${path.module}/prometheus_rule.yaml")
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_prometheus_rule_group.source.origin
+}
+EOF
+
+tf_init "$SOURCE_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$SOURCE_DIR"
+ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$SOURCE_DIR" origin)"
+[[ -n "$ORIGIN" ]] || fail "Could not read the origin of the created rule group"
+tf_state_rm "$SOURCE_DIR" "dash0_prometheus_rule_group.source" >/dev/null \
+  || fail "terraform state rm failed"
+info "Rule group ${ORIGIN} created and removed from the source state."
+
+CHECK_RULE="${ORIGIN}-roundtripimportrulegroup--roundtrip-import-alert"
+RECORDING="${ORIGIN}-roundtripimportrulegroup"
+
+# ---------------------------------------------------------------------------
+# Step 2: Write the Terraform resource shell.
+# ---------------------------------------------------------------------------
+info "Step 2: Writing Terraform config..."
+
+cp "${SOURCE_DIR}/prometheus_rule.yaml" "${WORK_DIR}/prometheus_rule.yaml"
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_prometheus_rule_group" "imported" {
+  dataset              = var.dataset
+  prometheus_rule_yaml = file("${path.module}/prometheus_rule.yaml")
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_prometheus_rule_group.imported.origin
+}
+
+output "check_rule_origins" {
+  value = join(",", dash0_prometheus_rule_group.imported.check_rule_origins)
+}
+
+output "recording_rule_origins" {
+  value = join(",", dash0_prometheus_rule_group.imported.recording_rule_origins)
+}
+EOF
+
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 3: terraform import
+# ---------------------------------------------------------------------------
+info "Step 3: Importing via terraform import..."
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_prometheus_rule_group.imported" "${DATASET},${ORIGIN}" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 4: The imported document is joined from the API responses, which drop
+# zero-value fields and order the rules by origin, so it is written back to
+# the local file before `terraform plan` must report no changes (see
+# test_import_check_rule.sh).
+# ---------------------------------------------------------------------------
+info "Step 4: Syncing local YAML file to imported state..."
+(cd "$WORK_DIR" && TF_CLI_CONFIG_FILE="${WORK_DIR}/.terraformrc" tofu show -json) \
+  | python3 -c "
+import json, sys
+data = json.load(sys.stdin)
+for r in data['values']['root_module']['resources']:
+    if r['address'] == 'dash0_prometheus_rule_group.imported':
+        sys.stdout.write(r['values']['prometheus_rule_yaml'])
+        break
+" > "${WORK_DIR}/prometheus_rule.yaml"
+[[ -s "${WORK_DIR}/prometheus_rule.yaml" ]] || fail "Failed to sync prometheus_rule.yaml from state"
+grep -q "roundtrip-import-alert" "${WORK_DIR}/prometheus_rule.yaml" \
+  || fail "Imported document does not contain the alerting rule"
+grep -q "job:roundtrip_import:sum" "${WORK_DIR}/prometheus_rule.yaml" \
+  || fail "Imported document does not contain the recording rule"
+
+info "Step 4b: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Origin preservation
+# ---------------------------------------------------------------------------
+info "Step 5: Verifying origin preservation in state..."
+STATE_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+[[ "$STATE_ORIGIN" == "$ORIGIN" ]] \
+  || fail "Expected imported origin '${ORIGIN}' in state, got '${STATE_ORIGIN}'"
+CHECK_RULE_ORIGINS="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" check_rule_origins)"
+[[ "$CHECK_RULE_ORIGINS" == "$CHECK_RULE" ]] \
+  || fail "Expected check_rule_origins '${CHECK_RULE}', got '${CHECK_RULE_ORIGINS}'"
+RECORDING_RULE_ORIGINS="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" recording_rule_origins)"
+[[ "$RECORDING_RULE_ORIGINS" == "$RECORDING" ]] \
+  || fail "Expected recording_rule_origins '${RECORDING}', got '${RECORDING_RULE_ORIGINS}'"
+info "Origin preservation check PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 6: Modify + apply — bump the group interval, which both the check rule
+# and the recording rule group take over.
+# ---------------------------------------------------------------------------
+info "Step 6: Modifying + applying to prove imported resource is manageable..."
+
+python3 - "${WORK_DIR}/prometheus_rule.yaml" <<'PYEOF'
+import sys, yaml
+path = sys.argv[1]
+with open(path) as f:
+    doc = yaml.safe_load(f)
+for group in doc["spec"]["groups"]:
+    group["interval"] = "2m0s"
+with open(path, "w") as f:
+    yaml.safe_dump(doc, f, sort_keys=False)
+PYEOF
+
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+CLI_OUTPUT="$(dash0 check-rules get "$CHECK_RULE" --dataset "$DATASET" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "2m0s" \
+  || fail "Check rule does not reflect the post-import update"
+CLI_OUTPUT="$(dash0 recording-rules get "$RECORDING" --dataset "$DATASET" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "2m" \
+  || fail "Recording rule group does not reflect the post-import update"
+info "Update-after-import verified via CLI."
+
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 7: Destroy + verify deletion server-side
+# ---------------------------------------------------------------------------
+info "Step 7: Destroying imported rule group via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+
+assert_deleted_via_cli "dash0 check-rules get" "$CHECK_RULE" "$DATASET"
+assert_deleted_via_cli "dash0 recording-rules get" "$RECORDING" "$DATASET"
+
+info "=== dash0_prometheus_rule_group import roundtrip test PASSED ==="
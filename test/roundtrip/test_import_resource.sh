@@ -0,0 +1,179 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on dash0_resource.
+#
+# A view is created out-of-band via the dash0 CLI and adopted into a
+# dash0_resource with the `kind,dataset,identifier` import ID. After import,
+# `terraform plan` must report no changes.
+#
+# Steps:
+#   1. Create view via dash0 CLI (non-Terraform origin, no `tf_` prefix)
+#   2. Discover its identifier via dash0 CLI
+#   3. Export its YAML + write the resource shell
+#   4. `terraform import` with `views,<dataset>,<identifier>`
+#   5. Assert plan reports no changes
+#   6. Verify identifier preservation in state
+#   7. Modify + apply — prove the imported resource is manageable
+#   8. Destroy + verify deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+# shellcheck source=common.sh
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_resource) ==="
+info "Working directory: ${WORK_DIR}"
+info "Dataset: ${DATASET}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create view via dash0 CLI (out-of-band, no Terraform).
+# ---------------------------------------------------------------------------
+info "Step 1: Creating view via dash0 CLI..."
+
+cat > "${WORK_DIR}/view.yaml" <<YAMLEOF
+kind: Dash0View
+metadata:
+  name: roundtrip-import-generic-view
+  labels:
+    "dash0.com/dataset": "${DATASET}"
+spec:
+  display:
+    name: Roundtrip Import Test Generic View
+    folder: []
+  type: spans
+  permissions:
+    - actions:
+        - "views:read"
+        - "views:delete"
+      role: "admin"
+  filter:
+    - key: service.name
+      operator: is
+      value: probe
+  table:
+    columns:
+      - colSize: minmax(auto, 2fr)
+        key: dash0.span.name
+        label: Name
+    sort:
+      - direction: ascending
+        key: dash0.span.name
+YAMLEOF
+
+dash0 views create -f "${WORK_DIR}/view.yaml" --dataset "$DATASET" >/dev/null \
+  || fail "Failed to create view via dash0 CLI"
+info "View created via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 2: Discover the identifier.
+# ---------------------------------------------------------------------------
+info "Step 2: Discovering identifier via dash0 CLI..."
+
+IDENTIFIER="$(dash0 views list --dataset "$DATASET" -o json --limit 500 \
+  | python3 -c "
+import json, sys
+items = json.load(sys.stdin)
+for it in items:
+    if it.get('spec', {}).get('display', {}).get('name') == 'Roundtrip Import Test Generic View':
+        labels = it.get('metadata', {}).get('labels', {}) or {}
+        print(labels.get('dash0.com/origin') or labels.get('dash0.com/id') or '')
+        break
+")"
+[[ -n "$IDENTIFIER" ]] || fail "Could not discover identifier for roundtrip-import-generic-view"
+info "Identifier: ${IDENTIFIER}"
+
+if [[ "$IDENTIFIER" == tf_* ]]; then
+  fail "Expected a non-Terraform identifier from a CLI-created view, got: ${IDENTIFIER}"
+fi
+
+# ---------------------------------------------------------------------------
+# Step 3: Export the current YAML + write the resource shell.
+# ---------------------------------------------------------------------------
+info "Step 3: Exporting YAML via CLI + writing Terraform config..."
+
+dash0 views get "$IDENTIFIER" --dataset "$DATASET" -o yaml > "${WORK_DIR}/view.yaml" \
+  || fail "Failed to export view YAML"
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_resource" "imported" {
+  kind            = "views"
+  dataset         = var.dataset
+  definition_yaml = file("${path.module}/view.yaml")
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_resource.imported.origin
+}
+EOF
+
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 4: terraform import with the `kind,dataset,identifier` ID.
+# ---------------------------------------------------------------------------
+info "Step 4: Importing via terraform import..."
+
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_resource.imported" "views,${DATASET},${IDENTIFIER}" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 5: `terraform plan` must report no changes.
+# ---------------------------------------------------------------------------
+info "Step 5: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 6: Origin preservation.
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying identifier preservation in state..."
+STATE_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+if [[ "$STATE_ORIGIN" != "$IDENTIFIER" ]]; then
+  fail "Expected imported origin '${IDENTIFIER}' in state, got '${STATE_ORIGIN}'"
+fi
+info "Identifier preservation check PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 7: Modify + apply.
+# ---------------------------------------------------------------------------
+info "Step 7: Modifying + applying to prove imported resource is manageable..."
+
+python3 - "${WORK_DIR}/view.yaml" <<'PYEOF'
+import sys, yaml
+path = sys.argv[1]
+with open(path) as f:
+    doc = yaml.safe_load(f)
+doc["spec"]["filter"][0]["value"] = "probe-updated"
+with open(path, "w") as f:
+    yaml.safe_dump(doc, f, sort_keys=False)
+PYEOF
+
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+CLI_OUTPUT="$(dash0 views get "$IDENTIFIER" --dataset "$DATASET" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "probe-updated" \
+  || fail "CLI output does not reflect the post-import update"
+info "Update-after-import verified via CLI."
+
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 8: Destroy + verify deletion.
+# ---------------------------------------------------------------------------
+info "Step 8: Destroying imported view via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+
+info "Step 8b: Verifying deletion..."
+assert_deleted_via_tf "$WORK_DIR"
+
+info "=== dash0_resource import roundtrip test PASSED ==="
@@ -0,0 +1,153 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on a dataset-scoped resource
+# (dash0_slo), imported by `<dataset>,<origin>` like dash0_dashboard (see
+# test_import_dashboard.sh). The dash0 CLI does not manage SLOs, so the SLO
+# is created out-of-band through the Dash0 API directly, which needs an auth
+# token: with an OAuth-enabled profile the test is skipped.
+#
+# Steps:
+#   1. Create SLO via the Dash0 API (out-of-band, no Terraform)
+#   2. Write resource shell with the same definition
+#   3. `terraform import` with `<dataset>,<origin>`
+#   4. Assert plan reports no changes
+#   5. Verify origin preservation in state
+#   6. Modify + apply — prove the imported resource is manageable
+#   7. Destroy + verify server-side deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+if ! has_api_token; then
+  warn "No auth token available (OAuth profile); skipping dash0_slo import test."
+  exit 0
+fi
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_slo) ==="
+info "Working directory: ${WORK_DIR}"
+
+# The origin has a unique suffix so parallel runs and prior aborts don't
+# collide on the same SLO.
+ORIGIN="roundtrip-import-slo-$$-$RANDOM"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create SLO via the Dash0 API (out-of-band).
+# ---------------------------------------------------------------------------
+info "Step 1: Creating SLO ${ORIGIN} via the Dash0 API..."
+
+cat > "${WORK_DIR}/slo.yaml" <<'YAMLEOF'
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: roundtrip-import-slo
+spec:
+  description: Roundtrip import SLO.
+  service: roundtrip-import
+  budgetingMethod: Occurrences
+  indicator:
+    spec:
+      ratioMetric:
+        counter: true
+        good:
+          metricSource:
+            spec:
+              query: http_requests_total{service="roundtrip-import",status!~"5.."}
+        total:
+          metricSource:
+            spec:
+              query: http_requests_total{service="roundtrip-import"}
+  objectives:
+    - displayName: Availability
+      target: 0.99
+  timeWindow:
+    - duration: 28d
+      isRolling: true
+YAMLEOF
+
+dash0_api PUT "/api/slos/${ORIGIN}?dataset=${DATASET}" "${WORK_DIR}/slo.yaml" >/dev/null \
+  || fail "Failed to create SLO via the Dash0 API"
+info "SLO created via API."
+
+# ---------------------------------------------------------------------------
+# Step 2: Write resource shell.
+# ---------------------------------------------------------------------------
+info "Step 2: Writing Terraform config..."
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_slo" "imported" {
+  dataset                = var.dataset
+  slo_yaml               = file("${path.module}/slo.yaml")
+  ignore_server_defaults = true
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_slo.imported.origin
+}
+EOF
+
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 3: terraform import with `<dataset>,<origin>`.
+# ---------------------------------------------------------------------------
+info "Step 3: Importing via terraform import (dataset + origin)..."
+
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_slo.imported" "${DATASET},${ORIGIN}" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 4: Assert plan reports no changes.
+# ---------------------------------------------------------------------------
+info "Step 4: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Origin preservation.
+# ---------------------------------------------------------------------------
+info "Step 5: Verifying origin preservation in state..."
+STATE_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+if [[ "$STATE_ORIGIN" != "$ORIGIN" ]]; then
+  fail "Expected imported origin '${ORIGIN}' in state, got '${STATE_ORIGIN}'"
+fi
+info "Origin preservation check PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 6: Modify + apply.
+# ---------------------------------------------------------------------------
+info "Step 6: Modifying + applying to prove imported resource is manageable..."
+
+sed -i 's/description: Roundtrip import SLO./description: Roundtrip import SLO, updated-after-import./' "${WORK_DIR}/slo.yaml"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+API_OUTPUT="$(dash0_api GET "/api/slos/${ORIGIN}?dataset=${DATASET}")"
+echo "$API_OUTPUT" | grep -q "updated-after-import" \
+  || fail "API response does not reflect the post-import update"
+info "Update-after-import verified via API."
+
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 7: Destroy + verify server-side deletion.
+# ---------------------------------------------------------------------------
+info "Step 7: Destroying imported SLO via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+
+info "Step 7b: Verifying server-side deletion..."
+if dash0_api GET "/api/slos/${ORIGIN}?dataset=${DATASET}" >/dev/null 2>&1; then
+  fail "SLO '${ORIGIN}' still exists after terraform destroy"
+fi
+info "Server-side deletion confirmed."
+
+info "=== dash0_slo import roundtrip test PASSED ==="
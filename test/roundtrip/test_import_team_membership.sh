@@ -0,0 +1,153 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on dash0_team_membership, which is
+# imported by `<team>,<member>`. The membership is created out-of-band as part
+# of a team managed in a separate working directory, then imported and
+# destroyed on its own, which must remove the member from the team while
+# leaving the team in place.
+#
+# Steps:
+#   1. Discover an organization member via `dash0 members list`
+#   2. Create a team listing the member from a separate working directory
+#   3. Write a resource shell for the membership
+#   4. `terraform import` with `<team origin>,<email>`
+#   5. Assert plan reports no changes
+#   6. Destroy the membership + verify the member left the team
+#   7. Clean up the team
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+TEAM_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR" "$TEAM_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_team_membership) ==="
+info "Working directory: ${WORK_DIR}"
+
+# team_lists_member <team_origin> <email>
+#
+# Succeeds if spec.members of the team returned by the CLI holds email.
+team_lists_member() {
+  dash0 -X teams get "$1" -o yaml 2>/dev/null | python3 -c "
+import sys, yaml
+doc = yaml.safe_load(sys.stdin.read()) or {}
+team = doc.get('team', doc)
+members = [str(m).lower() for m in (team.get('spec', {}).get('members') or [])]
+sys.exit(0 if sys.argv[1].lower() in members else 1)
+" "$2"
+}
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+write_provider_tf "$TEAM_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Discover a member via CLI.
+# ---------------------------------------------------------------------------
+info "Step 1: Discovering an organization member via dash0 CLI..."
+
+MEMBER_EMAIL="$(dash0 -X members list -o json | python3 -c "
+import json, sys
+for m in json.load(sys.stdin):
+    email = m.get('email') or m.get('spec', {}).get('display', {}).get('email')
+    if email:
+        print(email)
+        break
+")"
+[[ -n "$MEMBER_EMAIL" ]] || fail "Could not discover an organization member with an email address"
+info "Member: ${MEMBER_EMAIL}"
+
+# ---------------------------------------------------------------------------
+# Step 2: Create a team that lists the member (out-of-band for WORK_DIR).
+# ---------------------------------------------------------------------------
+info "Step 2: Creating a team that lists the member..."
+
+cat > "${TEAM_DIR}/main.tf" <<EOF
+resource "dash0_team" "source" {
+  team_yaml = <<-YAML
+apiVersion: dash0.com/v1alpha1
+kind: Dash0Team
+metadata:
+  name: roundtrip-import-membership-team
+spec:
+  display:
+    name: Roundtrip Import Membership Team
+  members:
+    - ${MEMBER_EMAIL}
+YAML
+
+  lifecycle {
+    ignore_changes = [team_yaml]
+  }
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_team.source.origin
+}
+EOF
+
+tf_init "$TEAM_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$TEAM_DIR"
+TEAM_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$TEAM_DIR" origin)"
+info "Team origin: ${TEAM_ORIGIN}"
+
+team_lists_member "$TEAM_ORIGIN" "$MEMBER_EMAIL" \
+  || fail "Team ${TEAM_ORIGIN} does not list member ${MEMBER_EMAIL}"
+
+# ---------------------------------------------------------------------------
+# Step 3: Write resource shell.
+# ---------------------------------------------------------------------------
+info "Step 3: Writing Terraform config..."
+
+cat > "${WORK_DIR}/main.tf" <<EOF
+resource "dash0_team_membership" "imported" {
+  team   = "${TEAM_ORIGIN}"
+  member = "${MEMBER_EMAIL}"
+}
+
+variable "dataset" {
+  type = string
+}
+EOF
+
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 4: terraform import with `<team>,<member>`.
+# ---------------------------------------------------------------------------
+info "Step 4: Importing via terraform import (team origin + email)..."
+
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_team_membership.imported" "${TEAM_ORIGIN},${MEMBER_EMAIL}" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 5: Assert plan reports no changes.
+# ---------------------------------------------------------------------------
+info "Step 5: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 6: Destroy the membership + verify the member left the team.
+# ---------------------------------------------------------------------------
+info "Step 6: Destroying imported membership via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+
+if team_lists_member "$TEAM_ORIGIN" "$MEMBER_EMAIL"; then
+  fail "Team ${TEAM_ORIGIN} still lists member ${MEMBER_EMAIL} after terraform destroy"
+fi
+info "Server-side removal confirmed."
+
+# ---------------------------------------------------------------------------
+# Step 7: Clean up the team.
+# ---------------------------------------------------------------------------
+info "Step 7: Destroying the team..."
+TF_VAR_dataset="$DATASET" tf_destroy "$TEAM_DIR"
+
+info "=== dash0_team_membership import roundtrip test PASSED ==="
@@ -0,0 +1,144 @@
+#!/usr/bin/env bash
+# Roundtrip test for `terraform import` on a dataset-scoped resource
+# (dash0_trace_sampling_rule), imported by `<dataset>,<origin>` like
+# dash0_slo (see test_import_slo.sh). The dash0 CLI does not manage sampling
+# rules, so the rule is created out-of-band through the Dash0 API directly,
+# which needs an auth token: with an OAuth-enabled profile the test is
+# skipped.
+#
+# Steps:
+#   1. Create sampling rule via the Dash0 API (out-of-band, no Terraform)
+#   2. Write resource shell with the same definition
+#   3. `terraform import` with `<dataset>,<origin>`
+#   4. Assert plan reports no changes
+#   5. Verify origin preservation in state
+#   6. Modify + apply — prove the imported resource is manageable
+#   7. Destroy + verify server-side deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+if ! has_api_token; then
+  warn "No auth token available (OAuth profile); skipping dash0_trace_sampling_rule import test."
+  exit 0
+fi
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: terraform import (dash0_trace_sampling_rule) ==="
+info "Working directory: ${WORK_DIR}"
+
+# The origin has a unique suffix so parallel runs and prior aborts don't
+# collide on the same sampling rule.
+ORIGIN="roundtrip-import-sampling-rule-$$-$RANDOM"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create sampling rule via the Dash0 API (out-of-band).
+# ---------------------------------------------------------------------------
+info "Step 1: Creating sampling rule ${ORIGIN} via the Dash0 API..."
+
+cat > "${WORK_DIR}/sampling_rule.yaml" <<'YAMLEOF'
+kind: Dash0Sampling
+metadata:
+  name: roundtrip-import-sampling-rule
+spec:
+  enabled: true
+  conditions:
+    kind: and
+    spec:
+      conditions:
+        - kind: error
+          spec: {}
+        - kind: ottl
+          spec:
+            ottl: resource.attributes["service.name"] == "roundtrip-import"
+  rateLimit:
+    rate: 120
+YAMLEOF
+
+dash0_api PUT "/api/sampling-rules/${ORIGIN}?dataset=${DATASET}" "${WORK_DIR}/sampling_rule.yaml" >/dev/null \
+  || fail "Failed to create sampling rule via the Dash0 API"
+info "Sampling rule created via API."
+
+# ---------------------------------------------------------------------------
+# Step 2: Write resource shell.
+# ---------------------------------------------------------------------------
+info "Step 2: Writing Terraform config..."
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_trace_sampling_rule" "imported" {
+  dataset                = var.dataset
+  sampling_rule_yaml     = file("${path.module}/sampling_rule.yaml")
+  ignore_server_defaults = true
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_trace_sampling_rule.imported.origin
+}
+EOF
+
+tf_init "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 3: terraform import with `<dataset>,<origin>`.
+# ---------------------------------------------------------------------------
+info "Step 3: Importing via terraform import (dataset + origin)..."
+
+TF_VAR_dataset="$DATASET" tf_import "$WORK_DIR" "dash0_trace_sampling_rule.imported" "${DATASET},${ORIGIN}" \
+  || fail "terraform import failed"
+info "Import completed."
+
+# ---------------------------------------------------------------------------
+# Step 4: Assert plan reports no changes.
+# ---------------------------------------------------------------------------
+info "Step 4: Asserting terraform plan reports no changes after import..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Origin preservation.
+# ---------------------------------------------------------------------------
+info "Step 5: Verifying origin preservation in state..."
+STATE_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+if [[ "$STATE_ORIGIN" != "$ORIGIN" ]]; then
+  fail "Expected imported origin '${ORIGIN}' in state, got '${STATE_ORIGIN}'"
+fi
+info "Origin preservation check PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 6: Modify + apply.
+# ---------------------------------------------------------------------------
+info "Step 6: Modifying + applying to prove imported resource is manageable..."
+
+sed -i 's/rate: 120/rate: 600/' "${WORK_DIR}/sampling_rule.yaml"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+API_OUTPUT="$(dash0_api GET "/api/sampling-rules/${ORIGIN}?dataset=${DATASET}")"
+echo "$API_OUTPUT" | grep -q '"rate": *600' \
+  || fail "API response does not reflect the post-import update"
+info "Update-after-import verified via API."
+
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 7: Destroy + verify server-side deletion.
+# ---------------------------------------------------------------------------
+info "Step 7: Destroying imported sampling rule via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+
+info "Step 7b: Verifying server-side deletion..."
+if dash0_api GET "/api/sampling-rules/${ORIGIN}?dataset=${DATASET}" >/dev/null 2>&1; then
+  fail "Sampling rule '${ORIGIN}' still exists after terraform destroy"
+fi
+info "Server-side deletion confirmed."
+
+info "=== dash0_trace_sampling_rule import roundtrip test PASSED ==="
@@ -0,0 +1,106 @@
+#!/usr/bin/env bash
+# Roundtrip test for dash0_notification_channel_slack.
+#
+# Steps:
+#   1. Create the resource via Terraform
+#   2. Verify it exists via dash0 CLI, as a channel of type slack
+#   3. Rename it and re-apply via Terraform
+#   4. Re-apply without changes (idempotency)
+#   5. Destroy the resource via Terraform
+#   6. Verify deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: dash0_notification_channel_slack ==="
+info "Working directory: ${WORK_DIR}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create Slack notification channel
+# ---------------------------------------------------------------------------
+info "Step 1: Creating Slack notification channel via Terraform..."
+
+write_main_tf() {
+  local name="$1"
+  cat > "${WORK_DIR}/main.tf" <<EOF
+resource "dash0_notification_channel_slack" "test" {
+  name        = "${name}"
+  webhook_url = "https://hooks.slack.com/services/T0000/B0000/roundtrip"
+  channel     = "#roundtrip-test"
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_notification_channel_slack.test.origin
+}
+EOF
+}
+
+write_main_tf "roundtrip-test-slack-channel"
+
+tf_init "$WORK_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+info "Created notification channel with origin: ${ORIGIN}"
+
+# ---------------------------------------------------------------------------
+# Step 2: Verify via dash0 CLI
+# ---------------------------------------------------------------------------
+info "Step 2: Verifying notification channel exists via dash0 CLI..."
+
+CLI_OUTPUT="$(dash0 -X notification-channels get "$ORIGIN" -o yaml 2>&1)" \
+  || fail "dash0 CLI could not find notification channel ${ORIGIN}"
+echo "$CLI_OUTPUT"
+
+echo "$CLI_OUTPUT" | grep -q "roundtrip-test-slack-channel" \
+  || fail "CLI output does not contain expected notification channel name"
+echo "$CLI_OUTPUT" | grep -Eq "type: \"?slack\"?$" \
+  || fail "CLI output does not hold a channel of type slack"
+info "Notification channel verified via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 3: Update
+# ---------------------------------------------------------------------------
+info "Step 3: Renaming notification channel..."
+
+write_main_tf "roundtrip-test-slack-channel-UPDATED"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+info "Notification channel updated."
+
+CLI_OUTPUT="$(dash0 -X notification-channels get "$ORIGIN" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "UPDATED" \
+  || fail "CLI output does not reflect the update"
+info "Update verified via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 4: Idempotency
+# ---------------------------------------------------------------------------
+info "Step 4: Re-applying without changes (idempotency test)..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Destroy
+# ---------------------------------------------------------------------------
+info "Step 5: Destroying notification channel via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+info "Notification channel destroyed."
+
+# ---------------------------------------------------------------------------
+# Step 6: Verify deletion
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying notification channel is gone..."
+assert_deleted_via_tf "$WORK_DIR"
+
+info "=== dash0_notification_channel_slack roundtrip test PASSED ==="
@@ -0,0 +1,205 @@
+#!/usr/bin/env bash
+# Roundtrip test for dash0_prometheus_rule_group.
+#
+# The resource stores every alerting rule of the document as a check rule and
+# the recording rules of every group as a recording rule group, with origins
+# derived from the group and rule names. The test checks that an update which
+# reorders, adds and removes rules keeps the origins of the rules it did not
+# touch.
+#
+# Steps:
+#   1. Create the resource via Terraform
+#   2. Verify the check rules and the recording rule group via dash0 CLI
+#   3. Reorder, add and remove rules and re-apply via Terraform
+#   4. Re-apply without changes (idempotency)
+#   5. Destroy the resource via Terraform
+#   6. Verify deletion via dash0 CLI
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: dash0_prometheus_rule_group ==="
+info "Working directory: ${WORK_DIR}"
+info "Dataset: ${DATASET}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Write provider configuration
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create rule group
+# ---------------------------------------------------------------------------
+info "Step 1: Creating Prometheus rule group via Terraform..."
+
+cat > "${WORK_DIR}/prometheus_rule.yaml" <<'YAMLEOF'
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: roundtrip-test-rule-group
+spec:
+  groups:
+    - name: RoundtripRuleGroup
+      interval: 1m0s
+      rules:
+        - alert: roundtrip-test-first
+          expr: vector(1) > 0
+          for: 0s
+          keep_firing_for: 0s
+          annotations:
+            summary: "First roundtrip test alert"
+            dash0-enabled: true
+          labels: {}
+        - alert: roundtrip-test-second
+          expr: vector(2) > 0
+          for: 0s
+          keep_firing_for: 0s
+          annotations:
+            summary: "Second roundtrip test alert"
+            dash0-enabled: true
+          labels: {}
+        - record: job:roundtrip_test:sum
+          expr: sum by (job) (up)
+YAMLEOF
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_prometheus_rule_group" "test" {
+  dataset              = var.dataset
+  prometheus_rule_yaml = file("${path.module}/prometheus_rule.yaml")
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_prometheus_rule_group.test.origin
+}
+
+output "check_rule_origins" {
+  value = join(",", dash0_prometheus_rule_group.test.check_rule_origins)
+}
+
+output "recording_rule_origins" {
+  value = join(",", dash0_prometheus_rule_group.test.recording_rule_origins)
+}
+EOF
+
+tf_init "$WORK_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+info "Created Prometheus rule group with origin: ${ORIGIN}"
+
+FIRST="${ORIGIN}-roundtriprulegroup--roundtrip-test-first"
+SECOND="${ORIGIN}-roundtriprulegroup--roundtrip-test-second"
+THIRD="${ORIGIN}-roundtriprulegroup--roundtrip-test-third"
+RECORDING="${ORIGIN}-roundtriprulegroup"
+
+CHECK_RULE_ORIGINS="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" check_rule_origins)"
+[[ "$CHECK_RULE_ORIGINS" == "${FIRST},${SECOND}" ]] \
+  || fail "Expected check_rule_origins '${FIRST},${SECOND}', got '${CHECK_RULE_ORIGINS}'"
+RECORDING_RULE_ORIGINS="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" recording_rule_origins)"
+[[ "$RECORDING_RULE_ORIGINS" == "$RECORDING" ]] \
+  || fail "Expected recording_rule_origins '${RECORDING}', got '${RECORDING_RULE_ORIGINS}'"
+
+# ---------------------------------------------------------------------------
+# Step 2: Verify via dash0 CLI
+# ---------------------------------------------------------------------------
+info "Step 2: Verifying check rules and recording rule group via dash0 CLI..."
+
+CLI_OUTPUT="$(dash0 check-rules get "$FIRST" --dataset "$DATASET" -o yaml 2>&1)" \
+  || fail "dash0 CLI could not find check rule ${FIRST}"
+echo "$CLI_OUTPUT"
+echo "$CLI_OUTPUT" | grep -q "roundtrip-test-first" \
+  || fail "CLI output does not contain the first alert name"
+
+CLI_OUTPUT="$(dash0 check-rules get "$SECOND" --dataset "$DATASET" -o yaml 2>&1)" \
+  || fail "dash0 CLI could not find check rule ${SECOND}"
+echo "$CLI_OUTPUT" | grep -q "vector(2) > 0" \
+  || fail "CLI output does not contain the second alert expression"
+
+CLI_OUTPUT="$(dash0 recording-rules get "$RECORDING" --dataset "$DATASET" -o yaml 2>&1)" \
+  || fail "dash0 CLI could not find recording rule group ${RECORDING}"
+echo "$CLI_OUTPUT"
+echo "$CLI_OUTPUT" | grep -q "job:roundtrip_test:sum" \
+  || fail "CLI output does not contain the recording rule"
+info "CLI verification PASSED."
+
+# ---------------------------------------------------------------------------
+# Step 3: Reorder, add and remove rules and re-apply. The second alert moves
+# in front, a third alert is added and the first is removed: only the third
+# is created and the first deleted, and the second keeps its origin.
+# ---------------------------------------------------------------------------
+info "Step 3: Reordering, adding and removing rules..."
+
+cat > "${WORK_DIR}/prometheus_rule.yaml" <<'YAMLEOF'
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: roundtrip-test-rule-group
+spec:
+  groups:
+    - name: RoundtripRuleGroup
+      interval: 1m0s
+      rules:
+        - record: job:roundtrip_test:sum
+          expr: sum by (job) (up)
+        - alert: roundtrip-test-third
+          expr: vector(3) > 0
+          for: 0s
+          keep_firing_for: 0s
+          annotations:
+            summary: "Third roundtrip test alert"
+            dash0-enabled: true
+          labels: {}
+        - alert: roundtrip-test-second
+          expr: vector(2) > 0
+          for: 0s
+          keep_firing_for: 0s
+          annotations:
+            summary: "Second roundtrip test alert - UPDATED"
+            dash0-enabled: true
+          labels: {}
+YAMLEOF
+
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+info "Prometheus rule group updated."
+
+CHECK_RULE_ORIGINS="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" check_rule_origins)"
+[[ "$CHECK_RULE_ORIGINS" == "${THIRD},${SECOND}" ]] \
+  || fail "Expected check_rule_origins '${THIRD},${SECOND}', got '${CHECK_RULE_ORIGINS}'"
+
+CLI_OUTPUT="$(dash0 check-rules get "$SECOND" --dataset "$DATASET" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "UPDATED" \
+  || fail "CLI output does not reflect the update of ${SECOND}"
+CLI_OUTPUT="$(dash0 check-rules get "$THIRD" --dataset "$DATASET" -o yaml 2>&1)" \
+  || fail "dash0 CLI could not find the added check rule ${THIRD}"
+assert_deleted_via_cli "dash0 check-rules get" "$FIRST" "$DATASET"
+info "Update verified via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 4: Idempotency — re-apply without changes
+# ---------------------------------------------------------------------------
+info "Step 4: Re-applying without changes (idempotency test)..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Destroy
+# ---------------------------------------------------------------------------
+info "Step 5: Destroying Prometheus rule group via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+info "Prometheus rule group destroyed."
+
+# ---------------------------------------------------------------------------
+# Step 6: Verify deletion via CLI
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying check rules and recording rule group are gone..."
+assert_deleted_via_cli "dash0 check-rules get" "$SECOND" "$DATASET"
+assert_deleted_via_cli "dash0 check-rules get" "$THIRD" "$DATASET"
+assert_deleted_via_cli "dash0 recording-rules get" "$RECORDING" "$DATASET"
+
+info "=== dash0_prometheus_rule_group roundtrip test PASSED ==="
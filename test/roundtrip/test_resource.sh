@@ -0,0 +1,136 @@
+#!/usr/bin/env bash
+# Roundtrip test for dash0_resource.
+#
+# Manages a view through the generic asset endpoint (`kind = "views"`), so the
+# result can be verified with the same dash0 CLI commands as test_view.sh
+# while exercising the generic create, read, update and delete code paths.
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+# shellcheck source=common.sh
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: dash0_resource ==="
+info "Working directory: ${WORK_DIR}"
+info "Dataset: ${DATASET}"
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create a view through the generic asset endpoint
+# ---------------------------------------------------------------------------
+info "Step 1: Creating view via dash0_resource..."
+
+write_view_yaml() {
+  local name="$1"
+  cat > "${WORK_DIR}/view.yaml" <<YAMLEOF
+kind: Dash0View
+metadata:
+  name: roundtrip-test-generic-view
+  labels:
+    "dash0.com/dataset": "${DATASET}"
+spec:
+  display:
+    name: ${name}
+    folder: []
+  type: spans
+  permissions:
+    - actions:
+        - "views:read"
+        - "views:delete"
+      role: "admin"
+    - actions:
+        - "views:read"
+      role: "basic_member"
+  filter:
+    - key: service.name
+      operator: is
+      value: roundtrip-test-service
+  table:
+    columns:
+      - colSize: minmax(auto, 2fr)
+        key: dash0.span.name
+        label: Name
+      - colSize: 8.5rem
+        key: otel.span.duration
+        label: Duration
+    sort:
+      - direction: ascending
+        key: otel.span.duration
+YAMLEOF
+}
+
+write_view_yaml "Roundtrip Test Generic View"
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_resource" "test" {
+  kind            = "views"
+  dataset         = var.dataset
+  definition_yaml = file("${path.module}/view.yaml")
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_resource.test.origin
+}
+EOF
+
+tf_init "$WORK_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+info "Created view with origin: ${ORIGIN}"
+
+# ---------------------------------------------------------------------------
+# Step 2: Verify via dash0 CLI
+# ---------------------------------------------------------------------------
+info "Step 2: Verifying view exists via dash0 CLI..."
+
+CLI_OUTPUT="$(dash0 views get "$ORIGIN" --dataset "$DATASET" -o yaml 2>&1)" \
+  || fail "dash0 CLI could not find view ${ORIGIN}"
+echo "$CLI_OUTPUT"
+
+info "Step 2b: Checking YAML equivalence (uploaded vs downloaded)..."
+assert_yaml_equivalent_eventually "${WORK_DIR}/view.yaml" "dash0 views" "$ORIGIN" "$DATASET"
+
+# ---------------------------------------------------------------------------
+# Step 3: Update
+# ---------------------------------------------------------------------------
+info "Step 3: Updating view (changing display name)..."
+
+write_view_yaml "Roundtrip Test Generic View - Updated"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+CLI_OUTPUT="$(dash0 views get "$ORIGIN" --dataset "$DATASET" -o yaml 2>&1)"
+echo "$CLI_OUTPUT" | grep -q "Roundtrip Test Generic View - Updated" \
+  || fail "CLI output does not reflect the update"
+info "Update verified via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 4: Idempotency
+# ---------------------------------------------------------------------------
+info "Step 4: Re-applying without changes (idempotency test)..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Destroy
+# ---------------------------------------------------------------------------
+info "Step 5: Destroying view via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+info "View destroyed."
+
+# ---------------------------------------------------------------------------
+# Step 6: Verify deletion
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying view is gone..."
+assert_deleted_via_tf "$WORK_DIR"
+
+info "=== dash0_resource roundtrip test PASSED ==="
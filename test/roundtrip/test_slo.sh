@@ -0,0 +1,140 @@
+#!/usr/bin/env bash
+# Roundtrip test for dash0_slo.
+#
+# The dash0 CLI does not manage SLOs, so the SLO is verified through the Dash0
+# API directly. With an OAuth-enabled profile no auth token is available to
+# the test, and the API checks are skipped.
+#
+# Steps:
+#   1. Create the resource via Terraform
+#   2. Verify it exists via the Dash0 API
+#   3. Update a field and re-apply via Terraform
+#   4. Re-apply without changes (idempotency)
+#   5. Destroy the resource via Terraform
+#   6. Verify deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: dash0_slo ==="
+info "Working directory: ${WORK_DIR}"
+
+# write_slo_yaml <description> <target>
+write_slo_yaml() {
+  cat > "${WORK_DIR}/slo.yaml" <<YAMLEOF
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: roundtrip-test-slo
+spec:
+  description: $1
+  service: roundtrip-test
+  budgetingMethod: Occurrences
+  indicator:
+    spec:
+      ratioMetric:
+        counter: true
+        good:
+          metricSource:
+            spec:
+              query: http_requests_total{service="roundtrip-test",status!~"5.."}
+        total:
+          metricSource:
+            spec:
+              query: http_requests_total{service="roundtrip-test"}
+  objectives:
+    - displayName: Availability
+      target: $2
+  timeWindow:
+    - duration: 28d
+      isRolling: true
+YAMLEOF
+}
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Create SLO
+# ---------------------------------------------------------------------------
+info "Step 1: Creating SLO via Terraform..."
+
+write_slo_yaml "Roundtrip test SLO." 0.99
+
+cat > "${WORK_DIR}/main.tf" <<'EOF'
+resource "dash0_slo" "test" {
+  dataset  = var.dataset
+  slo_yaml = file("${path.module}/slo.yaml")
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "origin" {
+  value = dash0_slo.test.origin
+}
+EOF
+
+tf_init "$WORK_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" origin)"
+info "Created SLO with origin: ${ORIGIN}"
+
+# ---------------------------------------------------------------------------
+# Step 2: Verify via the Dash0 API
+# ---------------------------------------------------------------------------
+if has_api_token; then
+  info "Step 2: Verifying SLO exists via the Dash0 API..."
+  API_OUTPUT="$(dash0_api GET "/api/slos/${ORIGIN}?dataset=${DATASET}")" \
+    || fail "Dash0 API could not find SLO ${ORIGIN}"
+  echo "$API_OUTPUT"
+  echo "$API_OUTPUT" | grep -q "roundtrip-test-slo" \
+    || fail "API response does not contain expected SLO name"
+  info "SLO verified via API."
+else
+  warn "Step 2: No auth token available (OAuth profile); skipping API verification."
+fi
+
+# ---------------------------------------------------------------------------
+# Step 3: Update
+# ---------------------------------------------------------------------------
+info "Step 3: Updating SLO (changing description and target)..."
+
+write_slo_yaml "Roundtrip test SLO, UPDATED." 0.995
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+info "SLO updated."
+
+if has_api_token; then
+  API_OUTPUT="$(dash0_api GET "/api/slos/${ORIGIN}?dataset=${DATASET}")"
+  echo "$API_OUTPUT" | grep -q "UPDATED" \
+    || fail "API response does not reflect the update"
+  info "Update verified via API."
+fi
+
+# ---------------------------------------------------------------------------
+# Step 4: Idempotency
+# ---------------------------------------------------------------------------
+info "Step 4: Re-applying without changes (idempotency test)..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 5: Destroy
+# ---------------------------------------------------------------------------
+info "Step 5: Destroying SLO via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+info "SLO destroyed."
+
+# ---------------------------------------------------------------------------
+# Step 6: Verify deletion
+# ---------------------------------------------------------------------------
+info "Step 6: Verifying SLO is gone..."
+assert_deleted_via_tf "$WORK_DIR"
+
+info "=== dash0_slo roundtrip test PASSED ==="
@@ -0,0 +1,159 @@
+#!/usr/bin/env bash
+# Roundtrip test for dash0_team_membership.
+#
+# Steps:
+#   1. Discover two organization members via `dash0 members list`
+#   2. Create a team and a membership via Terraform
+#   3. Verify the member is listed in the team via dash0 CLI
+#   4. Move the membership to the second member and re-apply
+#   5. Re-apply without changes (idempotency)
+#   6. Destroy the resources via Terraform
+#   7. Verify deletion
+
+SCRIPT_DIR="$(cd "$(dirname "$0")" && pwd)"
+source "${SCRIPT_DIR}/common.sh"
+
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+info "=== Roundtrip test: dash0_team_membership ==="
+info "Working directory: ${WORK_DIR}"
+
+# team_lists_member <team_origin> <email>
+#
+# Succeeds if spec.members of the team returned by the CLI holds email.
+team_lists_member() {
+  dash0 -X teams get "$1" -o yaml 2>/dev/null | python3 -c "
+import sys, yaml
+doc = yaml.safe_load(sys.stdin.read()) or {}
+team = doc.get('team', doc)
+members = [str(m).lower() for m in (team.get('spec', {}).get('members') or [])]
+sys.exit(0 if sys.argv[1].lower() in members else 1)
+" "$2"
+}
+
+# ---------------------------------------------------------------------------
+# Step 0: Provider config
+# ---------------------------------------------------------------------------
+write_provider_tf "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 1: Discover two organization members via CLI
+# ---------------------------------------------------------------------------
+info "Step 1: Discovering organization members via dash0 CLI..."
+
+MEMBERS_JSON="$(dash0 -X members list -o json 2>&1)" \
+  || fail "dash0 CLI could not list members"
+
+readarray -t MEMBER_EMAILS < <(python3 - "$MEMBERS_JSON" <<'PYEOF'
+import json, sys
+emails = []
+for m in json.loads(sys.argv[1]):
+    email = m.get("email") or (m.get("spec", {}).get("display", {}).get("email"))
+    if email:
+        emails.append(email)
+        if len(emails) >= 2:
+            break
+for e in emails:
+    print(e)
+PYEOF
+)
+
+if [[ "${#MEMBER_EMAILS[@]}" -lt 2 ]]; then
+  fail "Need at least two organization members with emails for this test; found ${#MEMBER_EMAILS[@]}"
+fi
+MEMBER_A="${MEMBER_EMAILS[0]}"
+MEMBER_B="${MEMBER_EMAILS[1]}"
+info "Using members: ${MEMBER_A}, then ${MEMBER_B}"
+
+# ---------------------------------------------------------------------------
+# Step 2: Create team + membership via Terraform
+# ---------------------------------------------------------------------------
+info "Step 2: Creating team and membership via Terraform..."
+
+write_main_tf() {
+  local member="$1"
+  cat > "${WORK_DIR}/main.tf" <<EOF
+resource "dash0_team" "test" {
+  team_yaml = <<-YAML
+apiVersion: dash0.com/v1alpha1
+kind: Dash0Team
+metadata:
+  name: roundtrip-test-membership-team
+spec:
+  display:
+    name: Roundtrip Test Membership Team
+  members: []
+YAML
+
+  lifecycle {
+    ignore_changes = [team_yaml]
+  }
+}
+
+resource "dash0_team_membership" "test" {
+  team   = dash0_team.test.origin
+  member = "${member}"
+}
+
+variable "dataset" {
+  type = string
+}
+
+output "team_origin" {
+  value = dash0_team.test.origin
+}
+EOF
+}
+
+write_main_tf "$MEMBER_A"
+
+tf_init "$WORK_DIR"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+TEAM_ORIGIN="$(TF_VAR_dataset="$DATASET" tf_output "$WORK_DIR" team_origin)"
+info "Created team with origin: ${TEAM_ORIGIN}"
+
+# ---------------------------------------------------------------------------
+# Step 3: Verify via dash0 CLI
+# ---------------------------------------------------------------------------
+info "Step 3: Verifying membership via dash0 CLI..."
+team_lists_member "$TEAM_ORIGIN" "$MEMBER_A" \
+  || fail "Team ${TEAM_ORIGIN} does not list member ${MEMBER_A}"
+info "Membership verified via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 4: Update (replace the membership)
+# ---------------------------------------------------------------------------
+info "Step 4: Moving the membership to ${MEMBER_B}..."
+
+write_main_tf "$MEMBER_B"
+TF_VAR_dataset="$DATASET" tf_apply "$WORK_DIR"
+
+team_lists_member "$TEAM_ORIGIN" "$MEMBER_B" \
+  || fail "Team ${TEAM_ORIGIN} does not list member ${MEMBER_B} after the update"
+if team_lists_member "$TEAM_ORIGIN" "$MEMBER_A"; then
+  fail "Team ${TEAM_ORIGIN} still lists member ${MEMBER_A} after the update"
+fi
+info "Update verified via CLI."
+
+# ---------------------------------------------------------------------------
+# Step 5: Idempotency
+# ---------------------------------------------------------------------------
+info "Step 5: Re-applying without changes (idempotency test)..."
+assert_idempotent "$WORK_DIR"
+
+# ---------------------------------------------------------------------------
+# Step 6: Destroy
+# ---------------------------------------------------------------------------
+info "Step 6: Destroying team and membership via Terraform..."
+TF_VAR_dataset="$DATASET" tf_destroy "$WORK_DIR"
+info "Team and membership destroyed."
+
+# ---------------------------------------------------------------------------
+# Step 7: Verify deletion
+# ---------------------------------------------------------------------------
+info "Step 7: Verifying team and membership are gone..."
+assert_deleted_via_tf "$WORK_DIR"
+
+info "=== dash0_team_membership roundtrip test PASSED ==="